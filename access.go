@@ -0,0 +1,118 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"sort"
+	"time"
+)
+
+// TrackAccess is an Option that makes the container record how often each
+// value and value group is requested, and when it was last requested.
+// The statistics are reported in the Accesses field of InspectSnapshot,
+// along with the values that were never requested.
+//
+//	c := dig.New(dig.TrackAccess())
+//	// ...
+//	for _, a := range c.InspectSnapshot().Accesses {
+//	  if a.Count == 0 {
+//	    log.Printf("%v is never used", a.Key)
+//	  }
+//	}
+//
+// A value is requested every time a constructor, decorator, or invoked
+// function depends on it, whether or not it had been constructed already.
+func TrackAccess() Option {
+	return trackAccessOption{}
+}
+
+type trackAccessOption struct{}
+
+func (trackAccessOption) String() string {
+	return "TrackAccess()"
+}
+
+func (trackAccessOption) applyOption(c *Container) {
+	c.scope.access = make(map[key]*KeyAccess)
+}
+
+// KeyAccess reports how often a value or value group was requested from a
+// container built with TrackAccess.
+type KeyAccess struct {
+	// Key is the value or value group that was requested.
+	Key *Output
+
+	// Count is the number of times the key was requested.
+	Count int
+
+	// Last is the time at which the key was last requested. This is the
+	// zero time if the key was never requested.
+	Last time.Time
+}
+
+// recordAccess records that the given key was requested, if the container
+// tracks accesses.
+func (s *Scope) recordAccess(k key) {
+	root := s.rootScope()
+	if root.access == nil {
+		return
+	}
+	a, ok := root.access[k]
+	if !ok {
+		a = &KeyAccess{Key: &Output{t: k.t, name: k.name, group: k.group}}
+		root.access[k] = a
+	}
+	a.Count++
+	a.Last = time.Now()
+}
+
+// snapshotAccesses reports the accesses recorded by the container, along
+// with the keys provided to the given scopes that were never requested.
+// It returns nil if the container doesn't track accesses.
+func (s *Scope) snapshotAccesses(scopes []*Scope) []KeyAccess {
+	access := s.rootScope().access
+	if access == nil {
+		return nil
+	}
+
+	seen := make(map[key]struct{}, len(access))
+	accesses := make([]KeyAccess, 0, len(access))
+	for k, a := range access {
+		seen[k] = struct{}{}
+		accesses = append(accesses, *a)
+	}
+	for _, scope := range scopes {
+		for k := range scope.providers {
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			seen[k] = struct{}{}
+			accesses = append(accesses, KeyAccess{
+				Key: &Output{t: k.t, name: k.name, group: k.group},
+			})
+		}
+	}
+
+	sort.Slice(accesses, func(i, j int) bool {
+		return accesses[i].Key.String() < accesses[j].Key.String()
+	})
+	return accesses
+}
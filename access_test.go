@@ -0,0 +1,102 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestTrackAccess(t *testing.T) {
+	t.Parallel()
+
+	type A struct{}
+	type B struct{}
+	type C struct{}
+
+	accesses := func(snap *dig.Snapshot) map[string]dig.KeyAccess {
+		m := make(map[string]dig.KeyAccess)
+		for _, a := range snap.Accesses {
+			m[a.Key.String()] = a
+		}
+		return m
+	}
+
+	t.Run("String", func(t *testing.T) {
+		assert.Equal(t, "TrackAccess()", fmt.Sprint(dig.TrackAccess()))
+	})
+
+	t.Run("counts requests", func(t *testing.T) {
+		c := digtest.New(t, dig.TrackAccess())
+		c.RequireProvide(func() *A { return &A{} })
+		c.RequireProvide(func(*A) *B { return &B{} })
+		c.RequireProvide(func() *C { return &C{} })
+		c.RequireProvide(func() int { return 1 }, dig.Group("ints"))
+
+		before := time.Now()
+		c.RequireInvoke(func(*A, *B) {})
+		c.RequireInvoke(func(*B) {})
+		c.RequireInvoke(func(struct {
+			dig.In
+
+			Ints []int `group:"ints"`
+		}) {
+		})
+
+		got := accesses(c.InspectSnapshot())
+		require.Len(t, got, 4)
+
+		a := got["*dig_test.A"]
+		assert.Equal(t, 2, a.Count, "requested by B and an invoke")
+		assert.False(t, a.Last.Before(before))
+		assert.Equal(t, 2, got["*dig_test.B"].Count)
+		assert.Equal(t, 1, got[`int[group = "ints"]`].Count)
+
+		cold := got["*dig_test.C"]
+		assert.Zero(t, cold.Count)
+		assert.True(t, cold.Last.IsZero())
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{} })
+		c.RequireInvoke(func(*A) {})
+
+		assert.Empty(t, c.InspectSnapshot().Accesses)
+	})
+
+	t.Run("clones start over", func(t *testing.T) {
+		c := digtest.New(t, dig.TrackAccess())
+		c.RequireProvide(func() *A { return &A{} })
+		c.RequireInvoke(func(*A) {})
+
+		clone := c.Clone()
+		got := accesses(clone.InspectSnapshot())
+		require.Contains(t, got, "*dig_test.A")
+		assert.Zero(t, got["*dig_test.A"].Count)
+	})
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// ParamOption is an option that applies to both Provide and Invoke.
+type ParamOption interface {
+	ProvideOption
+	InvokeOption
+}
+
+// ParamName is a ParamOption that makes the i-th parameter (starting at 0)
+// of a constructor or invoked function request the value with the given
+// name, as if it were a dig.In field with a `name` tag. This lets plain
+// functions consume named values without declaring a parameter object.
+//
+//	c.Provide(NewRepository, dig.ParamName(0, "rw"), dig.ParamName(1, "ro"))
+func ParamName(i int, name string) ParamOption {
+	return paramTagOption{
+		Index: i,
+		Tag:   fmt.Sprintf("%v:%v", _nameTag, strconv.Quote(name)),
+		desc:  fmt.Sprintf("ParamName(%d, %q)", i, name),
+	}
+}
+
+// ParamGroup is a ParamOption that makes the i-th parameter (starting at
+// 0) of a constructor or invoked function request the values of the given
+// value group, as if it were a dig.In field with a `group` tag.
+//
+//	c.Invoke(func(handlers []http.Handler) { ... }, dig.ParamGroup(0, "handlers"))
+func ParamGroup(i int, group string) ParamOption {
+	return paramTagOption{
+		Index: i,
+		Tag:   fmt.Sprintf("%v:%v", _groupTag, strconv.Quote(group)),
+		desc:  fmt.Sprintf("ParamGroup(%d, %q)", i, group),
+	}
+}
+
+// paramTag annotates a positional parameter with a struct tag.
+type paramTag struct {
+	Index int
+	Tag   string
+}
+
+type paramTagOption struct {
+	Index int
+	Tag   string
+	desc  string
+}
+
+func (o paramTagOption) String() string {
+	return o.desc
+}
+
+func (o paramTagOption) applyProvideOption(opts *provideOptions) {
+	opts.ParamTags = append(opts.ParamTags, paramTag{Index: o.Index, Tag: o.Tag})
+}
+
+func (o paramTagOption) applyInvokeOption(opts *invokeOptions) {
+	opts.ParamTags = append(opts.ParamTags, paramTag{Index: o.Index, Tag: o.Tag})
+}
+
+// annotate replaces the parameters targeted by the given tags with the
+// parameters they would be as fields of a dig.In struct with those tags.
+func (pl paramList) annotate(c containerStore, tags []paramTag) (paramList, error) {
+	if len(tags) == 0 {
+		return pl, nil
+	}
+
+	byIndex := make(map[int]string, len(tags))
+	var indexes []int
+	for _, t := range tags {
+		if t.Index < 0 || t.Index >= len(pl.Params) {
+			return pl, newErrInvalidInput(fmt.Sprintf(
+				"cannot annotate parameter %d of %v: it has %d parameters", t.Index, pl.ctype, len(pl.Params)), nil)
+		}
+		if _, ok := byIndex[t.Index]; !ok {
+			indexes = append(indexes, t.Index)
+		} else {
+			byIndex[t.Index] += " "
+		}
+		byIndex[t.Index] += t.Tag
+	}
+
+	params := append([]param(nil), pl.Params...)
+	for _, i := range indexes {
+		t := pl.ctype.In(i)
+		if _, ok := params[i].(paramSingle); !ok {
+			return pl, newErrInvalidInput(fmt.Sprintf(
+				"cannot annotate parameter %d of %v: %v is a parameter object", i, pl.ctype, t), nil)
+		}
+		f, err := newParamObjectField(i, reflect.StructField{
+			Name: fmt.Sprintf("Arg%d", i),
+			Type: t,
+			Tag:  reflect.StructTag(byIndex[i]),
+		}, c)
+		if err != nil {
+			return pl, newErrInvalidInput(fmt.Sprintf("bad argument %d", i+1), err)
+		}
+		params[i] = f.Param
+	}
+	pl.Params = params
+	return pl, nil
+}
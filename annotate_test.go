@@ -0,0 +1,116 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestParamAnnotations(t *testing.T) {
+	t.Parallel()
+
+	type Repo struct{ rw, ro string }
+
+	t.Run("named constructor parameters", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() string { return "primary" }, dig.Name("rw"))
+		c.RequireProvide(func() string { return "replica" }, dig.Name("ro"))
+		c.RequireProvide(func(rw, ro string) *Repo {
+			return &Repo{rw: rw, ro: ro}
+		}, dig.ParamName(0, "rw"), dig.ParamName(1, "ro"))
+
+		c.RequireInvoke(func(r *Repo) {
+			assert.Equal(t, &Repo{rw: "primary", ro: "replica"}, r)
+		})
+	})
+
+	t.Run("invoke with group and name", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() int { return 1 }, dig.Group("ints"))
+		c.RequireProvide(func() int { return 2 }, dig.Group("ints"))
+		c.RequireProvide(func() string { return "replica" }, dig.Name("ro"))
+
+		c.RequireInvoke(func(ints []int, ro string) {
+			assert.ElementsMatch(t, []int{1, 2}, ints)
+			assert.Equal(t, "replica", ro)
+		}, dig.ParamGroup(0, "ints"), dig.ParamName(1, "ro"))
+	})
+
+	t.Run("missing named value", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() string { return "unnamed" })
+		err := c.Invoke(func(string) {}, dig.ParamName(0, "rw"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `missing type: string[name="rw"]`)
+	})
+
+	t.Run("invalid index", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		err := c.Invoke(func(string) {}, dig.ParamName(1, "rw"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot annotate parameter 1 of func(string): it has 1 parameters")
+	})
+
+	t.Run("name and group", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		err := c.Provide(func([]int) string { return "" },
+			dig.ParamGroup(0, "ints"), dig.ParamName(0, "x"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot use named values with value groups")
+	})
+
+	t.Run("parameter objects", func(t *testing.T) {
+		t.Parallel()
+
+		type params struct {
+			dig.In
+
+			S string
+		}
+		c := digtest.New(t)
+		err := c.Invoke(func(params) {}, dig.ParamName(0, "rw"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "is a parameter object")
+	})
+
+	t.Run("String", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, `ParamName(0, "rw")`, fmt.Sprint(dig.ParamName(0, "rw")))
+		assert.Equal(t, `ParamGroup(2, "handlers")`, fmt.Sprint(dig.ParamGroup(2, "handlers")))
+	})
+}
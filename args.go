@@ -0,0 +1,60 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import "reflect"
+
+// ResolveArgs instantiates the dependencies of the given function like
+// Invoke, but returns the arguments that the function would be called with
+// instead of calling it.
+//
+//	args, err := c.ResolveArgs(runReport)
+//	// ...
+//	for range ticker.C {
+//	  reflect.ValueOf(runReport).Call(args)
+//	}
+//
+// This allows functions to be called repeatedly, or by frameworks that
+// manage their own call sites, without resolving their dependencies again.
+// Arguments that are dig.In structs are returned as single values.
+func (c *Container) ResolveArgs(function interface{}, opts ...InvokeOption) ([]reflect.Value, error) {
+	return c.scope.ResolveArgs(function, opts...)
+}
+
+// ResolveArgs instantiates the dependencies of the given function like
+// Invoke, but returns the arguments that the function would be called with
+// instead of calling it.
+func (s *Scope) ResolveArgs(function interface{}, opts ...InvokeOption) ([]reflect.Value, error) {
+	var options invokeOptions
+	for _, o := range opts {
+		o.applyInvokeOption(&options)
+	}
+
+	var resolved []reflect.Value
+	options.invoker = func(_ reflect.Value, args []reflect.Value) []reflect.Value {
+		resolved = args
+		return nil
+	}
+	if _, err := s.invoke(function, options); err != nil {
+		return nil, err
+	}
+	return resolved, nil
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestResolveArgs(t *testing.T) {
+	t.Parallel()
+
+	type A struct{ N int }
+	type B struct{ S string }
+
+	t.Run("resolves without calling", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{N: 1} })
+		c.RequireProvide(func() B { return B{S: "b"} })
+
+		var called bool
+		fn := func(a *A, b B) { called = true }
+		args, err := c.ResolveArgs(fn)
+		require.NoError(t, err)
+		assert.False(t, called)
+		require.Len(t, args, 2)
+		assert.Equal(t, 1, args[0].Interface().(*A).N)
+		assert.Equal(t, "b", args[1].Interface().(B).S)
+
+		reflect.ValueOf(fn).Call(args)
+		assert.True(t, called)
+	})
+
+	t.Run("param objects", func(t *testing.T) {
+		type params struct {
+			dig.In
+
+			A *A
+			B *B `optional:"true"`
+		}
+
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{N: 1} })
+
+		args, err := c.ResolveArgs(func(params) {})
+		require.NoError(t, err)
+		require.Len(t, args, 1)
+		p := args[0].Interface().(params)
+		assert.Equal(t, 1, p.A.N)
+		assert.Nil(t, p.B)
+	})
+
+	t.Run("constructor fails", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() (*A, error) { return nil, errors.New("great sadness") })
+
+		_, err := c.ResolveArgs(func(*A) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "great sadness")
+	})
+
+	t.Run("missing dependency", func(t *testing.T) {
+		c := digtest.New(t)
+
+		_, err := c.ResolveArgs(func(*A) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing type: *dig_test.A")
+	})
+
+	t.Run("not a function", func(t *testing.T) {
+		c := digtest.New(t)
+
+		_, err := c.ResolveArgs(42)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "can't invoke non-function")
+	})
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import "errors"
+
+// Build calls all constructors provided with the Eager option that have not
+// been called yet, along with their dependencies, in the Container and all
+// of its Scopes.
+//
+// Use Build during application startup to surface failures of eager
+// constructors immediately rather than at their first use by Invoke. Build
+// attempts every eager constructor even if some of them fail, and returns an
+// error aggregating all failures.
+//
+// Build also reports every constructor in the container or any of its
+// Scopes that claims a resource already claimed by another constructor.
+// See Claims.
+//
+// Failures in a named Scope are grouped under that Scope, so that a single
+// call reports every misconfigured Scope. Use ErrorsByScope to inspect
+// them.
+func (c *Container) Build() error {
+	return c.scope.Build()
+}
+
+// Build calls all constructors provided with the Eager option that have not
+// been called yet, along with their dependencies, in this Scope and all of
+// its descendants.
+//
+// See Container.Build for details.
+func (s *Scope) Build() error {
+	defer s.lock()()
+
+	errs := s.rootScope().claimConflicts()
+	for _, scope := range s.appendSubscopes(nil) {
+		if err := scope.buildEager(); err != nil {
+			errs = append(errs, scope.wrapScopeError(err))
+		}
+	}
+	return newErrMultiple(errs)
+}
+
+// buildEager calls the eager constructors of this Scope, not including its
+// descendants.
+func (s *Scope) buildEager() error {
+	if err := s.checkAcyclic(); err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, n := range s.nodes {
+		if !n.eager || n.called {
+			continue
+		}
+		// Constructors waiting on values declared with Extern are
+		// left to be called once those values are provided.
+		if err := n.build(); err != nil && !errors.As(err, new(errExternNotSupplied)) {
+			errs = append(errs, err)
+		}
+	}
+	return newErrMultiple(errs)
+}
+
+// build calls the constructor on behalf of Build.
+func (n *constructorNode) build() error {
+	var k key
+	if results := n.resultList.DotResult(); len(results) > 0 {
+		k = key{t: results[0].Type, name: results[0].Name, group: results[0].Group}
+	}
+
+	s := n.OrigScope()
+	defer s.pushResolveFrame(resolveFrame{Key: k})()
+	return n.Call(s)
+}
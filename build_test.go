@@ -0,0 +1,171 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestBuild(t *testing.T) {
+	t.Parallel()
+
+	type A struct{}
+	type B struct{}
+	type C struct{}
+
+	t.Run("calls eager constructors", func(t *testing.T) {
+		c := digtest.New(t)
+
+		var calls []string
+		c.RequireProvide(func() *A {
+			calls = append(calls, "A")
+			return &A{}
+		})
+		c.RequireProvide(func(*A) *B {
+			calls = append(calls, "B")
+			return &B{}
+		}, dig.Eager())
+		c.RequireProvide(func() *C {
+			calls = append(calls, "C")
+			return &C{}
+		})
+
+		require.NoError(t, c.Build())
+		assert.Equal(t, []string{"A", "B"}, calls)
+
+		// Eager constructors are still called at most once.
+		require.NoError(t, c.Build())
+		c.RequireInvoke(func(*B) {})
+		assert.Equal(t, []string{"A", "B"}, calls)
+	})
+
+	t.Run("reports all failures", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() (*A, error) {
+			return nil, errors.New("great sadness")
+		}, dig.Eager())
+		c.RequireProvide(func(string) *B { return &B{} }, dig.Eager())
+
+		var called bool
+		c.RequireProvide(func() *C {
+			called = true
+			return &C{}
+		}, dig.Eager())
+
+		err := c.Build()
+		require.Error(t, err)
+		assert.True(t, called, "failures must not stop other eager constructors")
+		dig.AssertErrorMatches(t, err,
+			"2 errors occurred:",
+			`received non-nil error from function "go.uber.org/dig_test".TestBuild.\S+`,
+			"great sadness",
+			`missing dependencies for function "go.uber.org/dig_test".TestBuild.\S+`,
+			"missing type:",
+			"string",
+		)
+	})
+
+	t.Run("groups failures by scope", func(t *testing.T) {
+		c := digtest.New(t, dig.Compatibility(dig.CompatExtended))
+		c.RequireProvide(func(string) *A { return &A{} }, dig.Eager())
+
+		api := c.Scope("api")
+		api.RequireProvide(func(int) *B { return &B{} }, dig.Eager())
+		api.RequireProvide(func() (*C, error) {
+			return nil, errors.New("great sadness")
+		}, dig.Eager())
+		handlers := api.Scope("handlers")
+		handlers.RequireProvide(func(bool) *C { return &C{} }, dig.Eager())
+		c.Scope("healthy").RequireProvide(func() *B { return &B{} }, dig.Eager())
+
+		err := c.Build()
+		require.Error(t, err)
+		dig.AssertErrorMatches(t, err,
+			"3 errors occurred:",
+			"missing type:", "string",
+			`in scope "api":`, "2 errors occurred:",
+			`in scope "api/handlers":`, "missing type:", "bool",
+		)
+
+		byScope := dig.ErrorsByScope(err)
+		assert.Len(t, byScope, 3)
+		assert.Len(t, byScope[""], 1)
+		assert.Len(t, byScope["api"], 2)
+		assert.Len(t, byScope["api/handlers"], 1)
+		assert.Contains(t, byScope["api"][1].Error(), "great sadness")
+
+		assert.Nil(t, dig.ErrorsByScope(nil))
+	})
+
+	t.Run("scopes", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{} })
+
+		var built bool
+		child := c.Scope("child")
+		child.RequireProvide(func(*A) *B {
+			built = true
+			return &B{}
+		}, dig.Eager())
+
+		require.NoError(t, c.Build())
+		assert.True(t, built)
+	})
+
+	t.Run("scope only builds its subtree", func(t *testing.T) {
+		c := digtest.New(t)
+
+		var calls []string
+		c.RequireProvide(func() *A {
+			calls = append(calls, "A")
+			return &A{}
+		}, dig.Eager())
+		child := c.Scope("child")
+		child.RequireProvide(func() *B {
+			calls = append(calls, "B")
+			return &B{}
+		}, dig.Eager())
+
+		require.NoError(t, child.Build())
+		assert.Equal(t, []string{"B"}, calls)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		c := digtest.New(t, dig.DeferAcyclicVerification())
+		c.RequireProvide(func(*B) *A { return &A{} }, dig.Eager())
+		c.RequireProvide(func(*A) *B { return &B{} })
+
+		err := c.Build()
+		require.Error(t, err)
+		assert.True(t, dig.IsCycleDetected(err))
+	})
+
+	t.Run("Eager string", func(t *testing.T) {
+		assert.Equal(t, "Eager()", fmt.Sprint(dig.Eager()))
+	})
+}
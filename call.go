@@ -0,0 +1,85 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Call runs the given function like Invoke and returns its result.
+// See CallScope.
+func Call[T any](c *Container, function interface{}, opts ...InvokeOption) (T, error) {
+	return CallScope[T](c.scope, function, opts...)
+}
+
+// CallScope runs the given function like Scope.Invoke and returns its
+// result instead of discarding it. The function must return a T as its
+// first result, and may return an error as its last result.
+//
+//	srv, err := dig.Call[*http.Server](c, func(cfg *Config, h http.Handler) (*http.Server, error) {
+//	  return newServer(cfg.Addr, h)
+//	})
+//
+// If the function fails, its error is returned along with its T result
+// as-is. With ValidateOnly, the function isn't called, and the zero value
+// of T is returned along with the validation error, if any.
+func CallScope[T any](s *Scope, function interface{}, opts ...InvokeOption) (result T, err error) {
+	ftype := reflect.TypeOf(function)
+	if ftype != nil && ftype.Kind() == reflect.Func {
+		if err := validateCallFunc(ftype, reflect.TypeOf((*T)(nil)).Elem()); err != nil {
+			return result, err
+		}
+	}
+
+	var options invokeOptions
+	for _, o := range opts {
+		o.applyInvokeOption(&options)
+	}
+
+	returned, err := s.invoke(function, options)
+	if err != nil || len(returned) == 0 {
+		return result, err
+	}
+
+	// A nil interface result can't be asserted to T, but the zero value
+	// of T is nil as well.
+	result, _ = returned[0].Interface().(T)
+	if len(returned) > 1 {
+		err, _ = returned[1].Interface().(error)
+	}
+	return result, err
+}
+
+// validateCallFunc checks that a function passed to Call returns a value of
+// the given type as its first result.
+func validateCallFunc(ftype, t reflect.Type) error {
+	if ftype.NumOut() > 0 && ftype.Out(0) == t {
+		switch {
+		case ftype.NumOut() == 1:
+			return nil
+		case ftype.NumOut() == 2 && isError(ftype.Out(1)):
+			return nil
+		}
+	}
+	return newErrInvalidInput(fmt.Sprintf(
+		"%v must return %v as its first result, optionally followed by an error", ftype, t), nil)
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestCall(t *testing.T) {
+	t.Parallel()
+
+	type A struct{ name string }
+	type B struct{ a *A }
+
+	t.Run("returns result", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{name: "a"} })
+
+		b, err := dig.Call[*B](c.Container, func(a *A) *B { return &B{a: a} })
+		require.NoError(t, err)
+		assert.Equal(t, "a", b.a.name)
+	})
+
+	t.Run("returns error", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{} })
+
+		b, err := dig.Call[*B](c.Container, func(a *A) (*B, error) {
+			return &B{a: a}, errors.New("great sadness")
+		})
+		assert.EqualError(t, err, "great sadness")
+		assert.NotNil(t, b, "result must be returned as-is")
+	})
+
+	t.Run("interface result", func(t *testing.T) {
+		c := digtest.New(t)
+
+		s, err := dig.Call[fmt.Stringer](c.Container, func() fmt.Stringer { return nil })
+		require.NoError(t, err)
+		assert.Nil(t, s)
+	})
+
+	t.Run("scope", func(t *testing.T) {
+		c := digtest.New(t)
+		child := c.Container.Scope("child")
+		require.NoError(t, child.Provide(func() *A { return &A{name: "child"} }))
+
+		name, err := dig.CallScope[string](child, func(a *A) string { return a.name })
+		require.NoError(t, err)
+		assert.Equal(t, "child", name)
+	})
+
+	t.Run("missing dependency", func(t *testing.T) {
+		c := digtest.New(t)
+
+		_, err := dig.Call[*B](c.Container, func(a *A) *B { return &B{a: a} })
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing type: *dig_test.A")
+	})
+
+	t.Run("wrong result type", func(t *testing.T) {
+		c := digtest.New(t)
+
+		_, err := dig.Call[*B](c.Container, func() *A { return nil })
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must return *dig_test.B as its first result")
+	})
+
+	t.Run("ValidateOnly", func(t *testing.T) {
+		c := digtest.New(t)
+		fn := func(a *A) *B {
+			t.Fatal("this function must not be called")
+			return nil
+		}
+
+		_, err := dig.Call[*B](c.Container, fn, dig.ValidateOnly())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing type: *dig_test.A")
+
+		c.RequireProvide(func() *A { return &A{} })
+		b, err := dig.Call[*B](c.Container, fn, dig.ValidateOnly())
+		require.NoError(t, err)
+		assert.Nil(t, b)
+	})
+
+	t.Run("too many results", func(t *testing.T) {
+		c := digtest.New(t)
+
+		_, err := dig.Call[*A](c.Container, func() (*A, *B, error) { return nil, nil, nil })
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "optionally followed by an error")
+	})
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"reflect"
+
+	"go.uber.org/dig/internal/digreflect"
+)
+
+// CallInfo describes what a constructor is being called for. Constructors
+// may accept a CallInfo parameter to tailor the values that they produce
+// to their consumer, such as metrics prefixed with the package that uses
+// them.
+//
+//	c.Provide(func(reg *Registry, info dig.CallInfo) *Metrics {
+//	  return reg.Sub(info.Consumer.Package)
+//	}, dig.Transient())
+//
+// Values are shared by all their consumers unless they're provided with
+// Transient, so other constructors see only the consumer that requested
+// their values first.
+//
+// A CallInfo parameter is filled in only if the container has no provider
+// of CallInfo. It's not available to invoked functions or decorators.
+type CallInfo struct {
+	// Constructor or invoked function whose parameter the constructor is
+	// called for. Unset if the constructor is called by Build.
+	Consumer Location
+
+	// Names of the Scopes from the root to the Scope that the consumer was
+	// provided to or invoked in, separated by "/". Unnamed Scopes are
+	// omitted.
+	Scope string
+
+	// Trace ID of the innermost Invoke that the constructor is called for,
+	// if it was given one with TraceID or ContextWithTraceID.
+	TraceID string
+}
+
+// _callInfoType is the type of CallInfo.
+var _callInfoType = reflect.TypeOf(CallInfo{})
+
+// caller is a function whose parameters are being built.
+type caller struct {
+	// Location of the constructor. Unset for invoked functions.
+	Func *digreflect.Func
+
+	// Scope that the function was provided to or invoked in.
+	Scope *Scope
+}
+
+// pushCaller records that the parameters of the given function are being
+// built and returns a function that must be called once they're done.
+func (s *Scope) pushCaller(c caller) (pop func()) {
+	r, leave := s.enterResolution()
+	r.callers = append(r.callers, c)
+	n := len(r.callers)
+	return func() {
+		r.callers = r.callers[:n-1]
+		leave()
+	}
+}
+
+func (s *Scope) callInfo() (CallInfo, bool) {
+	r := s.resolution()
+	if r == nil {
+		return CallInfo{}, false
+	}
+	callers := r.callers
+	if len(callers) == 0 || callers[len(callers)-1].Func == nil {
+		return CallInfo{}, false
+	}
+
+	path := s.resolutionPath()
+	info := CallInfo{TraceID: path.traceID()}
+	if len(callers) == 1 {
+		return info, true
+	}
+	consumer := callers[len(callers)-2]
+	info.Scope = consumer.Scope.path()
+	if consumer.Func != nil {
+		info.Consumer = newLocation(consumer.Func)
+	} else {
+		info.Consumer = newLocation(path.invoke())
+	}
+	return info, true
+}
+
+// isCallInfo reports whether this is an unnamed CallInfo parameter.
+func (ps paramSingle) isCallInfo() bool {
+	return ps.Name == "" && ps.Type == _callInfoType
+}
+
+// callInfo returns the CallInfo of the constructor that this parameter is
+// built for if this is an unnamed CallInfo parameter without a provider.
+func (ps paramSingle) callInfo(c containerStore) (reflect.Value, bool) {
+	if !ps.isCallInfo() {
+		return _noValue, false
+	}
+	info, ok := c.callInfo()
+	if !ok {
+		return _noValue, false
+	}
+	return reflect.ValueOf(info), true
+}
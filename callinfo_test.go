@@ -0,0 +1,94 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+type callInfoMetrics struct{ info dig.CallInfo }
+
+type callInfoServer struct{ m *callInfoMetrics }
+
+func newCallInfoServer(m *callInfoMetrics) *callInfoServer {
+	return &callInfoServer{m: m}
+}
+
+func TestCallInfo(t *testing.T) {
+	t.Parallel()
+
+	newMetrics := func(info dig.CallInfo) *callInfoMetrics {
+		return &callInfoMetrics{info: info}
+	}
+
+	t.Run("consumer is a constructor", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(newMetrics)
+		c.RequireProvide(newCallInfoServer)
+		c.RequireInvoke(func(s *callInfoServer) {
+			info := s.m.info
+			assert.Equal(t, "newCallInfoServer", info.Consumer.Name)
+			assert.Equal(t, "go.uber.org/dig_test", info.Consumer.Package)
+			assert.Empty(t, info.Scope)
+			assert.Equal(t, "trace-1", info.TraceID)
+		}, dig.TraceID("trace-1"))
+	})
+
+	t.Run("consumer is an invoked function", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(newMetrics, dig.Transient())
+		child := c.Scope("child")
+		require.NoError(t, child.Invoke(func(m *callInfoMetrics) {
+			assert.Contains(t, m.info.Consumer.Name, "TestCallInfo")
+			assert.Equal(t, "child", m.info.Scope)
+			assert.Equal(t, "trace-1", m.info.TraceID)
+		}, dig.TraceID("trace-1")))
+	})
+
+	t.Run("unavailable to invoked functions", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		err := c.Invoke(func(dig.CallInfo) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing type: dig.CallInfo")
+	})
+
+	t.Run("provided CallInfo wins", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() dig.CallInfo { return dig.CallInfo{TraceID: "provided"} })
+		c.RequireProvide(newMetrics)
+		c.RequireInvoke(func(m *callInfoMetrics) {
+			assert.Equal(t, "provided", m.info.TraceID)
+		}, dig.TraceID("trace-1"))
+	})
+}
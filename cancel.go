@@ -0,0 +1,107 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"go.uber.org/dig/internal/digreflect"
+)
+
+// checkCanceled returns an error if the context given to the InvokeContext
+// being resolved is done. Constructors, decorators, and invoked functions
+// are not called once it is.
+func (s *Scope) checkCanceled() error {
+	ctx := s.resolutionPath().context()
+	if ctx == nil || ctx.Err() == nil {
+		return nil
+	}
+	return &errCanceled{Last: s.resolution().lastCalled, Reason: ctx.Err()}
+}
+
+// skipCanceled records in err, if it was caused by a canceled context,
+// the constructors that the given parameters depend on that were not
+// called.
+func (s *Scope) skipCanceled(err error, pl paramList) {
+	var ce *errCanceled
+	if !errors.As(err, &ce) || ce.Skipped != nil {
+		return
+	}
+
+	ce.Skipped = []*digreflect.Func{}
+	walkDependencies(s, pl, func(s *Scope, p param, n *constructorNode) bool {
+		if ps, ok := p.(paramSingle); ok {
+			for _, a := range s.ancestors() {
+				if _, ok := a.getValue(ps.Name, ps.Type); ok {
+					return false
+				}
+			}
+		}
+		if n.called {
+			return false
+		}
+		ce.Skipped = append(ce.Skipped, n.location)
+		return true
+	})
+}
+
+// errCanceled is returned when the context given to InvokeContext is done
+// before all constructors needed by the invoked function were called.
+type errCanceled struct {
+	// Constructor called last on behalf of the Invoke, if any. It was
+	// running or had just returned when the context was done.
+	Last *digreflect.Func
+
+	// Constructors needed by the Invoke that were not called.
+	Skipped []*digreflect.Func
+
+	// Error reported by the context.
+	Reason error
+}
+
+var _ digError = (*errCanceled)(nil)
+
+func (e *errCanceled) Error() string { return fmt.Sprint(e) }
+
+func (e *errCanceled) Unwrap() error { return e.Reason }
+
+func (e *errCanceled) writeMessage(w io.Writer, verb string) {
+	io.WriteString(w, "resolution aborted")
+	if e.Last != nil {
+		fmt.Fprintf(w, " after calling "+verb, e.Last)
+	} else {
+		io.WriteString(w, " before calling any constructors")
+	}
+	if len(e.Skipped) > 0 {
+		skipped := make([]string, len(e.Skipped))
+		for i, f := range e.Skipped {
+			skipped[i] = fmt.Sprintf(verb, f)
+		}
+		fmt.Fprintf(w, ", skipping %v", strings.Join(skipped, "; "))
+	}
+}
+
+func (e *errCanceled) Format(w fmt.State, c rune) {
+	formatError(e, w, c)
+}
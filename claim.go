@@ -0,0 +1,135 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"go.uber.org/dig/internal/digreflect"
+)
+
+// Claims is a ProvideOption that declares that a constructor acquires
+// exclusive resources, such as a network port or a lock file, identified by
+// the given names.
+//
+//	c.Provide(NewHTTPServer, dig.Claims("port:8080"))
+//
+// At most one constructor in a container and all of its Scopes may claim a
+// resource. Claims are advisory: dig doesn't acquire the resources, but it
+// reports constructors competing for them. Container.Build reports all
+// constructors that claim a resource claimed by an earlier one, and
+// calling a constructor that claims a resource held by another constructor
+// that was already called fails without calling it.
+func Claims(resources ...string) ProvideOption {
+	return provideClaimsOption(resources)
+}
+
+type provideClaimsOption []string
+
+func (o provideClaimsOption) String() string {
+	quoted := make([]string, len(o))
+	for i, r := range o {
+		quoted[i] = fmt.Sprintf("%q", r)
+	}
+	return fmt.Sprintf("Claims(%v)", strings.Join(quoted, ", "))
+}
+
+func (o provideClaimsOption) applyProvideOption(opts *provideOptions) {
+	opts.Claims = append(opts.Claims, o...)
+}
+
+// checkClaims verifies that none of the resources claimed by the given
+// constructor are held by another constructor.
+func (s *Scope) checkClaims(n *constructorNode) error {
+	held := s.rootScope().claims
+	for _, r := range n.claims {
+		if h, ok := held[r]; ok && h != n {
+			return errClaimConflict{Resource: r, Func: n.location, Holder: h.location}
+		}
+	}
+	return nil
+}
+
+// holdClaims records that the given constructor, which was just called,
+// holds the resources it claims.
+func (s *Scope) holdClaims(n *constructorNode) {
+	if len(n.claims) == 0 {
+		return
+	}
+	root := s.rootScope()
+	if root.claims == nil {
+		root.claims = make(map[string]*constructorNode)
+	}
+	for _, r := range n.claims {
+		root.claims[r] = n
+	}
+}
+
+// claimConflicts returns an error for each constructor in this Scope and its
+// descendants that claims a resource claimed by a constructor before it.
+func (s *Scope) claimConflicts() []error {
+	var (
+		errs     []error
+		claimant = make(map[string]*constructorNode)
+		seen     = make(map[*constructorNode]struct{})
+	)
+	for _, scope := range s.appendSubscopes(nil) {
+		for _, n := range scope.nodes {
+			// Exported constructors are listed by several Scopes.
+			if _, ok := seen[n]; ok || n.removed {
+				continue
+			}
+			seen[n] = struct{}{}
+
+			for _, r := range n.claims {
+				if c, ok := claimant[r]; ok {
+					errs = append(errs, errClaimConflict{Resource: r, Func: n.location, Holder: c.location})
+					continue
+				}
+				claimant[r] = n
+			}
+		}
+	}
+	return errs
+}
+
+// errClaimConflict is returned when a constructor claims a resource that is
+// claimed by another constructor.
+type errClaimConflict struct {
+	Resource string
+	Func     *digreflect.Func
+	Holder   *digreflect.Func
+}
+
+var _ digError = errClaimConflict{}
+
+func (e errClaimConflict) Error() string { return fmt.Sprint(e) }
+
+func (e errClaimConflict) writeMessage(w io.Writer, verb string) {
+	fmt.Fprintf(w, "function "+verb+" cannot claim %q: already claimed by "+verb,
+		e.Func, e.Resource, e.Holder)
+}
+
+func (e errClaimConflict) Format(w fmt.State, c rune) {
+	formatError(e, w, c)
+}
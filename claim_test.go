@@ -0,0 +1,92 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestClaims(t *testing.T) {
+	t.Parallel()
+
+	type A struct{}
+	type B struct{}
+
+	t.Run("build reports conflicts across scopes", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{} }, dig.Claims("port:8080", "lock:a"))
+		child := c.Scope("child")
+		child.RequireProvide(func() *B { return &B{} }, dig.Claims("port:8080"))
+
+		err := c.Build()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `cannot claim "port:8080": already claimed by`)
+		assert.NotContains(t, err.Error(), "lock:a")
+	})
+
+	t.Run("build without conflicts", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{} }, dig.Claims("port:8080"))
+		c.RequireProvide(func() *B { return &B{} }, dig.Claims("port:9090"))
+
+		assert.NoError(t, c.Build())
+	})
+
+	t.Run("second claimant is not called", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{} }, dig.Claims("port:8080"))
+		c.RequireProvide(func() *B {
+			t.Fatal("constructor must not be called")
+			return nil
+		}, dig.Claims("port:8080"))
+
+		c.RequireInvoke(func(*A) {})
+		err := c.Invoke(func(*B) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `cannot claim "port:8080"`)
+	})
+
+	t.Run("transient constructor keeps its claim", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{} }, dig.Claims("lock"), dig.Transient())
+
+		c.RequireInvoke(func(*A) {})
+		c.RequireInvoke(func(*A) {})
+	})
+
+	t.Run("empty resource", func(t *testing.T) {
+		c := digtest.New(t)
+
+		err := c.Provide(func() *A { return &A{} }, dig.Claims(""))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "resource names cannot be empty")
+	})
+
+	t.Run("String", func(t *testing.T) {
+		assert.Equal(t, `Claims("port:8080", "lock")`, fmt.Sprint(dig.Claims("port:8080", "lock")))
+	})
+}
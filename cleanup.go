@@ -0,0 +1,271 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"go.uber.org/dig/internal/digreflect"
+)
+
+// teardown releases a resource held by the values produced by a single
+// constructor call. Exactly one of Cleanup or Closer is set.
+type teardown struct {
+	// Key under which the value being closed was provided. Unset for
+	// cleanup functions.
+	Key key
+
+	// Constructor that produced the value or returned the cleanup
+	// function.
+	Func *digreflect.Func
+
+	// Node of that constructor. Unlike Func, it identifies the
+	// constructor even if others were provided with the same location.
+	Node *constructorNode
+
+	// Cleanup function returned by the constructor.
+	Cleanup func()
+
+	// Value produced by the constructor that implements io.Closer.
+	Closer io.Closer
+
+	// Time allowed for this teardown in Shutdown. Zero means no limit.
+	Timeout time.Duration
+}
+
+func (td teardown) run() error {
+	if td.Closer != nil {
+		return td.Closer.Close()
+	}
+	td.Cleanup()
+	return nil
+}
+
+func (td teardown) isCleanup() bool { return td.Cleanup != nil }
+
+func (td teardown) isCloser() bool { return td.Closer != nil }
+
+// takeTeardowns removes the teardowns matching the given predicate from
+// this Scope and returns them in the reverse order in which they were
+// registered. Teardowns are held by the root Scope, or by request Scopes
+// for request-scoped values.
+func (s *Scope) takeTeardowns(match func(teardown) bool) []teardown {
+	defer s.lock()()
+	return s.takeTeardownsLocked(match)
+}
+
+// takeTeardownsLocked is takeTeardowns for callers that hold the lock.
+func (s *Scope) takeTeardownsLocked(match func(teardown) bool) []teardown {
+	var taken []teardown
+	kept := s.teardowns[:0]
+	for _, td := range s.teardowns {
+		if match(td) {
+			taken = append(taken, td)
+		} else {
+			kept = append(kept, td)
+		}
+	}
+	s.teardowns = kept
+
+	for i, j := 0, len(taken)-1; i < j; i, j = i+1, j-1 {
+		taken[i], taken[j] = taken[j], taken[i]
+	}
+	return taken
+}
+
+// Cleanup runs the cleanup functions returned by constructors that have
+// been called so far, in the reverse order in which those constructors
+// were called.
+//
+// Constructors provided with the ReturnsCleanup option may return a
+// cleanup function after all other values, and optionally before an
+// error, in the style of google/wire:
+//
+//	func NewFile(cfg *Config) (*os.File, func(), error) {
+//	  f, err := os.Open(cfg.Path)
+//	  if err != nil {
+//	    return nil, nil, err
+//	  }
+//	  return f, func() { f.Close() }, nil
+//	}
+//
+//	c.Provide(NewFile, dig.ReturnsCleanup())
+//
+// Cleanup functions are not made available to the container as values.
+// Functions returned alongside a non-nil error are ignored.
+//
+// Each cleanup function is run at most once: Cleanup forgets the functions
+// it ran. Values that were already constructed remain in the container.
+func (c *Container) Cleanup() {
+	for _, td := range c.scope.takeTeardowns(teardown.isCleanup) {
+		td.Cleanup()
+	}
+}
+
+// Close closes the values already constructed by the container that
+// implement io.Closer, in the reverse order in which their constructors were
+// called. Since a constructor always runs after its dependencies, a value is
+// closed before any of the values it was built from.
+//
+// Close attempts to close every value even if some of them fail, and
+// returns an error aggregating all failures. Each value is closed at most
+// once: Close forgets the values it closed. Values remain available in the
+// container afterwards.
+//
+// Use the SkipClose option to exclude values produced by a constructor.
+// Close does not run cleanup functions; see Cleanup for those.
+func (c *Container) Close() error {
+	return c.scope.closeTeardowns(teardown.isCloser)
+}
+
+// closeTeardowns runs the teardowns matching the given predicate and
+// aggregates their failures.
+func (s *Scope) closeTeardowns(match func(teardown) bool) error {
+	return runTeardowns(s.takeTeardowns(match))
+}
+
+// runTeardowns runs the given teardowns in order and aggregates their
+// failures.
+func runTeardowns(tds []teardown) error {
+	var errs []error
+	for _, td := range tds {
+		if err := td.run(); err != nil {
+			errs = append(errs, errTeardownFailed{
+				Key:    td.Key,
+				Func:   td.Func,
+				Reason: err,
+			})
+		}
+	}
+	return newErrMultiple(errs)
+}
+
+// ShutdownReport describes the values that Container.Shutdown could not tear
+// down cleanly.
+type ShutdownReport struct {
+	// Failed lists values that failed to close.
+	Failed []TeardownResult
+
+	// TimedOut lists values whose teardown did not complete in time,
+	// including those that were not attempted because the context passed
+	// to Shutdown had already expired.
+	TimedOut []TeardownResult
+}
+
+// TeardownResult describes the teardown of a single value in a
+// ShutdownReport.
+type TeardownResult struct {
+	// Location of the constructor that produced the value.
+	Location Location
+
+	// Output that was being closed, or nil if this was the cleanup
+	// function returned by the constructor.
+	Output *Output
+
+	// Err is the error returned by Close, or the context error if the
+	// teardown timed out.
+	Err error
+}
+
+// Shutdown tears down the values already constructed by the container,
+// running both cleanup functions returned by constructors and Close on
+// values that implement io.Closer. Values are torn down in the reverse
+// order in which their constructors were called, as with Cleanup and
+// Close.
+//
+// Shutdown stops waiting for a value once the given context expires, or
+// once the timeout set with the ShutdownTimeout option on its constructor
+// elapses, and moves on to the next value. Once the context has expired,
+// remaining values are not attempted.
+//
+// To stop waiting, Shutdown runs each cleanup function or Close call that
+// may time out on a new goroutine. One that times out is abandoned rather
+// than stopped: it keeps running in the background, concurrently with the
+// teardown of the values that follow it, and its outcome is never
+// reported. Cleanup functions and Close methods that may time out must be
+// safe to run alongside each other.
+//
+// The returned report lists the values that failed or timed out. If there
+// were any, Shutdown also returns an error aggregating them.
+func (c *Container) Shutdown(ctx context.Context) (ShutdownReport, error) {
+	var (
+		report ShutdownReport
+		errs   []error
+	)
+	for _, td := range c.scope.takeTeardowns(func(teardown) bool { return true }) {
+		err := runTeardown(ctx, td)
+		if err == nil {
+			continue
+		}
+
+		res := TeardownResult{
+			Location: newLocation(td.Func),
+			Err:      err,
+		}
+		if td.Key.t != nil {
+			res.Output = &Output{t: td.Key.t, name: td.Key.name, group: td.Key.group}
+		}
+		if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+			report.TimedOut = append(report.TimedOut, res)
+		} else {
+			report.Failed = append(report.Failed, res)
+		}
+		errs = append(errs, errTeardownFailed{
+			Key:    td.Key,
+			Func:   td.Func,
+			Reason: err,
+		})
+	}
+	return report, newErrMultiple(errs)
+}
+
+// runTeardown runs the given teardown, giving up once the context or the
+// teardown's own timeout expires. The teardown then keeps running on its
+// own goroutine, which is left behind.
+func runTeardown(ctx context.Context, td teardown) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if td.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, td.Timeout)
+		defer cancel()
+	}
+
+	if ctx.Done() == nil {
+		// The context can never expire. Don't bother with a goroutine.
+		return td.run()
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- td.run() }()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,407 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestCleanup(t *testing.T) {
+	t.Parallel()
+
+	type A struct{}
+	type B struct{}
+
+	t.Run("reverse construction order", func(t *testing.T) {
+		c := digtest.New(t)
+
+		var calls []string
+		c.RequireProvide(func() (*A, func()) {
+			return &A{}, func() { calls = append(calls, "A") }
+		}, dig.ReturnsCleanup())
+		c.RequireProvide(func(*A) (*B, func(), error) {
+			return &B{}, func() { calls = append(calls, "B") }, nil
+		}, dig.ReturnsCleanup())
+
+		c.RequireInvoke(func(*B) {})
+		assert.Empty(t, calls)
+
+		c.Cleanup()
+		assert.Equal(t, []string{"B", "A"}, calls)
+
+		// Cleanup functions run only once.
+		c.Cleanup()
+		assert.Equal(t, []string{"B", "A"}, calls)
+	})
+
+	t.Run("not a value in the container", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() (*A, func()) { return &A{}, func() {} }, dig.ReturnsCleanup())
+
+		err := c.Invoke(func(func()) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing type: func()")
+	})
+
+	t.Run("constructors not called", func(t *testing.T) {
+		c := digtest.New(t)
+
+		var called bool
+		c.RequireProvide(func() (*A, func()) {
+			return &A{}, func() { called = true }
+		}, dig.ReturnsCleanup())
+		c.Cleanup()
+		assert.False(t, called)
+	})
+
+	t.Run("constructor failed", func(t *testing.T) {
+		c := digtest.New(t)
+
+		var called bool
+		c.RequireProvide(func() (*A, func(), error) {
+			return nil, func() { called = true }, errors.New("great sadness")
+		}, dig.ReturnsCleanup())
+		require.Error(t, c.Invoke(func(*A) {}))
+
+		c.Cleanup()
+		assert.False(t, called)
+	})
+
+	t.Run("nil cleanup", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() (*A, func()) { return &A{}, nil }, dig.ReturnsCleanup())
+		c.RequireInvoke(func(*A) {})
+		assert.NotPanics(t, c.Cleanup)
+	})
+
+	t.Run("scoped constructors", func(t *testing.T) {
+		c := digtest.New(t)
+
+		var calls []string
+		c.RequireProvide(func() (*A, func()) {
+			return &A{}, func() { calls = append(calls, "A") }
+		}, dig.ReturnsCleanup())
+		child := c.Scope("child")
+		child.RequireProvide(func(*A) (*B, func()) {
+			return &B{}, func() { calls = append(calls, "B") }
+		}, dig.ReturnsCleanup())
+		child.RequireInvoke(func(*B) {})
+
+		c.Cleanup()
+		assert.Equal(t, []string{"B", "A"}, calls)
+	})
+
+	t.Run("only func result is a value", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() func() { return func() {} })
+		c.RequireInvoke(func(f func()) {
+			assert.NotNil(t, f)
+		})
+	})
+
+	t.Run("func() without ReturnsCleanup", func(t *testing.T) {
+		c := digtest.New(t)
+		var cleaned bool
+		c.RequireProvide(func() (*A, func()) { return &A{}, func() { cleaned = true } })
+
+		c.RequireInvoke(func(_ *A, f func()) { f() })
+		assert.True(t, cleaned)
+
+		cleaned = false
+		c.Cleanup()
+		assert.False(t, cleaned, "func() results aren't cleanups without ReturnsCleanup")
+	})
+
+	t.Run("ReturnsCleanup without func()", func(t *testing.T) {
+		c := digtest.New(t)
+		err := c.Provide(func() *A { return &A{} }, dig.ReturnsCleanup())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot use dig.ReturnsCleanup() with a constructor that doesn't return a trailing func()")
+	})
+
+	t.Run("ReturnsCleanup string", func(t *testing.T) {
+		assert.Equal(t, "ReturnsCleanup()", fmt.Sprint(dig.ReturnsCleanup()))
+	})
+
+	t.Run("decorators return func() as a value", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() (*A, func()) { return &A{}, func() {} })
+
+		var decorated bool
+		c.RequireDecorate(func(a *A) (*A, func()) { return a, func() { decorated = true } })
+		c.RequireInvoke(func(f func()) { f() })
+		assert.True(t, decorated)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		c := digtest.New(t, dig.DryRun(true))
+		c.RequireProvide(func() (*A, func()) { return &A{}, func() {} }, dig.ReturnsCleanup())
+		c.RequireInvoke(func(*A) {})
+		assert.NotPanics(t, c.Cleanup)
+	})
+}
+
+type testCloser struct {
+	name   string
+	closed *[]string
+	err    error
+}
+
+func (c *testCloser) String() string { return c.name }
+
+func (c *testCloser) Close() error {
+	*c.closed = append(*c.closed, c.name)
+	return c.err
+}
+
+func TestClose(t *testing.T) {
+	t.Parallel()
+
+	type A struct{ io.Closer }
+	type B struct{ io.Closer }
+
+	t.Run("reverse construction order", func(t *testing.T) {
+		c := digtest.New(t)
+
+		var closed []string
+		c.RequireProvide(func() *testCloser {
+			return &testCloser{name: "first", closed: &closed}
+		})
+		c.RequireProvide(func(*testCloser) io.ReadCloser {
+			return struct {
+				io.Reader
+				io.Closer
+			}{Closer: &testCloser{name: "second", closed: &closed}}
+		})
+		c.RequireProvide(func() string { return "not a closer" })
+
+		c.RequireInvoke(func(io.ReadCloser, string) {})
+
+		require.NoError(t, c.Close())
+		assert.Equal(t, []string{"second", "first"}, closed)
+
+		// Values are closed only once.
+		require.NoError(t, c.Close())
+		assert.Equal(t, []string{"second", "first"}, closed)
+	})
+
+	t.Run("only constructed values", func(t *testing.T) {
+		c := digtest.New(t)
+
+		var closed []string
+		c.RequireProvide(func() *testCloser {
+			return &testCloser{name: "unused", closed: &closed}
+		})
+		require.NoError(t, c.Close())
+		assert.Empty(t, closed)
+	})
+
+	t.Run("As closes once", func(t *testing.T) {
+		c := digtest.New(t)
+
+		var closed []string
+		c.RequireProvide(func() *testCloser {
+			return &testCloser{name: "closer", closed: &closed}
+		}, dig.As(new(io.Closer), new(fmt.Stringer)))
+		c.RequireInvoke(func(io.Closer, fmt.Stringer) {})
+
+		require.NoError(t, c.Close())
+		assert.Equal(t, []string{"closer"}, closed)
+	})
+
+	t.Run("value groups", func(t *testing.T) {
+		c := digtest.New(t)
+
+		var closed []string
+		c.RequireProvide(func() io.Closer {
+			return &testCloser{name: "member", closed: &closed}
+		}, dig.Group("closers"))
+
+		type params struct {
+			dig.In
+
+			Closers []io.Closer `group:"closers"`
+		}
+		c.RequireInvoke(func(params) {})
+
+		require.NoError(t, c.Close())
+		assert.Equal(t, []string{"member"}, closed)
+	})
+
+	t.Run("SkipClose", func(t *testing.T) {
+		c := digtest.New(t)
+
+		var closed []string
+		c.RequireProvide(func() *testCloser {
+			return &testCloser{name: "skipped", closed: &closed}
+		}, dig.SkipClose())
+		c.RequireInvoke(func(*testCloser) {})
+
+		require.NoError(t, c.Close())
+		assert.Empty(t, closed)
+	})
+
+	t.Run("nil values", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *testCloser { return nil })
+		c.RequireInvoke(func(*testCloser) {})
+		require.NoError(t, c.Close())
+	})
+
+	t.Run("aggregates errors", func(t *testing.T) {
+		c := digtest.New(t)
+
+		var closed []string
+		c.RequireProvide(func() A {
+			return A{&testCloser{name: "A", closed: &closed, err: errors.New("sad A")}}
+		})
+		c.RequireProvide(func() B {
+			return B{&testCloser{name: "B", closed: &closed, err: errors.New("sad B")}}
+		})
+		c.RequireInvoke(func(A, B) {})
+
+		err := c.Close()
+		require.Error(t, err)
+		assert.Equal(t, []string{"B", "A"}, closed)
+		assert.Contains(t, err.Error(), "2 errors occurred")
+		assert.Contains(t, err.Error(), "failed to close dig_test.B provided by")
+		assert.Contains(t, err.Error(), "sad A")
+	})
+
+	t.Run("SkipClose string", func(t *testing.T) {
+		assert.Equal(t, "SkipClose()", fmt.Sprint(dig.SkipClose()))
+	})
+}
+
+type blockingCloser struct{ release chan struct{} }
+
+func (c *blockingCloser) Close() error {
+	<-c.release
+	return nil
+}
+
+func TestShutdown(t *testing.T) {
+	t.Parallel()
+
+	type A struct{ io.Closer }
+	type B struct{}
+
+	t.Run("tears down in reverse order", func(t *testing.T) {
+		c := digtest.New(t)
+
+		var calls []string
+		c.RequireProvide(func() A {
+			return A{&testCloser{name: "close A", closed: &calls}}
+		})
+		c.RequireProvide(func(A) (*B, func()) {
+			return &B{}, func() { calls = append(calls, "cleanup B") }
+		}, dig.ReturnsCleanup())
+		c.RequireInvoke(func(*B) {})
+
+		report, err := c.Shutdown(context.Background())
+		require.NoError(t, err)
+		assert.Empty(t, report.Failed)
+		assert.Empty(t, report.TimedOut)
+		assert.Equal(t, []string{"cleanup B", "close A"}, calls)
+
+		// Nothing left to tear down.
+		c.Cleanup()
+		require.NoError(t, c.Close())
+		assert.Equal(t, []string{"cleanup B", "close A"}, calls)
+	})
+
+	t.Run("reports failures", func(t *testing.T) {
+		c := digtest.New(t)
+
+		var calls []string
+		c.RequireProvide(func() A {
+			return A{&testCloser{name: "A", closed: &calls, err: errors.New("great sadness")}}
+		})
+		c.RequireInvoke(func(A) {})
+
+		report, err := c.Shutdown(context.Background())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to close dig_test.A provided by")
+		require.Len(t, report.Failed, 1)
+		assert.Equal(t, "dig_test.A", report.Failed[0].Output.String())
+		assert.Contains(t, report.Failed[0].Location.Name, "TestShutdown")
+		assert.EqualError(t, report.Failed[0].Err, "great sadness")
+	})
+
+	t.Run("per-provider timeout", func(t *testing.T) {
+		c := digtest.New(t)
+
+		release := make(chan struct{})
+		defer close(release)
+
+		var calls []string
+		c.RequireProvide(func() *testCloser {
+			return &testCloser{name: "after slow", closed: &calls}
+		})
+		c.RequireProvide(func(*testCloser) A {
+			return A{&blockingCloser{release: release}}
+		}, dig.ShutdownTimeout(time.Millisecond))
+		c.RequireInvoke(func(A) {})
+
+		report, err := c.Shutdown(context.Background())
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+		require.Len(t, report.TimedOut, 1)
+		assert.Equal(t, "dig_test.A", report.TimedOut[0].Output.String())
+
+		// The next value is still torn down.
+		assert.Equal(t, []string{"after slow"}, calls)
+	})
+
+	t.Run("context expired", func(t *testing.T) {
+		c := digtest.New(t)
+
+		var calls []string
+		c.RequireProvide(func() (*B, func()) {
+			return &B{}, func() { calls = append(calls, "cleanup B") }
+		}, dig.ReturnsCleanup())
+		c.RequireInvoke(func(*B) {})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		report, err := c.Shutdown(ctx)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to run cleanup function returned by")
+		require.Len(t, report.TimedOut, 1)
+		assert.Nil(t, report.TimedOut[0].Output)
+		assert.Empty(t, calls)
+	})
+
+	t.Run("ShutdownTimeout string", func(t *testing.T) {
+		assert.Equal(t, "ShutdownTimeout(5s)", fmt.Sprint(dig.ShutdownTimeout(5*time.Second)))
+	})
+}
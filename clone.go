@@ -0,0 +1,227 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"reflect"
+	"sync/atomic"
+
+	"go.uber.org/dig/internal/digerror"
+)
+
+// wiringOp records a single change to the wiring of a container so that it
+// can be replayed by Clone. Exactly one of ctor, dcor, child, or remove is
+// set.
+type wiringOp struct {
+	// Scope that was changed.
+	scope *Scope
+
+	// Constructor provided to the Scope, and the node built for it.
+	ctor        interface{}
+	provideOpts provideOptions
+	node        *constructorNode
+
+	// Decorator added to the Scope, and the node built for it.
+	dcor         interface{}
+	decorateOpts decorateOptions
+	dnode        *decoratorNode
+
+	// Child Scope created from the Scope.
+	child *Scope
+
+	// Constructors removed from the Scope, or the decorator removed from
+	// the Scope if removeDecorator is set.
+	remove          *removeOptions
+	removeType      reflect.Type
+	removeDecorator bool
+
+	// Whether all decorators were removed from the Scope.
+	resetDecorators bool
+}
+
+// recordWiring records a change to the wiring of this Scope. Changes to
+// request Scopes are not recorded since they are never cloned.
+func (s *Scope) recordWiring(op wiringOp) {
+	s.rootScope().generation++
+	if s.request {
+		return
+	}
+	root := s.rootScope()
+	root.wiring = append(root.wiring, op)
+}
+
+// Clone returns a new Container with the same constructors, decorators,
+// and child Scopes as this one, but none of the values built so far.
+// Changes made to either Container afterwards do not affect the other.
+//
+// This is intended for tests that need a variation of a production
+// container without mutating the original:
+//
+//	c := app.NewContainer()
+//	test := c.Clone()
+//	test.Decorate(func(*Clock) *Clock { return fakeClock })
+//
+// Constructors disabled with ProviderHandle.Disable remain disabled in the
+// clone, but existing ProviderHandles, ProvideInfo, and DecorateInfo refer
+// only to the original Container. Child Scopes are cloned as well so that
+// constructors exported from them remain available, but they cannot be
+// accessed directly; use Scope on the clone to create new ones.
+func (c *Container) Clone() *Container {
+	defer c.scope.lock()()
+	return c.clone()
+}
+
+func (c *Container) clone() *Container {
+	clone := newCloneOf(c.scope)
+	if _, err := clone.scope.replay(c.scope, c.scope.wiring); err != nil {
+		digerror.BugPanicf("could not replay wiring in Clone: %v", err)
+	}
+	return clone
+}
+
+// newCloneOf builds an empty Container with the same options as the given
+// root Scope.
+func newCloneOf(orig *Scope) *Container {
+	clone := &Container{scope: newScope()}
+	clone.scope.invokerFn = orig.invokerFn
+	clone.scope.deferAcyclicVerification = orig.deferAcyclicVerification
+	clone.scope.recoverFromPanics = orig.recoverFromPanics
+	clone.scope.inferInterfaces = orig.inferInterfaces
+	clone.scope.stubMissing = orig.stubMissing
+	clone.scope.stubCanned = orig.stubCanned
+	clone.scope.allowOverride = orig.allowOverride
+	clone.scope.keyEqual = orig.keyEqual
+	if len(orig.overridden) > 0 {
+		clone.scope.overridden = make(map[key]struct{}, len(orig.overridden))
+		for k := range orig.overridden {
+			clone.scope.overridden[k] = struct{}{}
+		}
+	}
+	clone.scope.strict = orig.strict
+	clone.scope.dryRun = orig.dryRun
+	clone.scope.derivers = orig.derivers
+	clone.scope.maxDepth = orig.maxDepth
+	clone.scope.platformOverride = orig.platformOverride
+	clone.scope.profile = orig.profile
+	clone.scope.groupView = orig.groupView
+	clone.scope.compat = orig.compat
+	clone.scope.callHooks = orig.callHooks
+	clone.scope.tracer = orig.tracer
+	if orig.access != nil {
+		clone.scope.access = make(map[key]*KeyAccess)
+	}
+	clone.scope.mu = new(containerMutex)
+	clone.scope.tickets = new(uint64)
+	clone.scope.concurrent = atomic.LoadUint32(&orig.concurrent)
+	if d := orig.dump; d != nil {
+		clone.scope.dump = &failureDumper{dumpOnFailureOption: d.dumpOnFailureOption}
+	}
+	return clone
+}
+
+// replay applies wiring changes recorded by the root Scope from to this
+// Scope. Child Scopes created in from are re-created as children of this
+// Scope, and values declared with Extern are declared again. It stops at
+// the first change that fails. It returns the Scopes of this Container
+// that the Scopes of from were replayed to.
+//
+// Constructors are replayed disabled so that the order in which they're
+// replayed can't introduce cycles through constructors that were disabled
+// at the time. Once done, their original state is restored and all
+// affected Scopes must verify that they are acyclic again.
+func (s *Scope) replay(from *Scope, wiring []wiringOp) (map[*Scope]*Scope, error) {
+	scopes := map[*Scope]*Scope{from: s}
+	disabled := make(map[*constructorNode]bool)
+	defer func() {
+		for n, d := range disabled {
+			n.disabled = d
+		}
+		for _, s := range s.appendSubscopes(nil) {
+			s.isVerifiedAcyclic = false
+		}
+	}()
+
+	for _, op := range wiring {
+		dst := scopes[op.scope]
+		switch {
+		case op.child != nil:
+			scopes[op.child] = dst.newChildScope(op.child.name, []ScopeOption{ScopeContext(op.child.ctx)})
+
+		case op.ctor != nil:
+			opts := op.provideOpts
+			opts.Info = nil
+			opts.Handle = new(ProviderHandle)
+			if err := dst.provide(op.ctor, opts); err != nil {
+				return nil, errProvide{Func: op.node.location, Reason: err}
+			}
+
+			n := opts.Handle.n
+			n.disabled = true
+			disabled[n] = op.node.disabled
+
+		case op.dcor != nil:
+			opts := op.decorateOpts
+			opts.Info = nil
+			if err := dst.decorate(op.dcor, opts); err != nil {
+				return nil, err
+			}
+
+		case op.remove != nil && op.removeDecorator:
+			k := key{t: op.removeType, name: op.remove.Name, group: op.remove.Group}
+			if err := dst.removeDecorator(k); err != nil {
+				return nil, err
+			}
+			dst.recordWiring(wiringOp{scope: dst, remove: op.remove, removeType: op.removeType, removeDecorator: true})
+
+		case op.remove != nil:
+			k := key{t: op.removeType, name: op.remove.Name, group: op.remove.Group}
+			if err := dst.remove(k, true /* force */); err != nil {
+				return nil, err
+			}
+			dst.recordWiring(wiringOp{scope: dst, remove: op.remove, removeType: op.removeType})
+
+		case op.resetDecorators:
+			dst.resetDecorators()
+			dst.recordWiring(wiringOp{scope: dst, resetDecorators: true})
+		}
+	}
+
+	if len(from.platformOnly) > 0 {
+		root := s.rootScope()
+		if root.platformOnly == nil {
+			root.platformOnly = make(map[key][]string, len(from.platformOnly))
+		}
+		for k, ps := range from.platformOnly {
+			root.platformOnly[k] = append(root.platformOnly[k], ps...)
+		}
+	}
+
+	if len(from.externs) > 0 {
+		root := s.rootScope()
+		if root.externs == nil {
+			root.externs = make(map[key]struct{}, len(from.externs))
+		}
+		for k := range from.externs {
+			root.externs[k] = struct{}{}
+		}
+	}
+	return scopes, nil
+}
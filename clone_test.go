@@ -0,0 +1,127 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestClone(t *testing.T) {
+	t.Parallel()
+
+	type A struct{ Name string }
+	type B struct{ A *A }
+
+	t.Run("does not share values", func(t *testing.T) {
+		c := digtest.New(t)
+
+		var calls int
+		c.RequireProvide(func() *A {
+			calls++
+			return &A{Name: "orig"}
+		})
+		c.RequireProvide(func(a *A) *B { return &B{A: a} })
+
+		var origA *A
+		c.RequireInvoke(func(a *A) { origA = a })
+
+		clone := c.Clone()
+		require.NoError(t, clone.Invoke(func(b *B) {
+			assert.NotSame(t, origA, b.A)
+		}))
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("changes do not affect the original", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{Name: "orig"} })
+
+		clone := c.Clone()
+		require.NoError(t, clone.Decorate(func(a *A) *A { return &A{Name: "fake"} }))
+		require.NoError(t, clone.Provide(func(a *A) *B { return &B{A: a} }))
+
+		require.NoError(t, clone.Invoke(func(b *B) {
+			assert.Equal(t, "fake", b.A.Name)
+		}))
+		c.RequireInvoke(func(a *A) {
+			assert.Equal(t, "orig", a.Name)
+		})
+		assert.Error(t, c.Invoke(func(*B) {}))
+	})
+
+	t.Run("decorators and groups", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{Name: "a"} })
+		c.RequireDecorate(func(a *A) *A { return &A{Name: a.Name + "!"} })
+		c.RequireProvide(func() string { return "x" }, dig.Group("g"))
+		c.RequireProvide(func() string { return "y" }, dig.Group("g"))
+
+		type params struct {
+			dig.In
+
+			A      *A
+			Values []string `group:"g"`
+		}
+		require.NoError(t, c.Clone().Invoke(func(p params) {
+			assert.Equal(t, "a!", p.A.Name)
+			assert.ElementsMatch(t, []string{"x", "y"}, p.Values)
+		}))
+	})
+
+	t.Run("exported from child scope", func(t *testing.T) {
+		c := digtest.New(t)
+		c.Scope("child").RequireProvide(func() *A { return &A{Name: "child"} }, dig.Export(true))
+
+		require.NoError(t, c.Clone().Invoke(func(a *A) {
+			assert.Equal(t, "child", a.Name)
+		}))
+	})
+
+	t.Run("handles", func(t *testing.T) {
+		c := digtest.New(t)
+
+		var h dig.ProviderHandle
+		c.RequireProvide(func() *A { return &A{} }, dig.FillProviderHandle(&h))
+		h.Disable()
+
+		clone := c.Clone()
+		assert.Error(t, clone.Invoke(func(*A) {}), "disabled constructors must stay disabled")
+
+		h.Enable()
+		c.RequireInvoke(func(*A) {})
+		assert.Error(t, clone.Invoke(func(*A) {}), "handles must only affect the original")
+	})
+
+	t.Run("options", func(t *testing.T) {
+		c := digtest.New(t, dig.RecoverFromPanics())
+		c.RequireProvide(func() *A { panic("great sadness") })
+
+		err := c.Clone().Invoke(func(*A) {})
+		require.Error(t, err)
+		var pe dig.PanicError
+		assert.ErrorAs(t, err, &pe)
+	})
+}
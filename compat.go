@@ -0,0 +1,95 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import "fmt"
+
+// CompatMode selects whether a container behaves exactly like upstream
+// go.uber.org/dig or enables the extended behavior of this fork. See
+// Compatibility.
+type CompatMode int
+
+const (
+	// CompatUpstream makes the container behave like upstream dig, so that
+	// code written against upstream dig can move to this fork, and back,
+	// without changes in behavior. This is the default.
+	CompatUpstream CompatMode = iota
+
+	// CompatExtended enables the extended behavior of this fork.
+	CompatExtended
+)
+
+func (m CompatMode) String() string {
+	switch m {
+	case CompatExtended:
+		return "CompatExtended"
+	case CompatUpstream:
+		return "CompatUpstream"
+	default:
+		return fmt.Sprintf("CompatMode(%d)", int(m))
+	}
+}
+
+// Compatibility is an Option that selects between the behavior of upstream
+// dig and the extended behavior of this fork.
+//
+//	c := dig.New(dig.Compatibility(dig.CompatExtended))
+//
+// Most features of this fork are opt-in, through Options, ProvideOptions,
+// InvokeOptions, and types that upstream dig doesn't have, and behave the
+// same in both modes. CompatExtended also turns on the features that
+// change the behavior of code written against upstream dig:
+//
+//   - cycle errors report the keys linking each constructor to the next,
+//     instead of the cycle in the order it was discovered;
+//   - missing type errors suggest the same type under other names;
+//   - errors from Scopes are prefixed with the path of the Scope; and
+//   - a PanicError describes the values that were being built.
+//
+// Remove the option, or use CompatUpstream, to turn these features off.
+func Compatibility(mode CompatMode) Option {
+	return compatibilityOption(mode)
+}
+
+type compatibilityOption CompatMode
+
+func (o compatibilityOption) String() string {
+	return fmt.Sprintf("Compatibility(%v)", CompatMode(o))
+}
+
+func (o compatibilityOption) applyOption(c *Container) {
+	c.scope.compat = CompatMode(o)
+}
+
+// isUpstream reports whether the container this Scope belongs to was built
+// with Compatibility(CompatUpstream).
+func (s *Scope) isUpstream() bool {
+	return s.rootScope().compat == CompatUpstream
+}
+
+// panicPath returns the path of the values being built to describe in a
+// PanicError, unless the container behaves like upstream dig.
+func panicPath(c containerStore) resolutionPath {
+	if c.isUpstream() {
+		return nil
+	}
+	return c.resolutionPath()
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestCompatibility(t *testing.T) {
+	t.Parallel()
+
+	type A struct{}
+	type B struct{}
+	type C struct{}
+
+	upstream := dig.Compatibility(dig.CompatUpstream)
+	extended := dig.Compatibility(dig.CompatExtended)
+
+	t.Run("String", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "Compatibility(CompatUpstream)", fmt.Sprint(upstream))
+		assert.Equal(t, "Compatibility(CompatExtended)", fmt.Sprint(extended))
+		assert.Equal(t, "CompatMode(3)", fmt.Sprint(dig.CompatMode(3)))
+	})
+
+	t.Run("func results", func(t *testing.T) {
+		t.Parallel()
+
+		ctor := func() (*A, func()) { return &A{}, func() {} }
+
+		for _, opt := range []dig.Option{upstream, extended} {
+			c := digtest.New(t, opt)
+			c.RequireProvide(ctor)
+			c.RequireInvoke(func(f func()) {
+				assert.NotNil(t, f)
+			})
+		}
+	})
+
+	t.Run("cycles", func(t *testing.T) {
+		t.Parallel()
+
+		for _, opt := range []dig.Option{upstream, extended} {
+			c := digtest.New(t, opt)
+			c.RequireProvide(func(*C) *A { return &A{} })
+			c.RequireProvide(func(*A) *B { return &B{} })
+			err := c.Provide(func(*B) *C { return &C{} })
+			require.Error(t, err)
+			assert.True(t, dig.IsCycleDetected(err))
+			if opt == upstream {
+				assert.NotContains(t, err.Error(), " from func(")
+			} else {
+				assert.Contains(t, err.Error(), "depends on *dig_test.C from func(")
+			}
+		}
+	})
+
+	t.Run("missing type suggestions", func(t *testing.T) {
+		t.Parallel()
+
+		type params struct {
+			dig.In
+
+			A *A `name:"primary"`
+		}
+		for _, opt := range []dig.Option{upstream, extended} {
+			c := digtest.New(t, opt)
+			c.RequireProvide(func() *A { return &A{} }, dig.Name("secondary"))
+			err := c.Invoke(func(params) {})
+			require.Error(t, err)
+			if opt == upstream {
+				assert.NotContains(t, err.Error(), "did you mean")
+			} else {
+				assert.Contains(t, err.Error(), `did you mean *dig_test.A[name="secondary"]`)
+			}
+		}
+	})
+
+	t.Run("scope errors", func(t *testing.T) {
+		t.Parallel()
+
+		for _, opt := range []dig.Option{upstream, extended} {
+			c := digtest.New(t, opt)
+			err := c.Scope("child").Invoke(func(*A) {})
+			require.Error(t, err)
+			if opt == upstream {
+				assert.NotContains(t, err.Error(), `in scope "child"`)
+			} else {
+				assert.Contains(t, err.Error(), `in scope "child"`)
+			}
+		}
+	})
+
+	t.Run("panics", func(t *testing.T) {
+		t.Parallel()
+
+		for _, opt := range []dig.Option{upstream, extended} {
+			c := digtest.New(t, opt, dig.RecoverFromPanics())
+			c.RequireProvide(func() *A { panic("great sadness") })
+			err := c.Invoke(func(*A) {})
+			require.Error(t, err)
+			if opt == upstream {
+				assert.NotContains(t, err.Error(), "while building")
+			} else {
+				assert.Contains(t, err.Error(), "while building *dig_test.A")
+			}
+		}
+	})
+
+	t.Run("default errors match upstream", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func(*C) *A { return &A{} })
+		c.RequireProvide(func(*A) *B { return &B{} })
+		err := c.Provide(func(*B) *C { return &C{} })
+		require.Error(t, err)
+		assert.Regexp(t, `this function introduces a cycle: `+
+			`func\(\*dig_test.C\) \*dig_test.A provided by "go.uber.org/dig_test".TestCompatibility.func\S+ \(\S+\)\n`+
+			`\tdepends on func\(\*dig_test.B\) \*dig_test.C provided by "go.uber.org/dig_test".TestCompatibility.func\S+ \(\S+\)\n`+
+			`\tdepends on func\(\*dig_test.A\) \*dig_test.B provided by "go.uber.org/dig_test".TestCompatibility.func\S+ \(\S+\)\n`+
+			`\tdepends on func\(\*dig_test.C\) \*dig_test.A provided by "go.uber.org/dig_test".TestCompatibility.func\S+ \(\S+\)$`,
+			err.Error())
+
+		type params struct {
+			dig.In
+
+			A *A `name:"primary"`
+		}
+		c = digtest.New(t)
+		c.RequireProvide(func() *A { return &A{} }, dig.Name("secondary"))
+		err = c.Invoke(func(params) {})
+		require.Error(t, err)
+		assert.Regexp(t, `: missing type: \*dig_test.A\[name="primary"\]$`, err.Error())
+
+		c = digtest.New(t)
+		err = c.Scope("child").Invoke(func(*A) {})
+		require.Error(t, err)
+		assert.Regexp(t, `^missing dependencies for function "go.uber.org/dig_test".TestCompatibility.func\S+ \(\S+\): missing type: \*dig_test.A$`, err.Error())
+
+		c = digtest.New(t, dig.RecoverFromPanics())
+		c.RequireProvide(func() *A { panic("great sadness") })
+		err = c.Invoke(func(*A) {})
+		require.Error(t, err)
+		assert.Regexp(t, `: failed to build \*dig_test.A: panic: "great sadness" in func: "go.uber.org/dig_test".TestCompatibility.func\S+ \(\S+\)$`, err.Error())
+	})
+
+	t.Run("clones keep the mode", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, upstream)
+		clone := c.Clone()
+		err := clone.Scope("child").Invoke(func(*A) {})
+		require.Error(t, err)
+		assert.NotContains(t, err.Error(), `in scope "child"`)
+	})
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"bytes"
+	"runtime"
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// Concurrent is an Option that makes the container safe for concurrent
+// use by multiple goroutines, along with all of its Scopes.
+//
+//	c := dig.New(dig.Concurrent())
+//	// ...
+//	go c.Invoke(handleA)
+//	go c.Invoke(handleB)
+//
+// Changes to the container and the resolution of values are serialized:
+// constructors and decorators are never called concurrently, and are
+// called on the goroutine of the Invoke that needs them. Invoked
+// functions, however, are called once their dependencies are resolved
+// without blocking other goroutines, so they may run concurrently and may
+// use the container themselves.
+//
+// A constructor is called at most once even if several goroutines need its
+// values at the same time: the first Invoke calls it and the others use
+// the values it produced. If it fails, Invokes that were waiting on it
+// fail with the same error instead of calling it again.
+//
+// Constructors and decorators are called with the container locked. They
+// may still use the container from the goroutine they're called on, such
+// as through Lazy dependencies or nested Invokes, but they must not wait
+// on other goroutines that use it, or they will deadlock.
+//
+// Unlike other Invokes, functions invoked by containers built with
+// Concurrent do not share the trace ID and context of an Invoke that
+// they're nested in.
+func Concurrent() Option {
+	return concurrentOption{}
+}
+
+type concurrentOption struct{}
+
+func (concurrentOption) String() string {
+	return "Concurrent()"
+}
+
+func (concurrentOption) applyOption(c *Container) {
+	c.scope.concurrent = 1
+}
+
+// setConcurrent makes the container behave as if it was built with
+// Concurrent from now on.
+func (s *Scope) setConcurrent() {
+	atomic.StoreUint32(&s.rootScope().concurrent, 1)
+}
+
+// isConcurrent reports whether the container was built with Concurrent,
+// or has since been made concurrent by Request.
+func (s *Scope) isConcurrent() bool {
+	return atomic.LoadUint32(&s.rootScope().concurrent) != 0
+}
+
+// lock acquires the lock of a concurrent container, and returns a function
+// that releases it. It does nothing for other containers, or if the
+// calling goroutine already holds the lock.
+func (s *Scope) lock() (unlock func()) {
+	if !s.isConcurrent() {
+		return func() {}
+	}
+	return s.rootScope().mu.lock()
+}
+
+// containerMutex is the lock of a concurrent container. The goroutine
+// holding it may acquire it again, so that constructors and decorators,
+// which are called with the lock held, may use the container through
+// Lazy dependencies or nested Invokes.
+type containerMutex struct {
+	mu    sync.Mutex
+	owner uint64 // goroutine holding mu, accessed atomically
+}
+
+func (m *containerMutex) lock() (unlock func()) {
+	g := goroutineID()
+	if atomic.LoadUint64(&m.owner) == g {
+		return func() {}
+	}
+
+	m.mu.Lock()
+	atomic.StoreUint64(&m.owner, g)
+	return func() {
+		atomic.StoreUint64(&m.owner, 0)
+		m.mu.Unlock()
+	}
+}
+
+// goroutineID returns the ID of the calling goroutine, as printed in its
+// stack trace.
+func goroutineID() uint64 {
+	var buf [64]byte
+	b := buf[:runtime.Stack(buf[:], false)]
+	b = bytes.TrimPrefix(b, []byte("goroutine "))
+	if i := bytes.IndexByte(b, ' '); i >= 0 {
+		b = b[:i]
+	}
+	id, _ := strconv.ParseUint(string(b), 10, 64)
+	return id
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"runtime"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestConcurrent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("String", func(t *testing.T) {
+		assert.Equal(t, "Concurrent()", fmt.Sprint(dig.Concurrent()))
+	})
+
+	t.Run("constructors run on the invoking goroutine", func(t *testing.T) {
+		type A struct{ G uint64 }
+		type B struct{ G uint64 }
+
+		c := digtest.New(t, dig.Concurrent())
+		c.RequireProvide(func() *A { return &A{G: goroutineID(t)} }, dig.Transient())
+		c.RequireProvide(func(*A) *B { return &B{G: goroutineID(t)} }, dig.Transient())
+
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				g := goroutineID(t)
+				assert.NoError(t, c.Invoke(func(a *A, b *B) {
+					assert.Equal(t, g, a.G)
+					assert.Equal(t, g, b.G)
+				}))
+			}()
+		}
+		wg.Wait()
+	})
+
+	t.Run("parallel provides and invokes", func(t *testing.T) {
+		type A struct{ N int }
+
+		c := digtest.New(t, dig.Concurrent())
+		var calls int
+		c.RequireProvide(func() *A {
+			calls++
+			return &A{N: 42}
+		})
+
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, c.Invoke(func(a *A) {
+					assert.Equal(t, 42, a.N)
+				}))
+			}()
+			go func(i int) {
+				defer wg.Done()
+				assert.NoError(t, c.Provide(func() int { return i }, dig.Name(fmt.Sprint("n", i))))
+			}(i)
+		}
+		wg.Wait()
+		assert.Equal(t, 1, calls, "constructor must be called once")
+	})
+
+	t.Run("invoked functions may use the container", func(t *testing.T) {
+		c := digtest.New(t, dig.Concurrent())
+		c.RequireProvide(func() string { return "hello" })
+
+		c.RequireInvoke(func(s string) {
+			require.NoError(t, c.Provide(func() int { return len(s) }))
+			c.RequireInvoke(func(n int) {
+				assert.Equal(t, 5, n)
+			})
+		})
+	})
+
+	t.Run("inspection during provides", func(t *testing.T) {
+		c := digtest.New(t, dig.Concurrent())
+
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(2)
+			go func(i int) {
+				defer wg.Done()
+				assert.NoError(t, c.Provide(func() int { return i }, dig.Name(fmt.Sprint("n", i))))
+			}(i)
+			go func() {
+				defer wg.Done()
+				assert.NotEmpty(t, c.String())
+				assert.NoError(t, dig.Visualize(c.Container, &bytes.Buffer{}))
+				assert.NoError(t, c.CheckSchema(dig.Schema{}))
+			}()
+		}
+		wg.Wait()
+	})
+
+	t.Run("clones are concurrent", func(t *testing.T) {
+		c := digtest.New(t, dig.Concurrent())
+		c.RequireProvide(func() string { return "hello" })
+
+		clone := c.Clone()
+		require.NoError(t, clone.Invoke(func(s string) {
+			require.NoError(t, clone.Provide(func() int { return len(s) }))
+		}))
+	})
+}
+
+func TestConcurrentSharedFailure(t *testing.T) {
+	t.Parallel()
+
+	type A struct{}
+
+	const waiters = 10
+
+	c := digtest.New(t, dig.Concurrent())
+	var (
+		calls   int
+		started = make(chan struct{})
+		release = make(chan struct{})
+	)
+	c.RequireProvide(func() (*A, error) {
+		calls++
+		if calls == 1 {
+			close(started)
+			<-release
+		}
+		return nil, errors.New("great sadness")
+	})
+
+	var wg sync.WaitGroup
+	errs := make(chan error, waiters+1)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		errs <- c.Invoke(func(*A) {})
+	}()
+	<-started
+
+	for i := 0; i < waiters; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- c.Invoke(func(*A) {})
+		}()
+	}
+	// Give the waiters a chance to start their Invokes.
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "great sadness")
+	}
+	assert.Equal(t, 1, calls, "waiting Invokes must share the failure")
+
+	// Invokes started after the failure call the constructor again.
+	before := calls
+	require.Error(t, c.Invoke(func(*A) {}))
+	assert.Equal(t, before+1, calls)
+}
+
+// goroutineID returns the ID of the calling goroutine, as reported in its
+// stack trace.
+func goroutineID(t *testing.T) uint64 {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+	buf = bytes.TrimPrefix(buf, []byte("goroutine "))
+	buf = buf[:bytes.IndexByte(buf, ' ')]
+	id, err := strconv.ParseUint(string(buf), 10, 64)
+	require.NoError(t, err)
+	return id
+}
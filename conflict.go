@@ -0,0 +1,182 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"runtime"
+	"strings"
+
+	"go.uber.org/dig/internal/digreflect"
+)
+
+// DuplicateProvideError is returned by Provide when a constructor provides
+// a value that other constructors already provide. Use errors.As to
+// retrieve it.
+//
+//	var dup dig.DuplicateProvideError
+//	if errors.As(err, &dup) {
+//	  for _, site := range dup.Conflicts {
+//	    log.Printf("%v is also provided at %v", dup.Type, site.CallSite)
+//	  }
+//	}
+type DuplicateProvideError struct {
+	// Type of the value provided more than once.
+	Type reflect.Type
+
+	// Name of the value, if it's a named value.
+	Name string
+
+	// Value group and key of the value, if it's a keyed member of a value
+	// group.
+	Group, Key string
+
+	// Constructors that already provide the value.
+	Conflicts []ProvideSite
+
+	// Path to the result of the new constructor providing the value.
+	path string
+}
+
+var _ digError = DuplicateProvideError{}
+
+func newErrDuplicateProvide(k key, path string, conflicts []ProvideSite) DuplicateProvideError {
+	e := DuplicateProvideError{
+		Type:      k.t,
+		Group:     k.group,
+		Conflicts: conflicts,
+		path:      path,
+	}
+	if k.group == "" {
+		e.Name = k.name
+	} else {
+		e.Key = k.name
+	}
+	return e
+}
+
+func (e DuplicateProvideError) key() key {
+	if e.Group == "" {
+		return key{t: e.Type, name: e.Name}
+	}
+	return key{t: e.Type, group: e.Group, name: e.Key}
+}
+
+func (e DuplicateProvideError) Error() string { return fmt.Sprint(e) }
+
+func (e DuplicateProvideError) writeMessage(w io.Writer, verb string) {
+	fmt.Fprintf(w, "cannot provide %v from %v:", e.key(), e.path)
+	if verb == "%+v" {
+		io.WriteString(w, "\n")
+	} else {
+		io.WriteString(w, " ")
+	}
+	io.WriteString(w, "already provided by ")
+	for i, site := range e.Conflicts {
+		if i > 0 {
+			io.WriteString(w, "; ")
+		}
+		fmt.Fprintf(w, verb, site)
+	}
+}
+
+func (e DuplicateProvideError) Format(w fmt.State, c rune) {
+	formatError(e, w, c)
+}
+
+// ProvideSite describes a constructor provided to a container.
+type ProvideSite struct {
+	// Where the constructor was defined.
+	Location Location
+
+	// Code that called Provide. Unset if it's unknown.
+	CallSite Location
+
+	// Options that the constructor was provided with, if any.
+	Name, Group string
+
+	// Name of the Module that the constructor was provided through, if
+	// any.
+	Module string
+}
+
+// String describes the site in the format used by dig's error messages.
+// For example,
+//
+//	"path/to/package".NewDB (path/to/db.go:42) (provided at "path/to/main".main (path/to/main.go:12) with Name("ro") in module "db")
+func (s ProvideSite) String() string {
+	var b strings.Builder
+	b.WriteString(s.Location.String())
+
+	var details []string
+	if s.CallSite != (Location{}) {
+		details = append(details, fmt.Sprintf("provided at %v", s.CallSite))
+	}
+	if s.Name != "" {
+		details = append(details, fmt.Sprintf("with Name(%q)", s.Name))
+	}
+	if s.Group != "" {
+		details = append(details, fmt.Sprintf("with Group(%q)", s.Group))
+	}
+	if s.Module != "" {
+		details = append(details, fmt.Sprintf("in module %q", s.Module))
+	}
+	if len(details) > 0 {
+		fmt.Fprintf(&b, " (%v)", strings.Join(details, " "))
+	}
+	return b.String()
+}
+
+// provideSite describes where this constructor was provided.
+func (n *constructorNode) provideSite() ProvideSite {
+	site := ProvideSite{
+		Location: newLocation(n.location),
+		Name:     n.name,
+		Group:    n.group,
+		Module:   n.module,
+	}
+	if n.callSite != nil {
+		site.CallSite = newLocation(n.callSite)
+	}
+	return site
+}
+
+// inspectCallSite returns the innermost caller outside of dig and its
+// internal packages, or nil if there isn't one.
+func inspectCallSite() *digreflect.Func {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	for {
+		frame, more := frames.Next()
+		if f := digreflect.InspectFrame(frame); f.Package != _digPackage &&
+			!strings.HasPrefix(f.Package, _digPackage+"/internal/") {
+			return f
+		}
+		if !more {
+			return nil
+		}
+	}
+}
+
+// _digPackage is the import path of this package.
+const _digPackage = "go.uber.org/dig"
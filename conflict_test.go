@@ -0,0 +1,64 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+)
+
+func TestDuplicateProvideError(t *testing.T) {
+	t.Parallel()
+
+	type DB struct{}
+
+	newDB := func() *DB { return &DB{} }
+
+	c := dig.New()
+	require.NoError(t, c.Use(dig.NewModule("db").Provide(newDB, dig.Name("ro"))))
+
+	err := c.Provide(newDB, dig.Name("ro"))
+	require.Error(t, err)
+
+	var dup dig.DuplicateProvideError
+	require.True(t, errors.As(err, &dup), "must be a DuplicateProvideError")
+	assert.Equal(t, reflect.TypeOf(&DB{}), dup.Type)
+	assert.Equal(t, "ro", dup.Name)
+	require.Len(t, dup.Conflicts, 1)
+
+	site := dup.Conflicts[0]
+	assert.Contains(t, site.Location.Name, "TestDuplicateProvideError")
+	assert.Equal(t, "go.uber.org/dig_test", site.CallSite.Package)
+	assert.Equal(t, "TestDuplicateProvideError", site.CallSite.Name)
+	assert.Contains(t, site.CallSite.File, "conflict_test.go")
+	assert.Equal(t, "ro", site.Name)
+	assert.Empty(t, site.Group)
+	assert.Equal(t, "db", site.Module)
+
+	assert.Contains(t, err.Error(), `already provided by "go.uber.org/dig_test".TestDuplicateProvideError`)
+	assert.Contains(t, err.Error(), `(provided at "go.uber.org/dig_test".TestDuplicateProvideError (`)
+	assert.Contains(t, err.Error(), `with Name("ro") in module "db")`)
+}
@@ -22,7 +22,9 @@ package dig
 
 import (
 	"fmt"
+	"io"
 	"reflect"
+	"time"
 
 	"go.uber.org/dig/internal/digerror"
 	"go.uber.org/dig/internal/digreflect"
@@ -48,6 +50,9 @@ type constructorNode struct {
 	// Whether the constructor owned by this node was already called.
 	called bool
 
+	// Whether this node was removed from its Scope with Remove.
+	removed bool
+
 	// Type information about constructor parameters.
 	paramList paramList
 
@@ -63,6 +68,70 @@ type constructorNode struct {
 	// scope this node was originally provided to.
 	// This is different from s if and only if the constructor was Provided with ExportOption.
 	origS *Scope
+
+	// Whether values produced by this node are excluded from Container.Close.
+	skipClose bool
+
+	// Time allowed to tear down values produced by this node.
+	shutdownTimeout time.Duration
+
+	// Whether this node is called by Container.Build.
+	eager bool
+
+	// Whether this node is called every time its values are requested.
+	transient bool
+
+	// Whether this node's values are memoized per request Scope.
+	request bool
+
+	// Whether this node was disabled with ProviderHandle.Disable.
+	disabled bool
+
+	// Name of the Module this node was provided through, if any.
+	module string
+
+	// Generation of the container once this node was provided.
+	generation uint64
+
+	// Number of times the constructor was called, and the total time
+	// spent in it.
+	calls   int
+	elapsed time.Duration
+
+	// Trace ID of the Invoke that the constructor was first called for.
+	traceID string
+
+	// Resources claimed by this node with Claims.
+	claims []string
+
+	// Whether this node is used only if its values have no other
+	// constructor.
+	fallback bool
+
+	// Values produced by this node for keys that another node provides
+	// instead, because this one is a fallback or is outranked. They're
+	// withheld from the Scope until this node provides them.
+	withheld map[key]reflect.Value
+
+	// Priority of this node among the nodes that provide the same values,
+	// if it was provided with Priority.
+	priority *int
+
+	// Whether values produced by this node are carried over by Swap.
+	pinned bool
+
+	// ValueStore that values produced by this node are saved to, if it
+	// was provided with Persist.
+	persist *persistOption
+
+	// Last failure of this node, if Invokes waiting on it share it.
+	failure *sharedFailure
+
+	// Code that called Provide, if known.
+	callSite *digreflect.Func
+
+	// Name and value group given with the Name and Group options, if any.
+	name, group string
 }
 
 type constructorOptions struct {
@@ -70,8 +139,65 @@ type constructorOptions struct {
 	// belong to the specified value group or implement any of the interfaces.
 	ResultName  string
 	ResultGroup string
+	ResultKey   string
 	ResultAs    []interface{}
-	Location    *digreflect.Func
+
+	// Labels of the values added to ResultGroup. See GroupLabels.
+	ResultLabels []string
+
+	Location *digreflect.Func
+
+	// If set, values produced by this constructor are not closed by
+	// Container.Close.
+	SkipClose bool
+
+	// If set, a trailing func() result is a cleanup function. See
+	// ReturnsCleanup.
+	Cleanup bool
+
+	// Time allowed to tear down values produced by this constructor in
+	// Container.Shutdown. Zero means no limit.
+	ShutdownTimeout time.Duration
+
+	// If set, this constructor is called by Container.Build.
+	Eager bool
+
+	// If set, this constructor is called every time its values are
+	// requested.
+	Transient bool
+
+	// If set, values produced by this constructor are memoized per request
+	// Scope.
+	Request bool
+
+	// Name of the Module this constructor was provided through, if any.
+	Module string
+
+	// Resources claimed by this constructor.
+	Claims []string
+
+	// If set, this constructor is used only if its values have no other
+	// constructor.
+	Fallback bool
+
+	// If set, this constructor may provide the same values as other
+	// constructors with a different priority.
+	Priority *int
+
+	// If set, values produced by this constructor are carried over by
+	// Swap.
+	Pin bool
+
+	// If set, values produced by this constructor are saved to and
+	// loaded from a ValueStore.
+	Persist *persistOption
+
+	// Tags annotating positional parameters with ParamName and
+	// ParamGroup.
+	ParamTags []paramTag
+
+	// Code that called Provide, if known.
+	CallSite *digreflect.Func
 }
 
 func newConstructorNode(ctor interface{}, s *Scope, origS *Scope, opts constructorOptions) (*constructorNode, error) {
@@ -83,18 +209,32 @@ func newConstructorNode(ctor interface{}, s *Scope, origS *Scope, opts construct
 	if err != nil {
 		return nil, err
 	}
+	params, err = params.annotate(s, opts.ParamTags)
+	if err != nil {
+		return nil, err
+	}
 
 	results, err := newResultList(
 		ctype,
 		resultOptions{
 			Name:  opts.ResultName,
 			Group: opts.ResultGroup,
+			Key:   opts.ResultKey,
 			As:    opts.ResultAs,
+
+			Labels: opts.ResultLabels,
+
+			Futures: true,
+			Cleanup: opts.Cleanup,
 		},
 	)
 	if err != nil {
 		return nil, err
 	}
+	if opts.Cleanup && results.cleanupIndex < 0 {
+		return nil, newErrInvalidInput(
+			"cannot use dig.ReturnsCleanup() with a constructor that doesn't return a trailing func()", nil)
+	}
 
 	location := opts.Location
 	if location == nil {
@@ -111,6 +251,21 @@ func newConstructorNode(ctor interface{}, s *Scope, origS *Scope, opts construct
 		orders:     make(map[*Scope]int),
 		s:          s,
 		origS:      origS,
+		skipClose:  opts.SkipClose,
+
+		shutdownTimeout: opts.ShutdownTimeout,
+		eager:           opts.Eager,
+		transient:       opts.Transient,
+		request:         opts.Request,
+		module:          opts.Module,
+		claims:          opts.Claims,
+		fallback:        opts.Fallback,
+		priority:        opts.Priority,
+		pinned:          opts.Pin,
+		persist:         opts.Persist,
+		callSite:        opts.CallSite,
+		name:            opts.ResultName,
+		group:           opts.ResultGroup,
 	}
 	s.newGraphNode(n, n.orders)
 	return n, nil
@@ -123,6 +278,8 @@ func (n *constructorNode) ID() dot.CtorID             { return n.id }
 func (n *constructorNode) CType() reflect.Type        { return n.ctype }
 func (n *constructorNode) Order(s *Scope) int         { return n.orders[s] }
 func (n *constructorNode) OrigScope() *Scope          { return n.origS }
+func (n *constructorNode) Transient() bool            { return n.transient }
+func (n *constructorNode) RequestScoped() bool        { return n.request }
 
 func (n *constructorNode) String() string {
 	return fmt.Sprintf("deps: %v, ctor: %v", n.paramList, n.ctype)
@@ -130,66 +287,207 @@ func (n *constructorNode) String() string {
 
 // Call calls this constructor if it hasn't already been called and
 // injects any values produced by it into the provided container.
-func (n *constructorNode) Call(c containerStore) (err error) {
+func (n *constructorNode) Call(c containerStore) error {
 	if n.called {
+		n.releaseWithheld()
 		return nil
 	}
+	if err := n.sharedFailure(c); err != nil {
+		return err
+	}
 
+	receiver, err := n.produceShared(c)
+	if err != nil {
+		return err
+	}
+
+	// Commit the result to the original container that this constructor
+	// was supplied to. The provided constructor is only used for a view of
+	// the rest of the graph to instantiate the dependencies of this
+	// container.
+	n.withheld = receiver.withhold(n.provides)
+	receiver.Commit(n.s)
+	n.called = true
+	return nil
+}
+
+// provides reports whether this constructor provides the value with the
+// given key in its Scope, rather than losing it to a regular constructor
+// or to one with a higher priority.
+func (n *constructorNode) provides(k key) bool {
+	for _, p := range n.s.getProviders(k) {
+		if p == provider(n) {
+			return true
+		}
+	}
+	return false
+}
+
+// releaseWithheld commits the withheld values of this constructor that it
+// has since become the provider of, such as because the constructors that
+// overrode it were disabled.
+func (n *constructorNode) releaseWithheld() {
+	for k, v := range n.withheld {
+		if n.provides(k) {
+			n.s.setValue(k.name, k.t, v)
+			delete(n.withheld, k)
+		}
+	}
+}
+
+// Produce calls this constructor and returns the values produced by it
+// without injecting them into any container.
+func (n *constructorNode) Produce(c containerStore) (_ *stagingContainerWriter, err error) {
 	if err := shallowCheckDependencies(c, n.paramList); err != nil {
-		return errMissingDependencies{
+		return nil, errMissingDependencies{
 			Func:   n.location,
+			Module: n.module,
 			Reason: err,
 		}
 	}
 
+	popSpan, endSpan := n.s.startSpan(nil, "", n.location)
+	defer func() {
+		popSpan()
+		endSpan(err)
+	}()
+
 	if n.s.recoverFromPanics {
 		defer func() {
 			if p := recover(); p != nil {
 				err = PanicError{
 					fn:    n.location,
+					path:  panicPath(c),
 					Panic: p,
 				}
 			}
 		}()
 	}
 
+	if n.persist != nil {
+		receiver, ok, err := n.restore()
+		if err != nil {
+			return nil, errConstructorFailed{Func: n.location, Module: n.module, Reason: err}
+		}
+		if ok {
+			if !n.skipClose {
+				root := n.s.rootScope()
+				root.teardowns = append(root.teardowns, receiver.Closers(n)...)
+			}
+			return receiver, nil
+		}
+	}
+
+	popCaller := n.s.pushCaller(caller{Func: n.location, Scope: n.s})
 	args, err := n.paramList.BuildList(c)
+	popCaller()
 	if err != nil {
-		return errArgumentsFailed{
+		return nil, errArgumentsFailed{
 			Func:   n.location,
+			Module: n.module,
 			Reason: err,
 		}
 	}
 
+	n.s.deriveArgs(n.paramList, args, n.location)
+
+	if err := n.s.checkClaims(n); err != nil {
+		return nil, err
+	}
+	if err := n.s.checkCanceled(); err != nil {
+		return nil, err
+	}
+
 	receiver := newStagingContainerWriter()
+	call := n.s.callBefore(n, args)
+	start := time.Now()
 	results := c.invoker()(reflect.ValueOf(n.ctor), args)
-	if err := n.resultList.ExtractList(receiver, false /* decorating */, results); err != nil {
-		return errConstructorFailed{Func: n.location, Reason: err}
+	n.s.setLastCalled(n.location)
+	err = n.resultList.ExtractList(receiver, false /* decorating */, results)
+	if d := n.s.rootScope().dump; d != nil {
+		d.record(n.s, n.location, start, err)
+	}
+	elapsed := time.Since(start)
+	if n.calls == 0 {
+		n.traceID = c.resolutionPath().traceID()
+	}
+	n.calls++
+	n.elapsed += elapsed
+	n.s.callAfter(call, elapsed, err)
+	if err != nil {
+		return nil, errConstructorFailed{Func: n.location, Module: n.module, Reason: err}
 	}
 
-	// Commit the result to the original container that this constructor
-	// was supplied to. The provided constructor is only used for a view of
-	// the rest of the graph to instantiate the dependencies of this
-	// container.
-	receiver.Commit(n.s)
-	n.called = true
+	var tds []teardown
+	if cleanup := n.resultList.Cleanup(results); cleanup != nil {
+		tds = append(tds, teardown{
+			Func:    n.location,
+			Node:    n,
+			Cleanup: cleanup,
+			Timeout: n.shutdownTimeout,
+		})
+	}
+	if !n.skipClose {
+		tds = append(tds, receiver.Closers(n)...)
+	}
+	if err := n.s.checkNilResults(receiver); err != nil {
+		// The constructor succeeded, so it expects its values to be torn
+		// down, but nothing will use them. Tear them down right away.
+		return nil, errConstructorFailed{Func: n.location, Module: n.module, Reason: discardTeardowns(tds, err)}
+	}
+	n.s.holdClaims(n)
 
-	return nil
+	// Request-scoped values are torn down with the request Scope that
+	// holds them; all others with the container.
+	owner := n.s.rootScope()
+	if n.request {
+		owner = c.requestScope()
+	}
+	owner.teardowns = append(owner.teardowns, tds...)
+	if n.persist != nil {
+		if err := n.save(receiver); err != nil {
+			return nil, errConstructorFailed{Func: n.location, Module: n.module, Reason: err}
+		}
+	}
+
+	return receiver, nil
+}
+
+// discardTeardowns runs the teardowns of the values of a constructor call
+// rejected with the given error, in the reverse order in which they would
+// have been registered. It returns the error along with any
+// failures of the teardowns.
+func discardTeardowns(tds []teardown, err error) error {
+	if len(tds) == 0 {
+		return err
+	}
+	reversed := make([]teardown, len(tds))
+	for i, td := range tds {
+		reversed[len(tds)-1-i] = td
+	}
+	if terr := runTeardowns(reversed); terr != nil {
+		return newErrMultiple([]error{err, terr})
+	}
+	return err
 }
 
 // stagingContainerWriter is a containerWriter that records the changes that
 // would be made to a containerWriter and defers them until Commit is called.
 type stagingContainerWriter struct {
-	values map[key]reflect.Value
-	groups map[key][]reflect.Value
+	values  map[key]reflect.Value
+	groups  map[key][]reflect.Value
+	members map[key]reflect.Value
+	labeled map[key][]labeledValue
 }
 
 var _ containerWriter = (*stagingContainerWriter)(nil)
 
 func newStagingContainerWriter() *stagingContainerWriter {
 	return &stagingContainerWriter{
-		values: make(map[key]reflect.Value),
-		groups: make(map[key][]reflect.Value),
+		values:  make(map[key]reflect.Value),
+		groups:  make(map[key][]reflect.Value),
+		members: make(map[key]reflect.Value),
+		labeled: make(map[key][]labeledValue),
 	}
 }
 
@@ -206,10 +504,92 @@ func (sr *stagingContainerWriter) submitGroupedValue(group string, t reflect.Typ
 	sr.groups[k] = append(sr.groups[k], v)
 }
 
+func (sr *stagingContainerWriter) submitLabeledValue(group string, t reflect.Type, labels []string, v reflect.Value) {
+	k := key{t: t, group: group}
+	sr.labeled[k] = append(sr.labeled[k], labeledValue{Labels: labels, Value: v})
+}
+
+func (sr *stagingContainerWriter) setGroupMember(group, member string, t reflect.Type, v reflect.Value) {
+	sr.members[key{t: t, group: group, name: member}] = v
+}
+
 func (sr *stagingContainerWriter) submitDecoratedGroupedValue(_ string, _ reflect.Type, _ reflect.Value) {
 	digerror.BugPanicf("stagingContainerWriter.submitDecoratedGroupedValue must never be called")
 }
 
+// Closers returns teardowns for the io.Closers among the received results,
+// attributed to the given constructor.
+func (sr *stagingContainerWriter) Closers(n *constructorNode) []teardown {
+	var (
+		closers []teardown
+		seen    []io.Closer
+	)
+	add := func(k key, v reflect.Value) {
+		if !v.IsValid() || isNilValue(v) {
+			return
+		}
+		c, ok := v.Interface().(io.Closer)
+		if !ok {
+			return
+		}
+
+		// The same value may have been provided under multiple keys with
+		// dig.As. Close it only once.
+		if reflect.TypeOf(c).Comparable() {
+			for _, s := range seen {
+				if reflect.TypeOf(s) == reflect.TypeOf(c) && s == c {
+					return
+				}
+			}
+			seen = append(seen, c)
+		}
+		closers = append(closers, teardown{
+			Key:     k,
+			Func:    n.location,
+			Node:    n,
+			Closer:  c,
+			Timeout: n.shutdownTimeout,
+		})
+	}
+
+	for k, v := range sr.values {
+		add(k, v)
+	}
+	for k, vs := range sr.groups {
+		for _, v := range vs {
+			add(k, v)
+		}
+	}
+	return closers
+}
+
+// isNilValue reports whether the given value is a nil pointer, interface,
+// map, slice, channel, or function.
+func isNilValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+		return v.IsNil()
+	}
+	return false
+}
+
+// withhold removes the received values whose keys aren't provided by the
+// constructor that produced them, and returns them.
+func (sr *stagingContainerWriter) withhold(provides func(key) bool) map[key]reflect.Value {
+	var withheld map[key]reflect.Value
+	for k, v := range sr.values {
+		if provides(k) {
+			continue
+		}
+		if withheld == nil {
+			withheld = make(map[key]reflect.Value)
+		}
+		withheld[k] = v
+		delete(sr.values, k)
+	}
+	return withheld
+}
+
 // Commit commits the received results to the provided containerWriter.
 func (sr *stagingContainerWriter) Commit(cw containerWriter) {
 	for k, v := range sr.values {
@@ -221,4 +601,14 @@ func (sr *stagingContainerWriter) Commit(cw containerWriter) {
 			cw.submitGroupedValue(k.group, k.t, v)
 		}
 	}
+
+	for k, v := range sr.members {
+		cw.setGroupMember(k.group, k.name, k.t, v)
+	}
+
+	for k, lvs := range sr.labeled {
+		for _, lv := range lvs {
+			cw.submitLabeledValue(k.group, k.t, lv.Labels, lv.Value)
+		}
+	}
 }
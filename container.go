@@ -21,15 +21,18 @@
 package dig
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"reflect"
 
+	"go.uber.org/dig/internal/digreflect"
 	"go.uber.org/dig/internal/dot"
 )
 
 const (
 	_optionalTag         = "optional"
+	_defaultTag          = "default"
 	_nameTag             = "name"
 	_ignoreUnexportedTag = "ignore-unexported"
 )
@@ -44,6 +47,9 @@ type key struct {
 }
 
 func (k key) String() string {
+	if k.name != "" && k.group != "" {
+		return fmt.Sprintf("%v[group=%q, key=%q]", k.t, k.group, k.name)
+	}
 	if k.name != "" {
 		return fmt.Sprintf("%v[name=%q]", k.t, k.name)
 	}
@@ -87,6 +93,15 @@ type containerWriter interface {
 	// submitDecoratedGroupedValue submits a decorated value to the value group
 	// with the provided name.
 	submitDecoratedGroupedValue(name string, t reflect.Type, v reflect.Value)
+
+	// setGroupMember records the value with the given key in the value
+	// group with the provided name. The value must be submitted to the
+	// group as well. See GroupKey.
+	setGroupMember(group, member string, t reflect.Type, v reflect.Value)
+
+	// submitLabeledValue records the labels of a value submitted to the
+	// value group with the provided name. See GroupLabels.
+	submitLabeledValue(group string, t reflect.Type, labels []string, v reflect.Value)
 }
 
 // containerStore provides access to the Container's underlying data store.
@@ -99,6 +114,11 @@ type containerStore interface {
 	// Returns a slice containing all known types.
 	knownTypes() []reflect.Type
 
+	// Returns the names under which values of the given type are provided
+	// to this store or its ancestors, sorted. Unnamed values are reported
+	// with an empty name.
+	knownNames(t reflect.Type) []string
+
 	// Retrieves the value with the provided name and type, if any.
 	getValue(name string, t reflect.Type) (v reflect.Value, ok bool)
 
@@ -107,12 +127,18 @@ type containerStore interface {
 
 	// Retrieves all values for the provided group and type.
 	//
-	// The order in which the values are returned is undefined.
+	// The order in which the values are returned is undefined unless the
+	// container was built with Strict.
 	getValueGroup(name string, t reflect.Type) []reflect.Value
 
 	// Retrieves all decorated values for the provided group and type, if any.
 	getDecoratedValueGroup(name string, t reflect.Type) (reflect.Value, bool)
 
+	// Returns a function that lists the current members of the provided
+	// group as a slice of type t if the container was built with
+	// GroupView(LiveGroups), or nil otherwise.
+	liveGroup(name string, t reflect.Type) func() reflect.Value
+
 	// Returns the providers that can produce a value with the given name and
 	// type.
 	getValueProviders(name string, t reflect.Type) []provider
@@ -125,6 +151,27 @@ type containerStore interface {
 	// type across all the Scopes that are in effect of this containerStore.
 	getAllValueProviders(name string, t reflect.Type) []provider
 
+	// Returns the providers for a value with the given name and type that
+	// were disabled with ProviderHandle.Disable.
+	getDisabledValueProviders(name string, t reflect.Type) []provider
+
+	// Reports whether a value with the given name and type was declared
+	// with Extern.
+	isExtern(name string, t reflect.Type) bool
+
+	// Returns the key of the only value that can satisfy the given
+	// interface type if InferInterfaces is enabled, and records the
+	// inference.
+	inferredKey(name string, t reflect.Type) (key, bool)
+
+	// Returns the stub for a value with the given name and type if the
+	// container was built with StubMissing.
+	stub(name string, t reflect.Type) (reflect.Value, bool)
+
+	// Returns the key of the only value equivalent to the given one under
+	// KeyEquivalence.
+	equivalentKey(name string, t reflect.Type) (key, bool)
+
 	// Returns the decorator that can decorate values for the given name and
 	// type.
 	getValueDecorator(name string, t reflect.Type) (decorator, bool)
@@ -139,6 +186,67 @@ type containerStore interface {
 
 	createGraph() *dot.Graph
 
+	// Records that the given frame is being resolved. The returned function
+	// must be called once it's done.
+	pushResolveFrame(resolveFrame) (pop func())
+
+	// Returns an error if the path of values currently being resolved is
+	// longer than allowed by MaxResolutionDepth.
+	checkResolutionDepth() error
+
+	// Returns the platform of the container as "os/arch", and the
+	// platforms that constructors for the given key skipped because of
+	// Platforms were restricted to.
+	platform() string
+	platformsFor(key) []string
+
+	// Returns an error if the value being resolved is already being
+	// resolved through a Lazy dependency.
+	checkLazyCycle() error
+
+	// Builds the given value on behalf of a Lazy dependency used at the
+	// given location.
+	resolveLazily(name string, t reflect.Type, loc *digreflect.Func) (reflect.Value, error)
+
+	// Returns the value with the given key in the given value group, and
+	// the providers for it. See GroupKey.
+	getGroupMember(group, member string, t reflect.Type) (reflect.Value, bool)
+	getGroupMemberProviders(group, member string, t reflect.Type) []provider
+
+	// Returns the values of the given type in the given value group that
+	// have all of the given labels. See GroupLabels.
+	getLabeledValues(group string, t reflect.Type, labels []string) []reflect.Value
+
+	// Returns the keys of the values of the given type in the given value
+	// group.
+	groupMemberKeys(group string, t reflect.Type) []string
+
+	// Records that the given key was requested if the container was built
+	// with TrackAccess.
+	recordAccess(key)
+
+	// Returns the path of values currently being resolved.
+	resolutionPath() resolutionPath
+
+	// Returns the nearest request Scope, starting at this store, or nil if
+	// this store isn't part of a request Scope.
+	requestScope() *Scope
+
+	// Reports whether the container was built with Strict.
+	isStrict() bool
+
+	// Reports whether the container was built with
+	// Compatibility(CompatUpstream).
+	isUpstream() bool
+
+	// Returns the context given to the nearest Scope with ScopeContext,
+	// starting at this store.
+	scopeContext() (context.Context, bool)
+
+	// Returns the CallInfo of the constructor whose parameters are being
+	// built, if any.
+	callInfo() (CallInfo, bool)
+
 	// Returns invokerFn function to use when calling arguments.
 	invoker() invokerFn
 }
@@ -146,6 +254,9 @@ type containerStore interface {
 // New constructs a Container.
 func New(opts ...Option) *Container {
 	s := newScope()
+	s.maxDepth = DefaultMaxResolutionDepth
+	s.mu = new(containerMutex)
+	s.tickets = new(uint64)
 	c := &Container{scope: s}
 
 	for _, opt := range opts {
@@ -229,6 +340,7 @@ func (o dryRunOption) applyOption(c *Container) {
 	} else {
 		c.scope.invokerFn = defaultInvoker
 	}
+	c.scope.dryRun = bool(o)
 }
 
 // invokerFn specifies how the container calls user-supplied functions.
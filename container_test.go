@@ -67,4 +67,22 @@ func TestOptionStrings(t *testing.T) {
 
 		assert.Equal(t, "RecoverFromPanics()", fmt.Sprint(RecoverFromPanics()))
 	})
+
+	t.Run("InferInterfaces()", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "InferInterfaces()", fmt.Sprint(InferInterfaces()))
+	})
+
+	t.Run("Strict()", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "Strict()", fmt.Sprint(Strict()))
+	})
+
+	t.Run("DumpOnFailureToFile()", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, `DumpOnFailureToFile("/tmp")`, fmt.Sprint(DumpOnFailureToFile("/tmp")))
+	})
 }
@@ -0,0 +1,178 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+type scopeContextKey struct{}
+
+// ContextWithScope returns a copy of ctx that carries the given Scope,
+// usually a request Scope created with Request. InvokeContext resolves
+// values through the Scope carried by its context.
+//
+// This lets a single container serve many concurrent requests while
+// request-scoped values, such as per-request loggers, are resolved for the
+// request that the context belongs to. Serving requests concurrently
+// requires the container to be safe for concurrent use: build it with
+// Concurrent, as below. Calling Request also makes it so, but only from
+// then on, so Invokes made concurrently before the first request are not
+// synchronized.
+//
+//	s.container = dig.New(dig.Concurrent())
+//	// ...
+//
+//	func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+//	  req := s.container.Request()
+//	  defer req.Close()
+//	  ctx := dig.ContextWithScope(r.Context(), req)
+//	  s.handle(ctx, w, r)
+//	}
+//
+//	func (s *Server) handle(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+//	  s.container.InvokeContext(ctx, func(log *RequestLogger) {
+//	    // ...
+//	  })
+//	}
+func ContextWithScope(ctx context.Context, s *Scope) context.Context {
+	return context.WithValue(ctx, scopeContextKey{}, s)
+}
+
+// ScopeFromContext returns the Scope carried by ctx, if any.
+func ScopeFromContext(ctx context.Context) (*Scope, bool) {
+	s, ok := ctx.Value(scopeContextKey{}).(*Scope)
+	return s, ok
+}
+
+// InvokeContext runs the given function like Invoke, resolving its
+// dependencies through the Scope carried by ctx if it was created from this
+// Container. Otherwise, this behaves like Invoke. See ContextWithScope.
+//
+// The function and the constructors called to build its dependencies
+// receive ctx for their unnamed context.Context parameters, unless a
+// constructor for context.Context was provided. ctx takes precedence over
+// contexts given with ScopeContext.
+//
+//	c.Provide(func(ctx context.Context, cfg *Config) (*sql.DB, error) {
+//	  return connect(ctx, cfg.DSN)
+//	})
+//	err := c.InvokeContext(ctx, func(db *sql.DB) {
+//	  // ...
+//	})
+//
+// Since values are built at most once, a constructor called on behalf of
+// InvokeContext must not hold on to ctx beyond its own call.
+//
+// Once ctx is done, no further constructors or decorators are called and
+// the function isn't invoked. The returned error wraps ctx.Err() and names
+// the constructor called last as well as those that were skipped.
+//
+// If ctx carries a trace ID, the function is invoked with it unless
+// another one is given with the TraceID option. See ContextWithTraceID.
+func (c *Container) InvokeContext(ctx context.Context, function interface{}, opts ...InvokeOption) error {
+	return c.scope.InvokeContext(ctx, function, opts...)
+}
+
+// InvokeContext runs the given function like Invoke, resolving its
+// dependencies through the Scope carried by ctx if it's this Scope or one
+// of its descendants. Otherwise, this behaves like Invoke. See
+// ContextWithScope.
+func (s *Scope) InvokeContext(ctx context.Context, function interface{}, opts ...InvokeOption) error {
+	opts = append([]InvokeOption{invokeContextOption{ctx: ctx}}, opts...)
+	if id, ok := TraceIDFromContext(ctx); ok {
+		opts = append([]InvokeOption{TraceID(id)}, opts...)
+	}
+	return s.contextScope(ctx).Invoke(function, opts...)
+}
+
+// invokeContextOption gives the context of InvokeContext to the values
+// resolved for it.
+type invokeContextOption struct{ ctx context.Context }
+
+func (o invokeContextOption) String() string {
+	return fmt.Sprintf("invokeContext(%v)", o.ctx)
+}
+
+func (o invokeContextOption) applyInvokeOption(opts *invokeOptions) {
+	opts.Context = o.ctx
+}
+
+// contextScope returns the Scope carried by ctx if it descends from this
+// Scope, or this Scope otherwise.
+func (s *Scope) contextScope(ctx context.Context) *Scope {
+	cs, ok := ScopeFromContext(ctx)
+	if !ok || cs == nil {
+		return s
+	}
+	for _, a := range cs.ancestors() {
+		if a == s {
+			return cs
+		}
+	}
+	return s
+}
+
+// _contextType is the type of context.Context.
+var _contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+
+// ScopeContext is a ScopeOption that gives a context to the new Scope.
+// Constructors resolved within the Scope or its descendants that depend
+// on a context.Context receive it, unless a constructor for
+// context.Context was provided or they're called on behalf of
+// InvokeContext.
+//
+// This is intended for request Scopes so that the constructors of
+// request-scoped values observe the deadline and tracing information of
+// the request without the need for an Invoke with a context.
+//
+//	req := c.Request(dig.ScopeContext(r.Context()))
+//	defer req.Close()
+//
+// Values are resolved in the Scope whose constructors produce them, so
+// values memoized in the Container and its named Scopes are built with
+// the context of those Scopes, not the one of the Scope that requested
+// them. Request-scoped values are built with the context of the request
+// Scope.
+func ScopeContext(ctx context.Context) ScopeOption {
+	return scopeContextOption{ctx: ctx}
+}
+
+type scopeContextOption struct{ ctx context.Context }
+
+func (o scopeContextOption) String() string {
+	return fmt.Sprintf("ScopeContext(%v)", o.ctx)
+}
+
+func (o scopeContextOption) applyScopeOption(opts *scopeOptions) {
+	opts.Context = o.ctx
+}
+
+func (s *Scope) scopeContext() (context.Context, bool) {
+	for _, a := range s.ancestors() {
+		if a.ctx != nil {
+			return a.ctx, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,263 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestInvokeContext(t *testing.T) {
+	t.Parallel()
+
+	type Logger struct{ ID int }
+
+	newContainer := func(t *testing.T) *digtest.Container {
+		c := digtest.New(t)
+		var loggers int
+		c.RequireProvide(func() *Logger {
+			loggers++
+			return &Logger{ID: loggers}
+		}, dig.RequestScoped())
+		c.RequireProvide(func() string { return "shared" })
+		return c
+	}
+
+	t.Run("resolves through the context's scope", func(t *testing.T) {
+		c := newContainer(t)
+
+		ctx1 := dig.ContextWithScope(context.Background(), c.Request())
+		ctx2 := dig.ContextWithScope(context.Background(), c.Request())
+
+		for i := 0; i < 2; i++ {
+			require.NoError(t, c.InvokeContext(ctx1, func(l *Logger, s string) {
+				assert.Equal(t, 1, l.ID)
+				assert.Equal(t, "shared", s)
+			}))
+			require.NoError(t, c.InvokeContext(ctx2, func(l *Logger) {
+				assert.Equal(t, 2, l.ID)
+			}))
+		}
+	})
+
+	t.Run("no scope in context", func(t *testing.T) {
+		c := newContainer(t)
+
+		require.NoError(t, c.InvokeContext(context.Background(), func(s string) {
+			assert.Equal(t, "shared", s)
+		}))
+		err := c.InvokeContext(context.Background(), func(*Logger) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "can only be used from a request Scope")
+	})
+
+	t.Run("scope from another container is ignored", func(t *testing.T) {
+		c := newContainer(t)
+		other := newContainer(t)
+
+		ctx := dig.ContextWithScope(context.Background(), other.Request())
+		err := c.InvokeContext(ctx, func(*Logger) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "can only be used from a request Scope")
+	})
+
+	t.Run("ScopeFromContext", func(t *testing.T) {
+		c := newContainer(t)
+
+		_, ok := dig.ScopeFromContext(context.Background())
+		assert.False(t, ok)
+
+		req := c.Request()
+		got, ok := dig.ScopeFromContext(dig.ContextWithScope(context.Background(), req))
+		assert.True(t, ok)
+		assert.Same(t, req, got)
+	})
+
+	t.Run("injects context into constructors", func(t *testing.T) {
+		type ctxKey struct{}
+		type Conn struct{ ctx context.Context }
+
+		c := digtest.New(t)
+		c.RequireProvide(func(ctx context.Context) *Conn { return &Conn{ctx: ctx} })
+
+		ctx := context.WithValue(context.Background(), ctxKey{}, "invoke")
+		require.NoError(t, c.InvokeContext(ctx, func(got context.Context, conn *Conn) {
+			assert.Equal(t, "invoke", got.Value(ctxKey{}))
+			assert.Equal(t, "invoke", conn.ctx.Value(ctxKey{}))
+		}))
+
+		err := c.Invoke(func(context.Context) {})
+		require.Error(t, err, "Invoke without a context must not receive one")
+		assert.Contains(t, err.Error(), "missing type: context.Context")
+	})
+
+	t.Run("aborts when canceled", func(t *testing.T) {
+		type A struct{}
+		type B struct{}
+		type C struct{}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() *A {
+			cancel()
+			return &A{}
+		})
+		c.RequireProvide(func(*A) *B {
+			t.Fatal("B must not be built after cancellation")
+			return nil
+		})
+		c.RequireProvide(func(*B) *C { return &C{} })
+
+		err := c.InvokeContext(ctx, func(*C) {
+			t.Fatal("function must not be invoked after cancellation")
+		})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, context.Canceled))
+		assert.Regexp(t, `resolution aborted after calling "go.uber.org/dig_test".TestInvokeContext.func[\d.]+ \(\S+\), skipping "go.uber.org/dig_test".TestInvokeContext.func[\d.]+ \(\S+\); "go.uber.org/dig_test".TestInvokeContext.func[\d.]+ \(\S+\): context canceled`, err.Error())
+	})
+
+	t.Run("already canceled", func(t *testing.T) {
+		type A struct{}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{} })
+
+		err := c.InvokeContext(ctx, func(*A) {})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, context.Canceled))
+		assert.Contains(t, err.Error(), "resolution aborted before calling any constructors, skipping")
+
+		err = c.InvokeContext(ctx, func() {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "resolution aborted before calling any constructors: context canceled")
+	})
+
+	t.Run("takes precedence over scope context", func(t *testing.T) {
+		type ctxKey struct{}
+
+		c := digtest.New(t)
+		req := c.Request(dig.ScopeContext(context.WithValue(context.Background(), ctxKey{}, "scope")))
+		ctx := dig.ContextWithScope(context.WithValue(context.Background(), ctxKey{}, "invoke"), req)
+
+		require.NoError(t, c.InvokeContext(ctx, func(got context.Context) {
+			assert.Equal(t, "invoke", got.Value(ctxKey{}))
+		}))
+		require.NoError(t, req.Invoke(func(got context.Context) {
+			assert.Equal(t, "scope", got.Value(ctxKey{}))
+		}))
+	})
+}
+
+func TestScopeContext(t *testing.T) {
+	t.Parallel()
+
+	type ctxKey struct{}
+	type Handler struct{ Request string }
+
+	t.Run("request-scoped constructor", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func(ctx context.Context) *Handler {
+			return &Handler{Request: ctx.Value(ctxKey{}).(string)}
+		}, dig.RequestScoped())
+
+		for _, id := range []string{"a", "b"} {
+			ctx := context.WithValue(context.Background(), ctxKey{}, id)
+			req := c.Request(dig.ScopeContext(ctx))
+			require.NoError(t, req.Invoke(func(h *Handler) {
+				assert.Equal(t, id, h.Request)
+			}))
+			require.NoError(t, req.Close())
+		}
+	})
+
+	t.Run("invoke", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.WithValue(context.Background(), ctxKey{}, "a")
+		c := digtest.New(t)
+		child := c.Scope("child", dig.ScopeContext(ctx))
+		require.NoError(t, child.Invoke(func(got context.Context) {
+			assert.Equal(t, ctx, got)
+		}))
+	})
+
+	t.Run("inherited by descendants", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.WithValue(context.Background(), ctxKey{}, "a")
+		c := digtest.New(t)
+		child := c.Scope("child", dig.ScopeContext(ctx))
+		grandchild := child.Scope("grandchild")
+		require.NoError(t, grandchild.Request().Invoke(func(got context.Context) {
+			assert.Equal(t, ctx, got)
+		}))
+	})
+
+	t.Run("provided context takes precedence", func(t *testing.T) {
+		t.Parallel()
+
+		provided := context.WithValue(context.Background(), ctxKey{}, "provided")
+		c := digtest.New(t)
+		c.RequireProvide(func() context.Context { return provided })
+
+		req := c.Request(dig.ScopeContext(context.Background()))
+		require.NoError(t, req.Invoke(func(got context.Context) {
+			assert.Equal(t, provided, got)
+		}))
+	})
+
+	t.Run("no context", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		err := c.Invoke(func(context.Context) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing type: context.Context")
+	})
+
+	t.Run("named context is not supplied", func(t *testing.T) {
+		t.Parallel()
+
+		type params struct {
+			dig.In
+
+			Ctx context.Context `name:"ctx"`
+		}
+
+		c := digtest.New(t)
+		req := c.Request(dig.ScopeContext(context.Background()))
+		err := req.Invoke(func(params) {})
+		require.Error(t, err)
+	})
+}
@@ -32,6 +32,10 @@ import (
 type cycleErrPathEntry struct {
 	Key  key
 	Func *digreflect.Func
+
+	// Via is the key through which the previous entry in the path
+	// depends on this one, if known.
+	Via *key
 }
 
 type errCycleDetected struct {
@@ -46,10 +50,14 @@ func (e errCycleDetected) Error() string {
 	//
 	//   [scope "foo"]
 	//   func(*bar) *foo provided by "path/to/package".NewFoo (path/to/file.go:42)
-	//   	depends on func(*baz) *bar provided by "another/package".NewBar (somefile.go:1)
-	//   	depends on func(*foo) baz provided by "somepackage".NewBar (anotherfile.go:2)
-	//   	depends on func(*bar) *foo provided by "path/to/package".NewFoo (path/to/file.go:42)
+	//   	depends on *bar from func(*baz) *bar provided by "another/package".NewBar (somefile.go:1)
+	//   	depends on *baz[name="x"] from func(*foo) baz provided by "somepackage".NewBar (anotherfile.go:2)
+	//   	depends on *foo from func(*bar) *foo provided by "path/to/package".NewFoo (path/to/file.go:42)
 	//
+	// With Compatibility(CompatExtended), the path is the shortest cycle
+	// found, not the order in which it was discovered, and each entry
+	// names the key that depends on the previous one. Otherwise, it is
+	// the cycle as upstream dig reports it, without the keys.
 	b := new(bytes.Buffer)
 
 	if name := e.scope.name; len(name) > 0 {
@@ -58,6 +66,9 @@ func (e errCycleDetected) Error() string {
 	for i, entry := range e.Path {
 		if i > 0 {
 			b.WriteString("\n\tdepends on ")
+			if entry.Via != nil {
+				fmt.Fprintf(b, "%v from ", *entry.Via)
+			}
 		}
 		fmt.Fprintf(b, "%v provided by %v", entry.Key, entry.Func)
 	}
@@ -72,8 +83,30 @@ func (e errCycleDetected) Format(w fmt.State, c rune) {
 	formatError(e, w, c)
 }
 
+func (e errCycleDetected) Is(target error) bool { return target == ErrCycle }
+
+// errResolveCycle is returned when a cycle through dependencies that are
+// not part of the graph, such as inferred interfaces, is found while
+// resolving values.
+type errResolveCycle string
+
+var _ digError = errResolveCycle("")
+
+func (e errResolveCycle) Error() string { return fmt.Sprint(e) }
+
+func (e errResolveCycle) writeMessage(w io.Writer, _ string) {
+	fmt.Fprintf(w, "cycle detected in dependency graph: %v", string(e))
+}
+
+func (e errResolveCycle) Format(w fmt.State, c rune) {
+	formatError(e, w, c)
+}
+
+func (e errResolveCycle) Is(target error) bool { return target == ErrCycle }
+
 // IsCycleDetected returns a boolean as to whether the provided error indicates
-// a cycle was detected in the container graph.
+// a cycle was detected in the container graph. Use errors.Is with ErrCycle
+// to also match cycles found while resolving values.
 func IsCycleDetected(err error) bool {
 	return errors.As(err, &errCycleDetected{})
 }
@@ -38,6 +38,7 @@ const (
 
 type decorator interface {
 	Call(c containerStore) error
+	Produce(c containerStore) (*stagingContainerWriter, error)
 	ID() dot.CtorID
 	State() decoratorState
 }
@@ -65,6 +66,12 @@ type decoratorNode struct {
 
 	// scope this node was originally provided to.
 	s *Scope
+
+	// Name of the Module this node was provided through, if any.
+	module string
+
+	// Keys of the values decorated by this node.
+	keys []key
 }
 
 func newDecoratorNode(dcor interface{}, s *Scope) (*decoratorNode, error) {
@@ -101,10 +108,35 @@ func (n *decoratorNode) Call(s containerStore) (err error) {
 	}
 
 	n.state = decoratorOnStack
+	if err := n.call(s, n.s); err != nil {
+		return err
+	}
+	n.state = decoratorCalled
+	return nil
+}
+
+// Produce calls this decorator for a single consumer of a value that must
+// not be shared, such as one built by a transient constructor, and returns
+// its results instead of memoizing them in its Scope.
+func (n *decoratorNode) Produce(s containerStore) (*stagingContainerWriter, error) {
+	prev := n.state
+	n.state = decoratorOnStack
+	defer func() { n.state = prev }()
+
+	receiver := newStagingContainerWriter()
+	if err := n.call(s, decoratedStagingWriter{receiver}); err != nil {
+		return nil, err
+	}
+	return receiver, nil
+}
 
+// call calls this decorator and writes its results to cw as decorated
+// values.
+func (n *decoratorNode) call(s containerStore, cw containerWriter) (err error) {
 	if err := shallowCheckDependencies(s, n.params); err != nil {
 		return errMissingDependencies{
 			Func:   n.location,
+			Module: n.module,
 			Reason: err,
 		}
 	}
@@ -114,6 +146,7 @@ func (n *decoratorNode) Call(s containerStore) (err error) {
 			if p := recover(); p != nil {
 				err = PanicError{
 					fn:    n.location,
+					path:  panicPath(s),
 					Panic: p,
 				}
 			}
@@ -124,18 +157,43 @@ func (n *decoratorNode) Call(s containerStore) (err error) {
 	if err != nil {
 		return errArgumentsFailed{
 			Func:   n.location,
+			Module: n.module,
 			Reason: err,
 		}
 	}
 
+	if err := n.s.checkCanceled(); err != nil {
+		return err
+	}
 	results := s.invoker()(reflect.ValueOf(n.dcor), args)
-	if err := n.results.ExtractList(n.s, true /* decorated */, results); err != nil {
+	n.s.setLastCalled(n.location)
+	if err := n.results.ExtractList(cw, true /* decorated */, results); err != nil {
+		if n.module != "" {
+			// Decorator errors are otherwise returned as-is.
+			// Attribute them to the Module they came from.
+			return errConstructorFailed{
+				Func:   n.location,
+				Module: n.module,
+				Reason: err,
+			}
+		}
 		return err
 	}
-	n.state = decoratorCalled
 	return nil
 }
 
+// decoratedStagingWriter is a stagingContainerWriter that records decorated
+// values as if they were regular ones.
+type decoratedStagingWriter struct{ *stagingContainerWriter }
+
+func (w decoratedStagingWriter) setDecoratedValue(name string, t reflect.Type, v reflect.Value) {
+	w.setValue(name, t, v)
+}
+
+func (w decoratedStagingWriter) submitDecoratedGroupedValue(name string, t reflect.Type, v reflect.Value) {
+	w.submitGroupedValue(name, t, v)
+}
+
 func (n *decoratorNode) ID() dot.CtorID { return n.id }
 
 func (n *decoratorNode) State() decoratorState { return n.state }
@@ -146,7 +204,8 @@ type DecorateOption interface {
 }
 
 type decorateOptions struct {
-	Info *DecorateInfo
+	Info   *DecorateInfo
+	Module string
 }
 
 // FillDecorateInfo is a DecorateOption that writes info on what Dig was
@@ -171,6 +230,10 @@ type DecorateInfo struct {
 	ID      ID
 	Inputs  []*Input
 	Outputs []*Output
+
+	// Generation of the container once the decorator was provided. See
+	// Container.Generation.
+	Generation uint64
 }
 
 // Decorate provides a decorator for a type that has already been provided in the Container.
@@ -218,15 +281,21 @@ func (c *Container) Decorate(decorator interface{}, opts ...DecorateOption) erro
 //
 // Similar to a provider, the decorator function gets called *at most once*.
 func (s *Scope) Decorate(decorator interface{}, opts ...DecorateOption) error {
+	defer s.lock()()
+
 	var options decorateOptions
 	for _, opt := range opts {
 		opt.apply(&options)
 	}
+	return s.decorate(decorator, options)
+}
 
+func (s *Scope) decorate(decorator interface{}, options decorateOptions) error {
 	dn, err := newDecoratorNode(decorator, s)
 	if err != nil {
 		return err
 	}
+	dn.module = options.Module
 
 	keys, err := findResultKeys(dn.results)
 	if err != nil {
@@ -237,35 +306,136 @@ func (s *Scope) Decorate(decorator interface{}, opts ...DecorateOption) error {
 			return newErrInvalidInput(
 				fmt.Sprintf("cannot decorate using function %v: %s already decorated", dn.dtype, k), nil)
 		}
+		saveEntry(s, s.decorators, k)
 		s.decorators[k] = dn
 	}
+	dn.keys = keys
+	s.decoratorNodes = append(s.decoratorNodes, dn)
+	s.recordWiring(wiringOp{scope: s, dcor: decorator, decorateOpts: options, dnode: dn})
 
 	if info := options.Info; info != nil {
-		params := dn.params.DotParam()
-		results := dn.results.DotResult()
-		info.ID = (ID)(dn.id)
-		info.Inputs = make([]*Input, len(params))
-		info.Outputs = make([]*Output, len(results))
-
-		for i, param := range params {
-			info.Inputs[i] = &Input{
-				t:        param.Type,
-				optional: param.Optional,
-				name:     param.Name,
-				group:    param.Group,
-			}
+		*info = newDecorateInfo(dn, s.rootScope().generation)
+	}
+	return nil
+}
+
+func newDecorateInfo(dn *decoratorNode, generation uint64) DecorateInfo {
+	return DecorateInfo{
+		ID:         (ID)(dn.id),
+		Inputs:     newInputs(dn.params.DotParam()),
+		Outputs:    newOutputs(dn.results.DotResult()),
+		Generation: generation,
+	}
+}
+
+// Decorators returns information about the decorators provided to the
+// Container. See Scope.Decorators.
+func (c *Container) Decorators() []DecorateInfo {
+	return c.scope.Decorators()
+}
+
+// Decorators returns information about the decorators provided directly to
+// this Scope, in the order they were provided. Decorators of parent Scopes
+// are not included.
+func (s *Scope) Decorators() []DecorateInfo {
+	defer s.lock()()
+
+	infos := make([]DecorateInfo, len(s.decoratorNodes))
+	for i, dn := range s.decoratorNodes {
+		infos[i] = DecorateInfo{
+			ID:      ID(dn.id),
+			Inputs:  newInputs(dn.params.DotParam()),
+			Outputs: newOutputs(dn.results.DotResult()),
 		}
-		for i, res := range results {
-			info.Outputs[i] = &Output{
-				t:     res.Type,
-				name:  res.Name,
-				group: res.Group,
-			}
+	}
+	return infos
+}
+
+// RemoveDecorator removes the decorator of values of the given type from
+// the Container. See Scope.RemoveDecorator.
+func (c *Container) RemoveDecorator(t reflect.Type, opts ...RemoveOption) error {
+	return c.scope.RemoveDecorator(t, opts...)
+}
+
+// RemoveDecorator removes the decorator of values of the given type from
+// the Scope, so that they're resolved as if it had never been provided.
+// Use RemoveName and RemoveGroup to identify named values and value
+// groups. ForceRemove has no effect.
+//
+//	c.RemoveDecorator(reflect.TypeOf(&zap.Logger{}))
+//
+// The decorator is removed entirely, including for the other values it
+// decorates. If it has already been called, the values it produced are
+// discarded, but values that were built from them are kept. This allows a
+// Container with decorators meant for production to be reused by tests
+// that need the original values, provided the decorators are removed
+// before those values are used.
+func (s *Scope) RemoveDecorator(t reflect.Type, opts ...RemoveOption) error {
+	if t == nil {
+		return newErrInvalidInput("can't remove a decorator of an untyped nil", nil)
+	}
+	defer s.lock()()
+
+	var options removeOptions
+	for _, o := range opts {
+		o.applyRemoveOption(&options)
+	}
+	if options.Name != "" && options.Group != "" {
+		return newErrInvalidInput(fmt.Sprintf(
+			"cannot use named values with value groups: name:%q removed with group:%q",
+			options.Name, options.Group), nil)
+	}
+
+	if err := s.removeDecorator(key{t: t, name: options.Name, group: options.Group}); err != nil {
+		return err
+	}
+	s.recordWiring(wiringOp{scope: s, remove: &options, removeType: t, removeDecorator: true})
+	return nil
+}
+
+func (s *Scope) removeDecorator(k key) error {
+	dn, ok := s.decorators[k]
+	if !ok {
+		return newErrInvalidInput(
+			fmt.Sprintf("cannot remove decorator of %v: it is not decorated in this Scope", k), nil)
+	}
+	for _, dk := range dn.keys {
+		delete(s.decorators, dk)
+		delete(s.decoratedValues, dk)
+		delete(s.decoratedGroups, dk)
+	}
+
+	nodes := s.decoratorNodes[:0]
+	for _, n := range s.decoratorNodes {
+		if n != dn {
+			nodes = append(nodes, n)
 		}
 	}
+	s.decoratorNodes = nodes
 	return nil
 }
 
+// ResetDecorators removes all decorators from the Container.
+// See Scope.ResetDecorators.
+func (c *Container) ResetDecorators() {
+	c.scope.ResetDecorators()
+}
+
+// ResetDecorators removes all decorators provided directly to this Scope
+// as with RemoveDecorator. Decorators of parent and child Scopes are kept.
+func (s *Scope) ResetDecorators() {
+	defer s.lock()()
+	s.resetDecorators()
+}
+
+func (s *Scope) resetDecorators() {
+	s.decorators = make(map[key]*decoratorNode)
+	s.decoratedValues = make(map[key]reflect.Value)
+	s.decoratedGroups = make(map[key]reflect.Value)
+	s.decoratorNodes = nil
+	s.recordWiring(wiringOp{scope: s, resetDecorators: true})
+}
+
 func findResultKeys(r resultList) ([]key, error) {
 	// use BFS to search for all keys included in a resultList.
 	var (
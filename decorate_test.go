@@ -23,6 +23,7 @@ package dig_test
 import (
 	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -937,3 +938,121 @@ func TestFillDecorateInfoString(t *testing.T) {
 		assert.Contains(t, fmt.Sprint(opt), "FillDecorateInfo(0x")
 	})
 }
+
+func TestRemoveDecorator(t *testing.T) {
+	t.Parallel()
+
+	type A struct{ name string }
+	typeOfA := reflect.TypeOf(&A{})
+
+	t.Run("lists decorators in order", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{} })
+		c.RequireProvide(func() string { return "s" }, dig.Name("s"))
+		c.RequireDecorate(func(a *A) *A { return a })
+		c.RequireDecorate(func(p struct {
+			dig.In
+
+			S string `name:"s"`
+		}) (r struct {
+			dig.Out
+
+			S string `name:"s"`
+		}) {
+			r.S = p.S
+			return r
+		})
+
+		infos := c.Decorators()
+		require.Len(t, infos, 2)
+		assert.Equal(t, "[*dig_test.A]", fmt.Sprint(infos[0].Outputs))
+		assert.Equal(t, `[string[name = "s"]]`, fmt.Sprint(infos[1].Outputs))
+		assert.Empty(t, c.Scope("child").Decorators())
+	})
+
+	t.Run("removes decorator", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{name: "base"} })
+		c.RequireDecorate(func(a *A) *A { return &A{name: "decorated"} })
+		c.RequireInvoke(func(a *A) {
+			assert.Equal(t, "decorated", a.name)
+		})
+
+		require.NoError(t, c.RemoveDecorator(typeOfA))
+		c.RequireInvoke(func(a *A) {
+			assert.Equal(t, "base", a.name)
+		})
+		assert.Empty(t, c.Decorators())
+
+		// The value may be decorated again.
+		c.RequireDecorate(func(a *A) *A { return &A{name: "again"} })
+		c.RequireInvoke(func(a *A) {
+			assert.Equal(t, "again", a.name)
+		})
+	})
+
+	t.Run("removes group decorator", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() int { return 1 }, dig.Group("ints"))
+		c.RequireDecorate(func(p struct {
+			dig.In
+
+			Ints []int `group:"ints"`
+		}) (r struct {
+			dig.Out
+
+			Ints []int `group:"ints"`
+		}) {
+			r.Ints = append(p.Ints, 2)
+			return r
+		})
+
+		require.NoError(t, c.RemoveDecorator(reflect.TypeOf(0), dig.RemoveGroup("ints")))
+		c.RequireInvoke(func(p struct {
+			dig.In
+
+			Ints []int `group:"ints"`
+		}) {
+			assert.Equal(t, []int{1}, p.Ints)
+		})
+	})
+
+	t.Run("not decorated", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{} })
+
+		err := c.RemoveDecorator(typeOfA)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot remove decorator of *dig_test.A: it is not decorated in this Scope")
+	})
+
+	t.Run("reset keeps other scopes", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{name: "base"} })
+		c.RequireDecorate(func(a *A) *A { return &A{name: "root"} })
+		child := c.Scope("child")
+		child.RequireDecorate(func(a *A) *A { return &A{name: a.name + " child"} })
+
+		child.ResetDecorators()
+		child.RequireInvoke(func(a *A) {
+			assert.Equal(t, "root", a.name)
+		})
+
+		c.ResetDecorators()
+		c.RequireInvoke(func(a *A) {
+			assert.Equal(t, "base", a.name)
+		})
+	})
+
+	t.Run("cloned", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{name: "base"} })
+		c.RequireDecorate(func(a *A) *A { return &A{name: "decorated"} })
+		require.NoError(t, c.RemoveDecorator(typeOfA))
+
+		clone := c.Clone()
+		require.NoError(t, clone.Invoke(func(a *A) {
+			assert.Equal(t, "base", a.name)
+		}))
+	})
+}
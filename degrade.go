@@ -0,0 +1,147 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// DegradationKind identifies how a value was degraded. See Degradation.
+type DegradationKind int
+
+const (
+	// DegradedOptional indicates that an optional value was absent, and
+	// its consumer received the zero value instead.
+	DegradedOptional DegradationKind = iota + 1
+
+	// DegradedSubstitute indicates that a value without a constructor was
+	// substituted by another value, as with InferInterfaces.
+	DegradedSubstitute
+
+	// DegradedStub indicates that a value without a constructor was
+	// replaced by a stub, as with StubMissing.
+	DegradedStub
+)
+
+func (k DegradationKind) String() string {
+	switch k {
+	case DegradedOptional:
+		return "optional"
+	case DegradedSubstitute:
+		return "substitute"
+	case DegradedStub:
+		return "stub"
+	default:
+		return fmt.Sprintf("DegradationKind(%d)", int(k))
+	}
+}
+
+// Degradation describes a value that could not be resolved as requested,
+// but didn't cause the Invoke to fail.
+type Degradation struct {
+	Kind DegradationKind
+
+	// Type and Name of the value that was requested.
+	Type reflect.Type
+	Name string
+
+	// Substitute describes the value used instead, for DegradedSubstitute.
+	Substitute string
+
+	// Reason explains why an optional value was absent even though it has
+	// a constructor, for example because that constructor's dependencies
+	// are missing. It's nil if the value has no constructor.
+	Reason error
+}
+
+func (d Degradation) String() string {
+	k := key{t: d.Type, name: d.Name}
+	switch {
+	case d.Kind == DegradedSubstitute:
+		return fmt.Sprintf("%v substituted by %v", k, d.Substitute)
+	case d.Kind == DegradedStub:
+		return fmt.Sprintf("%v stubbed", k)
+	case d.Reason != nil:
+		return fmt.Sprintf("optional %v is absent: %v", k, d.Reason)
+	default:
+		return fmt.Sprintf("optional %v is absent", k)
+	}
+}
+
+// DegradationReport lists the values that were degraded while resolving
+// an Invoke. See FillDegradationReport.
+type DegradationReport struct {
+	Degradations []Degradation
+}
+
+// Degraded reports whether any values were degraded.
+func (r *DegradationReport) Degraded() bool {
+	return len(r.Degradations) > 0
+}
+
+func (r *DegradationReport) add(d Degradation) {
+	for _, o := range r.Degradations {
+		if o.Kind == d.Kind && o.Type == d.Type && o.Name == d.Name {
+			return
+		}
+	}
+	r.Degradations = append(r.Degradations, d)
+}
+
+// FillDegradationReport is an InvokeOption that records in the given
+// report the values that were degraded while resolving the dependencies
+// of the invoked function: optional values that were absent, and values
+// that were substituted. Each value is listed once.
+//
+//	var report dig.DegradationReport
+//	err := c.Invoke(start, dig.FillDegradationReport(&report))
+//	for _, d := range report.Degradations {
+//	  log.Warn("running in degraded mode", zap.Stringer("reason", d))
+//	}
+//
+// Only values built for this Invoke are considered. Values that were
+// built earlier, and the values they were built from, are not listed.
+func FillDegradationReport(r *DegradationReport) InvokeOption {
+	return fillDegradationReportOption{r: r}
+}
+
+type fillDegradationReportOption struct{ r *DegradationReport }
+
+func (o fillDegradationReportOption) String() string {
+	return fmt.Sprintf("FillDegradationReport(%p)", o.r)
+}
+
+func (o fillDegradationReportOption) applyInvokeOption(opts *invokeOptions) {
+	opts.Report = o.r
+}
+
+// recordDegradation records the given degradation in the report of the
+// innermost Invoke being resolved that has one.
+func recordDegradation(c containerStore, d Degradation) {
+	path := c.resolutionPath()
+	for i := len(path) - 1; i >= 0; i-- {
+		if r := path[i].Report; r != nil {
+			r.add(d)
+			return
+		}
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+type degradedCache interface{ Get(string) string }
+
+type degradedMemCache struct{}
+
+func (degradedMemCache) Get(string) string { return "" }
+
+func TestFillDegradationReport(t *testing.T) {
+	t.Parallel()
+
+	type (
+		Metrics struct{}
+		Tracer  struct{}
+		Missing struct{}
+		Server  struct{}
+	)
+
+	t.Run("optional values", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func(*Missing) *Tracer { return &Tracer{} })
+		c.RequireProvide(func(p struct {
+			dig.In
+
+			Metrics *Metrics `optional:"true"`
+			Tracer  *Tracer  `optional:"true"`
+		}) *Server {
+			return &Server{}
+		})
+
+		var report dig.DegradationReport
+		c.RequireInvoke(func(*Server, struct {
+			dig.In
+
+			Metrics *Metrics `name:"m" optional:"true"`
+		}) {
+		}, dig.FillDegradationReport(&report))
+
+		require.True(t, report.Degraded())
+		require.Len(t, report.Degradations, 3)
+
+		m := report.Degradations[0]
+		assert.Equal(t, dig.DegradedOptional, m.Kind)
+		assert.Equal(t, reflect.TypeOf(&Metrics{}), m.Type)
+		assert.Empty(t, m.Name)
+		assert.NoError(t, m.Reason)
+		assert.Equal(t, "optional *dig_test.Metrics is absent", m.String())
+
+		tr := report.Degradations[1]
+		assert.Equal(t, reflect.TypeOf(&Tracer{}), tr.Type)
+		require.Error(t, tr.Reason)
+		assert.Contains(t, tr.String(), "optional *dig_test.Tracer is absent: missing dependencies for function")
+
+		assert.Equal(t, "m", report.Degradations[2].Name)
+	})
+
+	t.Run("substitutes", func(t *testing.T) {
+		c := digtest.New(t, dig.InferInterfaces())
+		c.RequireProvide(func() *degradedMemCache { return &degradedMemCache{} })
+
+		var report dig.DegradationReport
+		c.RequireInvoke(func(degradedCache) {}, dig.FillDegradationReport(&report))
+		c.RequireInvoke(func(degradedCache) {}, dig.FillDegradationReport(&report))
+
+		require.Len(t, report.Degradations, 1, "values must be listed once")
+		d := report.Degradations[0]
+		assert.Equal(t, dig.DegradedSubstitute, d.Kind)
+		assert.Equal(t, "dig_test.degradedCache substituted by *dig_test.degradedMemCache", d.String())
+	})
+
+	t.Run("not degraded", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *Metrics { return &Metrics{} })
+
+		var report dig.DegradationReport
+		c.RequireInvoke(func(p struct {
+			dig.In
+
+			Metrics *Metrics `optional:"true"`
+		}) {
+		}, dig.FillDegradationReport(&report))
+		assert.False(t, report.Degraded())
+	})
+
+	t.Run("String", func(t *testing.T) {
+		assert.Equal(t, "optional", dig.DegradedOptional.String())
+		assert.Equal(t, "substitute", dig.DegradedSubstitute.String())
+		assert.Equal(t, "DegradationKind(42)", dig.DegradationKind(42).String())
+
+		var report dig.DegradationReport
+		assert.Equal(t, fmt.Sprintf("FillDegradationReport(%p)", &report),
+			fmt.Sprint(dig.FillDegradationReport(&report)))
+	})
+}
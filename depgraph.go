@@ -0,0 +1,305 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Graph is a read-only view of the dependency graph of a Container, for
+// tools that analyze the wiring of an application.
+//
+// Constructors are connected to the constructors of the values they
+// depend on, and value groups are nodes of their own connected to the
+// constructors of their members. Dependencies without a constructor,
+// such as missing or stubbed values, have no edges.
+type Graph struct {
+	// Nodes of the graph. The ID of each node is its position in Nodes
+	// plus one.
+	Nodes []GraphNode
+
+	// Edges lists the dependencies between nodes.
+	Edges []GraphEdge
+}
+
+// GraphNodeKind specifies what a GraphNode represents.
+type GraphNodeKind int
+
+const (
+	// GraphConstructorNode is a constructor provided to the container.
+	GraphConstructorNode GraphNodeKind = iota + 1
+
+	// GraphGroupNode is a value group, as consumed from a Scope.
+	GraphGroupNode
+)
+
+func (k GraphNodeKind) String() string {
+	switch k {
+	case GraphConstructorNode:
+		return "constructor"
+	case GraphGroupNode:
+		return "group"
+	default:
+		return fmt.Sprintf("GraphNodeKind(%d)", int(k))
+	}
+}
+
+// GraphNode is a single node of a Graph.
+type GraphNode struct {
+	// ID of the node, unique within the Graph.
+	ID int
+
+	Kind GraphNodeKind
+
+	// Scope is the path of the Scope that the constructor was provided to
+	// or that the value group is consumed from, made of the names of the
+	// Scopes from the root separated by "/". It is empty for the
+	// Container.
+	Scope string
+
+	// Location where the constructor was defined. Set for constructors.
+	Location Location
+
+	// Produces lists the values produced by the constructor, and Consumes
+	// the values that it depends on. Set for constructors.
+	Produces []GraphKey
+	Consumes []GraphDependency
+
+	// Key of the value group. Set for value groups.
+	Key GraphKey
+}
+
+// GraphKey identifies a value or a value group in a Graph.
+type GraphKey struct {
+	Type reflect.Type
+
+	// Name or value group of the value, if any. Keyed members of value
+	// groups carry both.
+	Name  string
+	Group string
+}
+
+func newGraphKey(k key) GraphKey {
+	return GraphKey{Type: k.t, Name: k.name, Group: k.group}
+}
+
+func (k GraphKey) String() string {
+	switch {
+	case k.Group != "" && k.Name != "":
+		return fmt.Sprintf("%v[group=%q, key=%q]", k.Type, k.Group, k.Name)
+	case k.Name != "":
+		return fmt.Sprintf("%v[name=%q]", k.Type, k.Name)
+	case k.Group != "":
+		return fmt.Sprintf("%v[group=%q]", k.Type, k.Group)
+	}
+	return fmt.Sprint(k.Type)
+}
+
+// GraphDependency is a value that a constructor depends on.
+type GraphDependency struct {
+	Key GraphKey
+
+	// Optional reports whether the dependency was marked optional.
+	Optional bool
+}
+
+// GraphEdge connects a node of a Graph to a node that it depends on.
+type GraphEdge struct {
+	// IDs of the dependent node and of its dependency.
+	From, To int
+
+	// Key of the value that the dependency provides.
+	Key GraphKey
+
+	// Optional reports whether the dependency was marked optional.
+	Optional bool
+}
+
+// Graph returns the dependency graph of the Container and all of its
+// Scopes.
+//
+// The Graph shares no state with the Container and is not updated when
+// the Container changes afterwards.
+func (c *Container) Graph() *Graph {
+	return c.scope.Graph()
+}
+
+// Graph returns the dependency graph of this Scope and all of its
+// descendants. See Container.Graph.
+func (s *Scope) Graph() *Graph {
+	defer s.lock()()
+	return newGraphBuilder().build(s)
+}
+
+type graphBuilder struct {
+	g      *Graph
+	ctors  map[*constructorNode]int
+	groups map[groupRef]int
+}
+
+// groupRef identifies a value group as seen from a Scope, since child
+// Scopes may add members to the groups of their parents.
+type groupRef struct {
+	s *Scope
+	k key
+}
+
+func newGraphBuilder() *graphBuilder {
+	return &graphBuilder{
+		g:      &Graph{Nodes: []GraphNode{}, Edges: []GraphEdge{}},
+		ctors:  make(map[*constructorNode]int),
+		groups: make(map[groupRef]int),
+	}
+}
+
+func (b *graphBuilder) build(root *Scope) *Graph {
+	var nodes []*constructorNode
+	for _, s := range root.appendSubscopes(nil) {
+		for _, n := range s.nodes {
+			if _, ok := b.ctors[n]; ok {
+				continue
+			}
+			nodes = append(nodes, n)
+
+			var produces []GraphKey
+			for _, k := range resultKeys(n) {
+				produces = append(produces, newGraphKey(k))
+			}
+			b.ctors[n] = b.addNode(GraphNode{
+				Kind:     GraphConstructorNode,
+				Scope:    n.OrigScope().path(),
+				Location: newLocation(n.Location()),
+				Produces: produces,
+				Consumes: appendDependencies(nil, n.paramList, false),
+			})
+		}
+	}
+	for _, n := range nodes {
+		b.addEdges(n.OrigScope(), b.ctors[n], n.paramList, false)
+	}
+	return b.g
+}
+
+func (b *graphBuilder) addNode(n GraphNode) int {
+	n.ID = len(b.g.Nodes) + 1
+	b.g.Nodes = append(b.g.Nodes, n)
+	return n.ID
+}
+
+// appendDependencies appends the values requested by p to deps.
+func appendDependencies(deps []GraphDependency, p param, optional bool) []GraphDependency {
+	switch p := p.(type) {
+	case paramList:
+		for _, p := range p.Params {
+			deps = appendDependencies(deps, p, optional)
+		}
+	case paramObject:
+		for _, f := range p.Fields {
+			deps = appendDependencies(deps, f.Param, optional)
+		}
+	case paramSingle:
+		deps = append(deps, GraphDependency{
+			Key:      GraphKey{Type: p.Type, Name: p.Name},
+			Optional: optional || p.Optional,
+		})
+	case paramGroupMember:
+		deps = append(deps, GraphDependency{
+			Key:      GraphKey{Type: p.Type, Name: p.Key, Group: p.Group},
+			Optional: optional || p.Optional,
+		})
+	case paramGroupedSlice:
+		deps = append(deps, GraphDependency{
+			Key:      GraphKey{Type: p.Type.Elem(), Group: p.Group},
+			Optional: optional,
+		})
+	}
+	return deps
+}
+
+// addEdges connects the node with the given ID to the providers of the
+// values requested by p when resolved in s.
+func (b *graphBuilder) addEdges(s *Scope, from int, p param, optional bool) {
+	var (
+		k         key
+		providers []provider
+	)
+	switch p := p.(type) {
+	case paramList:
+		for _, p := range p.Params {
+			b.addEdges(s, from, p, optional)
+		}
+		return
+	case paramObject:
+		for _, f := range p.Fields {
+			b.addEdges(s, from, f.Param, optional)
+		}
+		return
+	case paramSingle:
+		k = key{t: p.Type, name: p.Name}
+		optional = optional || p.Optional
+		providers = s.getAllValueProviders(p.Name, p.Type)
+	case paramGroupMember:
+		k = key{t: p.Type, group: p.Group, name: p.Key}
+		optional = optional || p.Optional
+		providers = p.providers(s)
+	case paramGroupedSlice:
+		k = key{t: p.Type.Elem(), group: p.Group}
+		b.addEdge(from, b.groupNode(s, k), k, optional)
+		return
+	}
+
+	for _, pr := range providers {
+		if n, ok := pr.(*constructorNode); ok {
+			b.addEdge(from, b.ctors[n], k, optional)
+		}
+	}
+}
+
+// groupNode returns the ID of the node for the given value group,
+// adding it along with edges to its members' constructors if needed.
+func (b *graphBuilder) groupNode(s *Scope, k key) int {
+	ref := groupRef{s: s, k: k}
+	if id, ok := b.groups[ref]; ok {
+		return id
+	}
+	id := b.addNode(GraphNode{Kind: GraphGroupNode, Scope: s.path(), Key: newGraphKey(k)})
+	b.groups[ref] = id
+	for _, pr := range s.getAllGroupProviders(k.group, k.t) {
+		if n, ok := pr.(*constructorNode); ok {
+			b.addEdge(id, b.ctors[n], k, false)
+		}
+	}
+	return id
+}
+
+func (b *graphBuilder) addEdge(from, to int, k key, optional bool) {
+	if to == 0 {
+		// The provider isn't a constructor listed by any Scope.
+		return
+	}
+	b.g.Edges = append(b.g.Edges, GraphEdge{
+		From:     from,
+		To:       to,
+		Key:      newGraphKey(k),
+		Optional: optional,
+	})
+}
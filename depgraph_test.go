@@ -0,0 +1,121 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestGraph(t *testing.T) {
+	t.Parallel()
+
+	type A struct{}
+	type B struct{}
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+
+		g := digtest.New(t).Graph()
+		assert.Empty(t, g.Nodes)
+		assert.Empty(t, g.Edges)
+	})
+
+	t.Run("nodes and edges", func(t *testing.T) {
+		t.Parallel()
+
+		type params struct {
+			dig.In
+
+			A *A       `name:"a"`
+			B *B       `optional:"true"`
+			S []string `group:"strs"`
+		}
+
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{} }, dig.Name("a"))
+		c.RequireProvide(func() *B { return &B{} })
+		c.RequireProvide(func() string { return "x" }, dig.Group("strs"))
+		c.RequireProvide(func(params) int { return 0 })
+
+		g := c.Graph()
+		require.Len(t, g.Nodes, 5)
+		for i, n := range g.Nodes {
+			assert.Equal(t, i+1, n.ID)
+		}
+
+		ctor := g.Nodes[3]
+		assert.Equal(t, dig.GraphConstructorNode, ctor.Kind)
+		assert.Equal(t, "go.uber.org/dig_test", ctor.Location.Package)
+		assert.Contains(t, ctor.Location.File, "depgraph_test.go")
+		assert.Equal(t, []dig.GraphKey{{Type: reflect.TypeOf(0)}}, ctor.Produces)
+		assert.Equal(t, []dig.GraphDependency{
+			{Key: dig.GraphKey{Type: reflect.TypeOf(&A{}), Name: "a"}},
+			{Key: dig.GraphKey{Type: reflect.TypeOf(&B{})}, Optional: true},
+			{Key: dig.GraphKey{Type: reflect.TypeOf(""), Group: "strs"}},
+		}, ctor.Consumes)
+
+		group := g.Nodes[4]
+		assert.Equal(t, dig.GraphGroupNode, group.Kind)
+		assert.Equal(t, "string[group=\"strs\"]", group.Key.String())
+
+		assert.Equal(t, []dig.GraphEdge{
+			{From: 4, To: 1, Key: dig.GraphKey{Type: reflect.TypeOf(&A{}), Name: "a"}},
+			{From: 4, To: 2, Key: dig.GraphKey{Type: reflect.TypeOf(&B{})}, Optional: true},
+			{From: 5, To: 3, Key: group.Key},
+			{From: 4, To: 5, Key: group.Key},
+		}, g.Edges)
+	})
+
+	t.Run("scope", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{} })
+		child := c.Scope("child")
+		require.NoError(t, child.Provide(func(*A) *B { return &B{} }))
+
+		g := child.Graph()
+		require.Len(t, g.Nodes, 1)
+		assert.Equal(t, "child", g.Nodes[0].Scope)
+		assert.Empty(t, g.Edges, "providers outside the Scope are not part of its graph")
+
+		assert.Len(t, c.Graph().Edges, 1)
+	})
+
+	t.Run("String", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "constructor", dig.GraphConstructorNode.String())
+		assert.Equal(t, "group", dig.GraphGroupNode.String())
+		assert.Equal(t, "GraphNodeKind(42)", dig.GraphNodeKind(42).String())
+
+		k := dig.GraphKey{Type: reflect.TypeOf(0), Name: "n", Group: "g"}
+		assert.Equal(t, `int[group="g", key="n"]`, k.String())
+		k.Group = ""
+		assert.Equal(t, `int[name="n"]`, k.String())
+	})
+}
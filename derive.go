@@ -0,0 +1,112 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"reflect"
+
+	"go.uber.org/dig/internal/digreflect"
+)
+
+// DerivePerConsumer is an Option that gives each constructor and invoked
+// function that depends on a value of type T its own copy of it, derived
+// from the value in the container with the given function. The function
+// receives the location of the consumer.
+//
+// This is intended for loggers, so that each component receives a logger
+// named after it without a hand-written provider per component.
+//
+//	c := dig.New(dig.DerivePerConsumer(func(log *zap.Logger, consumer dig.Location) *zap.Logger {
+//	  return log.Named(consumer.Package + "." + consumer.Name)
+//	}))
+//
+// The value in the container is built as usual, and only the values
+// passed to consumers are derived from it. Named values and fields of
+// parameter objects are derived as well. Decorators receive values of T
+// as-is, and optional dependencies that are absent aren't derived.
+//
+// Only one derivation function may be given per type; later ones replace
+// earlier ones.
+func DerivePerConsumer[T any](derive func(value T, consumer Location) T) Option {
+	return derivePerConsumerOption{
+		t: reflect.TypeOf((*T)(nil)).Elem(),
+		derive: func(v reflect.Value, consumer Location) reflect.Value {
+			// A nil interface can't be asserted to T, but the zero value
+			// of T is nil as well.
+			value, _ := v.Interface().(T)
+			derived := derive(value, consumer)
+			return reflect.ValueOf(&derived).Elem()
+		},
+	}
+}
+
+type derivePerConsumerOption struct {
+	t      reflect.Type
+	derive deriveFunc
+}
+
+// deriveFunc derives a value for the consumer at the given location.
+type deriveFunc func(v reflect.Value, consumer Location) reflect.Value
+
+func (o derivePerConsumerOption) String() string {
+	return fmt.Sprintf("DerivePerConsumer(%v)", o.t)
+}
+
+func (o derivePerConsumerOption) applyOption(c *Container) {
+	if c.scope.derivers == nil {
+		c.scope.derivers = make(map[reflect.Type]deriveFunc)
+	}
+	c.scope.derivers[o.t] = o.derive
+}
+
+// deriveArgs replaces the arguments built for the given parameters with
+// values derived for the consumer at the given location. See
+// DerivePerConsumer.
+func (s *Scope) deriveArgs(pl paramList, args []reflect.Value, consumer *digreflect.Func) {
+	derivers := s.rootScope().derivers
+	if len(derivers) == 0 {
+		return
+	}
+	loc := newLocation(consumer)
+	for i, p := range pl.Params {
+		args[i] = deriveArg(derivers, p, args[i], loc)
+	}
+}
+
+func deriveArg(derivers map[reflect.Type]deriveFunc, p param, v reflect.Value, loc Location) reflect.Value {
+	switch p := p.(type) {
+	case paramSingle:
+		if derive, ok := derivers[p.Type]; ok && !(p.Optional && v.IsZero()) {
+			return derive(v, loc)
+		}
+	case paramObject:
+		// Arguments are not addressable, so fields are set on a copy.
+		obj := reflect.New(v.Type()).Elem()
+		obj.Set(v)
+		for _, f := range p.Fields {
+			field := obj.Field(f.FieldIndex)
+			field.Set(deriveArg(derivers, f.Param, field, loc))
+		}
+		return obj
+	}
+	return v
+}
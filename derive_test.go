@@ -0,0 +1,125 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+type namedLogger struct{ names []string }
+
+func (l *namedLogger) Named(name string) *namedLogger {
+	return &namedLogger{names: append(append([]string(nil), l.names...), name)}
+}
+
+type (
+	loggedServer struct{ log *namedLogger }
+	loggedStore  struct{ log *namedLogger }
+)
+
+func newLoggedServer(log *namedLogger, _ *loggedStore) *loggedServer {
+	return &loggedServer{log: log}
+}
+
+func newLoggedStore(p struct {
+	dig.In
+
+	Log *namedLogger
+}) *loggedStore {
+	return &loggedStore{log: p.Log}
+}
+
+func TestDerivePerConsumer(t *testing.T) {
+	t.Parallel()
+
+	newContainer := func(t *testing.T) *digtest.Container {
+		c := digtest.New(t, dig.DerivePerConsumer(func(log *namedLogger, consumer dig.Location) *namedLogger {
+			return log.Named(consumer.Name)
+		}))
+		var built int
+		c.RequireProvide(func() *namedLogger {
+			built++
+			require.Equal(t, 1, built, "base logger must be built once")
+			return &namedLogger{names: []string{"root"}}
+		})
+		return c
+	}
+
+	t.Run("constructors and parameter objects", func(t *testing.T) {
+		c := newContainer(t)
+		c.RequireProvide(newLoggedServer)
+		c.RequireProvide(newLoggedStore)
+
+		c.RequireInvoke(func(s *loggedServer, st *loggedStore) {
+			assert.Equal(t, []string{"root", "newLoggedServer"}, s.log.names)
+			assert.Equal(t, []string{"root", "newLoggedStore"}, st.log.names)
+		})
+	})
+
+	t.Run("invoked function", func(t *testing.T) {
+		c := newContainer(t)
+
+		c.RequireInvoke(func(log *namedLogger) {
+			require.Len(t, log.names, 2)
+			assert.Regexp(t, `^TestDerivePerConsumer\.func[\d.]+$`, log.names[1])
+		})
+	})
+
+	t.Run("decorators receive the value as-is", func(t *testing.T) {
+		c := newContainer(t)
+		c.RequireDecorate(func(log *namedLogger) *namedLogger {
+			assert.Equal(t, []string{"root"}, log.names)
+			return log.Named("decorated")
+		})
+		c.RequireProvide(newLoggedServer)
+		c.RequireProvide(func() *loggedStore { return nil })
+
+		c.RequireInvoke(func(s *loggedServer) {
+			assert.Equal(t, []string{"root", "decorated", "newLoggedServer"}, s.log.names)
+		})
+	})
+
+	t.Run("absent optional value", func(t *testing.T) {
+		c := digtest.New(t, dig.DerivePerConsumer(func(*namedLogger, dig.Location) *namedLogger {
+			t.Fatal("must not derive absent values")
+			return nil
+		}))
+
+		c.RequireInvoke(func(p struct {
+			dig.In
+
+			Log *namedLogger `optional:"true"`
+		}) {
+			assert.Nil(t, p.Log)
+		})
+	})
+
+	t.Run("String", func(t *testing.T) {
+		opt := dig.DerivePerConsumer(func(l *namedLogger, _ dig.Location) *namedLogger { return l })
+		assert.Equal(t, "DerivePerConsumer(*dig_test.namedLogger)", fmt.Sprint(opt))
+	})
+}
@@ -25,3 +25,7 @@ import "math/rand"
 func SetRand(r *rand.Rand) Option {
 	return setRand(r)
 }
+
+func SetPlatform(p string) Option {
+	return setPlatform(p)
+}
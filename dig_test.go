@@ -1585,7 +1585,26 @@ func TestRecoverFromPanic(t *testing.T) {
 			wantErr: []string{
 				`could not build arguments for function "go.uber.org/dig_test".TestRecoverFromPanic.\S+`,
 				`failed to build int:`,
-				`panic: "terrible sadness" in func: "go.uber.org/dig_test".TestRecoverFromPanic.\S+`,
+				`while building int for Invoke at "go.uber.org/dig_test".TestRecoverFromPanic.\S+ \(\S+\): ` +
+					`panic: "terrible sadness" in func: "go.uber.org/dig_test".TestRecoverFromPanic.\S+`,
+			},
+		},
+		{
+			name: "panic in nested provided function",
+			setup: func(c *digtest.Container) {
+				c.RequireProvide(func() int {
+					panic("terrible sadness")
+				})
+				c.RequireProvide(func(int) string { return "" })
+			},
+			invoke: func(s string) {},
+			wantErr: []string{
+				`could not build arguments for function "go.uber.org/dig_test".TestRecoverFromPanic.\S+`,
+				`failed to build string:`,
+				`could not build arguments for function "go.uber.org/dig_test".TestRecoverFromPanic.\S+`,
+				`failed to build int:`,
+				`while building int for string for Invoke at "go.uber.org/dig_test".TestRecoverFromPanic.\S+ \(\S+\): ` +
+					`panic: "terrible sadness" in func: "go.uber.org/dig_test".TestRecoverFromPanic.\S+`,
 			},
 		},
 		{
@@ -1600,7 +1619,8 @@ func TestRecoverFromPanic(t *testing.T) {
 			wantErr: []string{
 				`could not build arguments for function "go.uber.org/dig_test".TestRecoverFromPanic.\S+`,
 				`failed to build string:`,
-				`panic: "great sadness" in func: "go.uber.org/dig_test".TestRecoverFromPanic.\S+`,
+				`while building string for Invoke at "go.uber.org/dig_test".TestRecoverFromPanic.\S+ \(\S+\): ` +
+					`panic: "great sadness" in func: "go.uber.org/dig_test".TestRecoverFromPanic.\S+`,
 			},
 		},
 		{
@@ -1608,7 +1628,7 @@ func TestRecoverFromPanic(t *testing.T) {
 			setup:  func(c *digtest.Container) {},
 			invoke: func() { panic("terrible woe") },
 			wantErr: []string{
-				`panic: "terrible woe" in func: "go.uber.org/dig_test".TestRecoverFromPanic.\S+`,
+				`^panic: "terrible woe" in func: "go.uber.org/dig_test".TestRecoverFromPanic.\S+`,
 			},
 		},
 	}
@@ -1624,7 +1644,7 @@ func TestRecoverFromPanic(t *testing.T) {
 			})
 
 			t.Run("with option", func(t *testing.T) {
-				c := digtest.New(t, dig.RecoverFromPanics())
+				c := digtest.New(t, dig.RecoverFromPanics(), dig.Compatibility(dig.CompatExtended))
 				tt.setup(c)
 				err := c.Container.Invoke(tt.invoke)
 				require.Error(t, err)
@@ -2001,15 +2021,50 @@ func TestAsExpectingOriginalType(t *testing.T) {
 func TestProvideIncompatibleOptions(t *testing.T) {
 	t.Parallel()
 
-	t.Run("group and name", func(t *testing.T) {
+	t.Run("group with different name and key", func(t *testing.T) {
+		c := digtest.New(t)
+		err := c.Provide(func() io.Reader {
+			t.Fatal("this function must not be called")
+			return nil
+		}, dig.Group("foo"), dig.Name("bar"), dig.GroupKey("baz"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `cannot use dig.Name("bar") and dig.GroupKey("baz") together`)
+	})
+
+	t.Run("transient and group", func(t *testing.T) {
+		c := digtest.New(t)
+		err := c.Provide(func() io.Reader {
+			t.Fatal("this function must not be called")
+			return nil
+		}, dig.Group("foo"), dig.Transient())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `cannot use transient constructors with value groups: group:"foo"`)
+	})
+
+	t.Run("transient and group result", func(t *testing.T) {
+		type out struct {
+			dig.Out
+
+			Reader io.Reader `group:"foo"`
+		}
+
+		c := digtest.New(t)
+		err := c.Provide(func() out {
+			t.Fatal("this function must not be called")
+			return out{}
+		}, dig.Transient())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot use transient constructors with value groups")
+	})
+
+	t.Run("transient and eager", func(t *testing.T) {
 		c := digtest.New(t)
 		err := c.Provide(func() io.Reader {
 			t.Fatal("this function must not be called")
 			return nil
-		}, dig.Group("foo"), dig.Name("bar"))
+		}, dig.Eager(), dig.Transient())
 		require.Error(t, err)
-		assert.Contains(t, err.Error(), "cannot use named values with value groups: "+
-			`name:"bar" provided with group:"foo"`)
+		assert.Contains(t, err.Error(), "cannot use dig.Eager with dig.Transient")
 	})
 }
 
@@ -2181,7 +2236,7 @@ func testProvideCycleFails(t *testing.T, dryRun bool) {
 		newB := func(*A) *B { return &B{} }
 		newC := func(*B) *C { return &C{} }
 
-		c := digtest.New(t, dig.DryRun(dryRun))
+		c := digtest.New(t, dig.DryRun(dryRun), dig.Compatibility(dig.CompatExtended))
 		c.RequireProvide(newA)
 		c.RequireProvide(newB)
 		err := c.Provide(newC)
@@ -2192,9 +2247,9 @@ func testProvideCycleFails(t *testing.T, dryRun bool) {
 			`dig_test.go:\d+`, // file:line
 			`this function introduces a cycle:`,
 			`func\(\*dig_test.C\) \*dig_test.A provided by "go.uber.org/dig_test".testProvideCycleFails\S+ \(\S+\)`,
-			`depends on func\(\*dig_test.B\) \*dig_test.C provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
-			`depends on func\(\*dig_test.A\) \*dig_test.B provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
-			`depends on func\(\*dig_test.C\) \*dig_test.A provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
+			`depends on \*dig_test.C from func\(\*dig_test.B\) \*dig_test.C provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
+			`depends on \*dig_test.B from func\(\*dig_test.A\) \*dig_test.B provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
+			`depends on \*dig_test.A from func\(\*dig_test.C\) \*dig_test.A provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
 		)
 		assert.NotContains(t, err.Error(), "[scope")
 		assert.Error(t, c.Invoke(func(c *C) {}), "expected invoking a function that uses a type that failed to provide to fail.")
@@ -2229,7 +2284,7 @@ func testProvideCycleFails(t *testing.T, dryRun bool) {
 		}
 		newC := func(CParams) C { return C{} }
 
-		c := digtest.New(t, dig.DryRun(dryRun))
+		c := digtest.New(t, dig.DryRun(dryRun), dig.Compatibility(dig.CompatExtended))
 		c.RequireProvide(newA)
 		c.RequireProvide(newB)
 
@@ -2241,9 +2296,9 @@ func testProvideCycleFails(t *testing.T, dryRun bool) {
 			`dig_test.go:\d+`, // file:line
 			`this function introduces a cycle:`,
 			`func\(dig_test.AParams\) dig_test.A provided by "go.uber.org/dig_test".testProvideCycleFails\S+ \(\S+\)`,
-			`depends on func\(dig_test.CParams\) dig_test.C provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
-			`depends on func\(dig_test.BParams\) dig_test.B provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
-			`depends on func\(dig_test.AParams\) dig_test.A provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
+			`depends on dig_test.C from func\(dig_test.CParams\) dig_test.C provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
+			`depends on dig_test.B from func\(dig_test.BParams\) dig_test.B provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
+			`depends on dig_test.A from func\(dig_test.AParams\) dig_test.A provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
 		)
 		assert.Error(t, c.Invoke(func(c C) {}), "expected invoking a function that uses a type that failed to provide to fail.")
 	})
@@ -2300,7 +2355,7 @@ func testProvideCycleFails(t *testing.T, dryRun bool) {
 			return nil
 		}
 
-		c := digtest.New(t)
+		c := digtest.New(t, dig.Compatibility(dig.CompatExtended))
 		c.RequireProvide(newA)
 		c.RequireProvide(newB)
 		c.RequireProvide(newC)
@@ -2313,9 +2368,9 @@ func testProvideCycleFails(t *testing.T, dryRun bool) {
 			`dig_test.go:\d+`, // file:line
 			`this function introduces a cycle:`,
 			`func\(\*dig_test.D\) dig_test.outB provided by "go.uber.org/dig_test".testProvideCycleFails\S+ \(\S+\)`,
-			`depends on func\(dig_test.inD\) \*dig_test.D provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
-			`depends on func\(dig_test.inC\) dig_test.outC provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
-			`depends on func\(\*dig_test.D\) dig_test.outB provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
+			`depends on \*dig_test.D from func\(dig_test.inD\) \*dig_test.D provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
+			`depends on int\[group="bar"\] from func\(dig_test.inC\) dig_test.outC provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
+			`depends on string\[group="foo"\] from func\(\*dig_test.D\) dig_test.outB provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
 		)
 	})
 
@@ -2332,7 +2387,7 @@ func testProvideCycleFails(t *testing.T, dryRun bool) {
 		newC := func(*B) *C { return &C{} }
 		newD := func(*C) *D { return &D{} }
 
-		c := digtest.New(t, dig.DeferAcyclicVerification())
+		c := digtest.New(t, dig.DeferAcyclicVerification(), dig.Compatibility(dig.CompatExtended))
 		c.RequireProvide(newA)
 		c.RequireProvide(newB)
 		c.RequireProvide(newC)
@@ -2344,12 +2399,52 @@ func testProvideCycleFails(t *testing.T, dryRun bool) {
 		dig.AssertErrorMatches(t, err,
 			`cycle detected in dependency graph:`,
 			`func\(\*dig_test.C\) \*dig_test.A provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
-			`depends on func\(\*dig_test.B\) \*dig_test.C provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
-			`depends on func\(\*dig_test.A\) \*dig_test.B provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
-			`depends on func\(\*dig_test.C\) \*dig_test.A provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
+			`depends on \*dig_test.C from func\(\*dig_test.B\) \*dig_test.C provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
+			`depends on \*dig_test.B from func\(\*dig_test.A\) \*dig_test.B provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
+			`depends on \*dig_test.A from func\(\*dig_test.C\) \*dig_test.A provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
 		)
 	})
 
+	t.Run("reports the shortest cycle", func(t *testing.T) {
+		// A -> B -> C
+		// ^    |    |
+		// |----'    |
+		// '---------'
+		type A struct{}
+		type B struct{}
+		type C struct{}
+		type BParams struct {
+			dig.In
+
+			C *C
+			A *A `name:"a"`
+		}
+		type CParams struct {
+			dig.In
+
+			A *A `name:"a"`
+		}
+		newA := func(*B) *A { return &A{} }
+		newB := func(BParams) *B { return &B{} }
+		newC := func(CParams) *C { return &C{} }
+
+		c := digtest.New(t, dig.DeferAcyclicVerification(), dig.Compatibility(dig.CompatExtended))
+		c.RequireProvide(newA, dig.Name("a"))
+		c.RequireProvide(newB)
+		c.RequireProvide(newC)
+
+		err := c.Invoke(func(*B) {})
+		require.Error(t, err, "expected error when introducing cycle")
+		assert.True(t, dig.IsCycleDetected(err))
+		dig.AssertErrorMatches(t, err,
+			`cycle detected in dependency graph:`,
+			`func\(\*dig_test.B\) \*dig_test.A provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
+			`depends on \*dig_test.B from func\(dig_test.BParams\) \*dig_test.B provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
+			`depends on \*dig_test.A\[name="a"\] from func\(\*dig_test.B\) \*dig_test.A provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
+		)
+		assert.NotContains(t, err.Error(), "CParams")
+	})
+
 	t.Run("DeferAcyclicVerification eventually catches cycle with self-cycle", func(t *testing.T) {
 		// A      <-- C <- D
 		// |      |__^    ^
@@ -2361,7 +2456,7 @@ func testProvideCycleFails(t *testing.T, dryRun bool) {
 		newC := func(*C) *C { return &C{} }
 		newD := func(*C) *D { return &D{} }
 
-		c := digtest.New(t, dig.DeferAcyclicVerification())
+		c := digtest.New(t, dig.DeferAcyclicVerification(), dig.Compatibility(dig.CompatExtended))
 		c.RequireProvide(newA)
 		c.RequireProvide(newC)
 		c.RequireProvide(newD)
@@ -2372,7 +2467,7 @@ func testProvideCycleFails(t *testing.T, dryRun bool) {
 		dig.AssertErrorMatches(t, err,
 			`cycle detected in dependency graph:`,
 			`func\(\*dig_test.C\) \*dig_test.C provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
-			`depends on func\(\*dig_test.C\) \*dig_test.C provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
+			`depends on \*dig_test.C from func\(\*dig_test.C\) \*dig_test.C provided by "go.uber.org/dig_test".testProvideCycleFails.\S+ \(\S+\)`,
 		)
 	})
 }
@@ -2468,6 +2563,118 @@ func TestInvokesUseCachedObjects(t *testing.T) {
 	}
 }
 
+func TestTransient(t *testing.T) {
+	t.Parallel()
+
+	type A struct{ ID int }
+	type B struct{ A1, A2 *A }
+
+	t.Run("called for every consumer", func(t *testing.T) {
+		c := digtest.New(t)
+
+		calls := 0
+		c.RequireProvide(func() *A {
+			calls++
+			return &A{ID: calls}
+		}, dig.Transient())
+
+		type params struct {
+			dig.In
+
+			A1 *A
+			A2 *A
+		}
+		c.RequireProvide(func(p params) *B {
+			return &B{A1: p.A1, A2: p.A2}
+		})
+
+		c.RequireInvoke(func(b *B, a *A) {
+			assert.Equal(t, 1, b.A1.ID)
+			assert.Equal(t, 2, b.A2.ID)
+			assert.Equal(t, 3, a.ID)
+		})
+		c.RequireInvoke(func(a *A) {
+			assert.Equal(t, 4, a.ID)
+		})
+		assert.Equal(t, 4, calls)
+	})
+
+	t.Run("dependencies are still shared", func(t *testing.T) {
+		c := digtest.New(t)
+
+		bufCalls := 0
+		c.RequireProvide(func() *bytes.Buffer {
+			bufCalls++
+			return new(bytes.Buffer)
+		})
+		c.RequireProvide(func(buf *bytes.Buffer) *A {
+			return &A{}
+		}, dig.Transient())
+
+		c.RequireInvoke(func(*A) {})
+		c.RequireInvoke(func(*A) {})
+		assert.Equal(t, 1, bufCalls)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() (*A, error) {
+			return nil, errors.New("great sadness")
+		}, dig.Transient())
+
+		err := c.Invoke(func(*A) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "great sadness")
+	})
+
+	t.Run("decorated", func(t *testing.T) {
+		c := digtest.New(t)
+
+		calls, decorations := 0, 0
+		c.RequireProvide(func() *A {
+			calls++
+			return &A{ID: calls}
+		}, dig.Transient())
+		c.RequireDecorate(func(a *A) *A {
+			decorations++
+			return &A{ID: a.ID * 10}
+		})
+
+		type params struct {
+			dig.In
+
+			A1 *A
+			A2 *A
+		}
+		c.RequireInvoke(func(p params) {
+			assert.Equal(t, 10, p.A1.ID)
+			assert.Equal(t, 20, p.A2.ID)
+		})
+		c.RequireInvoke(func(a *A) {
+			assert.Equal(t, 30, a.ID)
+		})
+		assert.Equal(t, 3, calls)
+		assert.Equal(t, 3, decorations)
+	})
+
+	t.Run("optional missing dependency", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func(*B) *A {
+			t.Fatal("this function must not be called")
+			return nil
+		}, dig.Transient())
+
+		type params struct {
+			dig.In
+
+			A *A `optional:"true"`
+		}
+		c.RequireInvoke(func(p params) {
+			assert.Nil(t, p.A)
+		})
+	})
+}
+
 func TestProvideFailures(t *testing.T) {
 	t.Run("not dry", func(t *testing.T) {
 		testProvideFailures(t, false /* dry run */)
@@ -3180,10 +3387,33 @@ func testInvokeFailures(t *testing.T, dryRun bool) {
 					`\*dig_test.A\[name="hello"\] \(did you mean (to use )?dig_test.A\[name="hello"\]\?\)`,
 				},
 			},
+			{
+				name:    "unnamed value missing, named value present",
+				provide: func() outA { return outA{A: A{}} },
+				invoke:  func(A) {},
+				errContains: []string{
+					`missing type:`,
+					`dig_test.A \(did you mean (to use )?dig_test.A\[name="hello"\]\?\)`,
+				},
+			},
+			{
+				name:    "named value missing, value with other name present",
+				provide: func() outA { return outA{A: A{}} },
+				invoke: func(struct {
+					dig.In
+
+					A `name:"goodbye"`
+				}) {
+				},
+				errContains: []string{
+					`missing type:`,
+					`dig_test.A\[name="goodbye"\] \(did you mean (to use )?dig_test.A\[name="hello"\]\?\)`,
+				},
+			},
 		}
 
 		for _, tc := range cases {
-			c := digtest.New(t, dig.DryRun(dryRun))
+			c := digtest.New(t, dig.DryRun(dryRun), dig.Compatibility(dig.CompatExtended))
 			t.Run(tc.name, func(t *testing.T) {
 				c.RequireProvide(tc.provide)
 
@@ -3741,6 +3971,96 @@ func TestProvideInfoOption(t *testing.T) {
 	})
 }
 
+func TestProviders(t *testing.T) {
+	t.Parallel()
+
+	type type1 struct{}
+	type type2 struct{}
+	type type3 struct{}
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, digtest.New(t).Providers())
+	})
+
+	t.Run("matches FillProvideInfo", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		var info1, info2, info3 dig.ProvideInfo
+		c.RequireProvide(func() *type1 { return &type1{} }, dig.FillProvideInfo(&info1))
+		c.RequireProvide(func(*type1) *type2 { return &type2{} }, dig.Name("n"), dig.FillProvideInfo(&info2))
+
+		child := c.Scope("child")
+		require.NoError(t, child.Provide(func(*type2) *type3 { return &type3{} }, dig.FillProvideInfo(&info3)))
+
+		assert.Equal(t, []dig.ProvideInfo{info1, info2, info3}, c.Providers())
+		assert.Equal(t, []dig.ProvideInfo{info3}, child.Providers())
+	})
+
+	t.Run("exported constructors are listed once", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		child := c.Scope("child")
+		require.NoError(t, child.Provide(func() *type1 { return &type1{} }, dig.Export(true)))
+
+		infos := c.Providers()
+		require.Len(t, infos, 1)
+		assert.Equal(t, "*dig_test.type1", infos[0].Outputs[0].String())
+	})
+}
+
+func TestInvokeInfoOption(t *testing.T) {
+	t.Parallel()
+
+	type type1 struct{}
+	type type2 struct{}
+
+	t.Run("inputs and providers", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		var p1, p2, p3 dig.ProvideInfo
+		c.RequireProvide(func() *type1 { return &type1{} }, dig.FillProvideInfo(&p1))
+		c.RequireProvide(func() int { return 1 }, dig.Group("ints"), dig.FillProvideInfo(&p2))
+		c.RequireProvide(func() int { return 2 }, dig.Group("ints"), dig.FillProvideInfo(&p3))
+
+		type params struct {
+			dig.In
+
+			Type2 *type2 `optional:"true"`
+			Ints  []int  `group:"ints"`
+		}
+		var info dig.InvokeInfo
+		c.RequireInvoke(func(*type1, params) {}, dig.FillInvokeInfo(&info))
+
+		require.Len(t, info.Inputs, 3)
+		assert.Equal(t, "*dig_test.type1", info.Inputs[0].String())
+		assert.Equal(t, "*dig_test.type2[optional]", info.Inputs[1].String())
+		assert.Equal(t, `[]int[group = "ints"]`, info.Inputs[2].String())
+		assert.Equal(t, [][]dig.ID{{p1.ID}, nil, {p2.ID, p3.ID}}, info.Providers)
+	})
+
+	t.Run("filled on failure", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		var info dig.InvokeInfo
+		err := c.Invoke(func(*type1) {}, dig.FillInvokeInfo(&info))
+		require.Error(t, err)
+		require.Len(t, info.Inputs, 1)
+		assert.Equal(t, [][]dig.ID{nil}, info.Providers)
+	})
+
+	t.Run("String", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "FillInvokeInfo(0x0)", fmt.Sprint(dig.FillInvokeInfo(nil)))
+	})
+}
+
 func TestEndToEndSuccessWithAliases(t *testing.T) {
 	t.Run("pointer constructor", func(t *testing.T) {
 		type Buffer = *bytes.Buffer
@@ -3840,3 +4160,156 @@ func TestEndToEndSuccessWithAliases(t *testing.T) {
 		})
 	})
 }
+
+func TestMaxResolutionDepth(t *testing.T) {
+	t.Parallel()
+
+	type (
+		A struct{}
+		B struct{}
+		C struct{}
+		D struct{}
+	)
+
+	provideChain := func(c *digtest.Container) {
+		c.RequireProvide(func() *A { return &A{} })
+		c.RequireProvide(func(*A) *B { return &B{} })
+		c.RequireProvide(func(*B) *C { return &C{} })
+		c.RequireProvide(func(*C) *D { return &D{} })
+	}
+
+	t.Run("exceeded", func(t *testing.T) {
+		c := digtest.New(t, dig.MaxResolutionDepth(3))
+		provideChain(c)
+
+		err := c.Invoke(func(*D) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(),
+			"exceeded the maximum resolution depth of 3 while building *dig_test.B for *dig_test.C for *dig_test.D for Invoke at")
+	})
+
+	t.Run("within limit", func(t *testing.T) {
+		c := digtest.New(t, dig.MaxResolutionDepth(5))
+		provideChain(c)
+
+		c.RequireInvoke(func(*D) {})
+	})
+
+	t.Run("unlimited", func(t *testing.T) {
+		c := digtest.New(t, dig.MaxResolutionDepth(0))
+		provideChain(c)
+
+		c.RequireInvoke(func(*D) {})
+	})
+
+	t.Run("String", func(t *testing.T) {
+		assert.Equal(t, "MaxResolutionDepth(3)", fmt.Sprint(dig.MaxResolutionDepth(3)))
+	})
+}
+
+func TestOptionalDefault(t *testing.T) {
+	t.Parallel()
+
+	type params struct {
+		dig.In
+
+		Port    int           `name:"port" optional:"true" default:"8080"`
+		Timeout time.Duration `name:"timeout" optional:"true" default:"30s"`
+		Host    string        `name:"host" optional:"true" default:"localhost"`
+		Debug   bool          `name:"debug" optional:"true" default:"true"`
+		Ratio   float64       `name:"ratio" optional:"true" default:"0.5"`
+		Mask    uint8         `name:"mask" optional:"true" default:"0xff"`
+		None    string        `name:"none" optional:"true"`
+	}
+
+	t.Run("absent", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireInvoke(func(p params) {
+			assert.Equal(t, 8080, p.Port)
+			assert.Equal(t, 30*time.Second, p.Timeout)
+			assert.Equal(t, "localhost", p.Host)
+			assert.True(t, p.Debug)
+			assert.Equal(t, 0.5, p.Ratio)
+			assert.Equal(t, uint8(0xff), p.Mask)
+			assert.Empty(t, p.None)
+		})
+	})
+
+	t.Run("provided", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() int { return 9090 }, dig.Name("port"))
+		c.RequireInvoke(func(p params) {
+			assert.Equal(t, 9090, p.Port)
+			assert.Equal(t, "localhost", p.Host)
+		})
+	})
+
+	t.Run("ignored when not optional", func(t *testing.T) {
+		type params struct {
+			dig.In
+
+			Port int     `default:"8080"`
+			Buf  *string `default:"x"`
+		}
+
+		c := digtest.New(t)
+		c.RequireProvide(func() int { return 9090 })
+		c.RequireProvide(func() *string { return new(string) })
+		c.RequireInvoke(func(p params) {
+			assert.Equal(t, 9090, p.Port)
+		})
+
+		err := digtest.New(t).Invoke(func(params) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing types: int; *string")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		tests := []struct {
+			desc string
+			fn   interface{}
+			want string
+		}{
+			{
+				desc: "unparsable",
+				fn: func(struct {
+					dig.In
+
+					Port int `optional:"true" default:"eighty"`
+				}) {
+				},
+				want: `invalid value "eighty" for "default" tag on field Port`,
+			},
+			{
+				desc: "overflow",
+				fn: func(struct {
+					dig.In
+
+					Mask uint8 `optional:"true" default:"256"`
+				}) {
+				},
+				want: `invalid value "256" for "default" tag on field Mask`,
+			},
+			{
+				desc: "unsupported type",
+				fn: func(struct {
+					dig.In
+
+					Buf *bytes.Buffer `optional:"true" default:"x"`
+				}) {
+				},
+				want: "default values are not supported for *bytes.Buffer",
+			},
+		}
+
+		for _, tt := range tests {
+			tt := tt
+			t.Run(tt.desc, func(t *testing.T) {
+				c := digtest.New(t)
+				err := c.Invoke(tt.fn)
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.want)
+			})
+		}
+	})
+}
@@ -0,0 +1,253 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package digbench synthesizes dependency graphs of configurable shape and
+// measures the cost of providing, validating, and invoking them with dig.
+//
+// Use it to predict the overhead of a container at the scale of an
+// application, or to track the performance of dig itself with realistic
+// workloads.
+//
+//	func BenchmarkContainer(b *testing.B) {
+//	  for _, s := range []digbench.Shape{
+//	    {Layers: 5, Width: 20, FanIn: 3},
+//	    {Layers: 50, Width: 2, FanIn: 2},
+//	  } {
+//	    b.Run(s.String(), func(b *testing.B) {
+//	      digbench.Benchmark(b, s)
+//	    })
+//	  }
+//	}
+//
+// Outside of benchmarks, Measure reports the cost of a single run.
+package digbench
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"go.uber.org/dig"
+)
+
+// Shape describes a synthesized dependency graph. Constructors are
+// arranged in layers: constructors in the first layer have no
+// dependencies, and each constructor in the other layers depends on
+// constructors of the layer before it.
+type Shape struct {
+	// Layers is the number of layers of constructors.
+	Layers int
+
+	// Width is the number of constructors in each layer.
+	Width int
+
+	// FanIn is the number of constructors of the previous layer that each
+	// constructor depends on. It's capped at Width.
+	FanIn int
+}
+
+// String returns a description of the shape suitable for naming a
+// sub-benchmark.
+func (s Shape) String() string {
+	return fmt.Sprintf("layers=%d/width=%d/fanin=%d", s.Layers, s.Width, s.FanIn)
+}
+
+func (s Shape) validate() error {
+	if s.Layers <= 0 || s.Width <= 0 {
+		return fmt.Errorf("digbench: invalid shape %v: layers and width must be positive", s)
+	}
+	if s.FanIn < 0 {
+		return fmt.Errorf("digbench: invalid shape %v: fan-in cannot be negative", s)
+	}
+	return nil
+}
+
+// Graph is a synthesized dependency graph. Each constructor produces a
+// value of its own type.
+type Graph struct {
+	shape  Shape
+	ctors  []interface{}
+	invoke interface{}
+}
+
+// New synthesizes a Graph of the given shape.
+func New(s Shape) (*Graph, error) {
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+	fanIn := s.FanIn
+	if fanIn > s.Width {
+		fanIn = s.Width
+	}
+
+	g := &Graph{shape: s}
+	var prev []reflect.Type
+	for l := 0; l < s.Layers; l++ {
+		layer := make([]reflect.Type, s.Width)
+		for i := range layer {
+			t := nodeType(l*s.Width + i)
+			layer[i] = t
+
+			var deps []reflect.Type
+			if l > 0 {
+				for j := 0; j < fanIn; j++ {
+					deps = append(deps, prev[(i+j)%s.Width])
+				}
+			}
+			g.ctors = append(g.ctors, newConstructor(deps, t))
+		}
+		prev = layer
+	}
+
+	g.invoke = reflect.MakeFunc(
+		reflect.FuncOf(prev, nil, false),
+		func([]reflect.Value) []reflect.Value { return nil },
+	).Interface()
+	return g, nil
+}
+
+// nodeType returns a distinct type for the n-th constructor of a Graph.
+func nodeType(n int) reflect.Type {
+	return reflect.ArrayOf(n, reflect.TypeOf(struct{}{}))
+}
+
+func newConstructor(deps []reflect.Type, t reflect.Type) interface{} {
+	out := []reflect.Value{reflect.Zero(t)}
+	return reflect.MakeFunc(
+		reflect.FuncOf(deps, []reflect.Type{t}, false),
+		func([]reflect.Value) []reflect.Value { return out },
+	).Interface()
+}
+
+// Shape returns the shape the Graph was synthesized from.
+func (g *Graph) Shape() Shape {
+	return g.shape
+}
+
+// Len returns the number of constructors in the Graph.
+func (g *Graph) Len() int {
+	return len(g.ctors)
+}
+
+// Provide provides all constructors of the Graph to the given Container.
+func (g *Graph) Provide(c *dig.Container) error {
+	for _, ctor := range g.ctors {
+		if err := c.Provide(ctor); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Invoke requests the values produced by the last layer of the Graph from
+// the given Container, building the whole Graph.
+func (g *Graph) Invoke(c *dig.Container) error {
+	return c.Invoke(g.invoke)
+}
+
+// Result reports the cost of a single run of a Graph.
+type Result struct {
+	// Provide is the time taken to provide all constructors.
+	Provide time.Duration
+
+	// Validate is the time taken to check that the Graph can be built,
+	// with dig.DryRun, without calling any constructors.
+	Validate time.Duration
+
+	// Invoke is the time taken to build the Graph.
+	Invoke time.Duration
+}
+
+// Measure synthesizes a Graph of the given shape and reports how long it
+// takes to provide, validate, and invoke it once. Options are passed to
+// every Container it creates.
+func Measure(s Shape, opts ...dig.Option) (Result, error) {
+	g, err := New(s)
+	if err != nil {
+		return Result{}, err
+	}
+
+	var r Result
+	start := time.Now()
+	c := dig.New(opts...)
+	if err := g.Provide(c); err != nil {
+		return r, err
+	}
+	r.Provide = time.Since(start)
+
+	dry := dig.New(append(opts[:len(opts):len(opts)], dig.DryRun(true))...)
+	if err := g.Provide(dry); err != nil {
+		return r, err
+	}
+	start = time.Now()
+	if err := g.Invoke(dry); err != nil {
+		return r, err
+	}
+	r.Validate = time.Since(start)
+
+	start = time.Now()
+	if err := g.Invoke(c); err != nil {
+		return r, err
+	}
+	r.Invoke = time.Since(start)
+	return r, nil
+}
+
+// Benchmark runs the Provide, Validate, and Invoke sub-benchmarks for a
+// Graph of the given shape. Each iteration uses a new Container created
+// with the given options; only the measured phase is timed.
+func Benchmark(b *testing.B, s Shape, opts ...dig.Option) {
+	g, err := New(s)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.Run("Provide", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if err := g.Provide(dig.New(opts...)); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("Validate", func(b *testing.B) {
+		benchmarkInvoke(b, g, append(opts[:len(opts):len(opts)], dig.DryRun(true)))
+	})
+
+	b.Run("Invoke", func(b *testing.B) {
+		benchmarkInvoke(b, g, opts)
+	})
+}
+
+func benchmarkInvoke(b *testing.B, g *Graph, opts []dig.Option) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		c := dig.New(opts...)
+		if err := g.Provide(c); err != nil {
+			b.Fatal(err)
+		}
+		b.StartTimer()
+
+		if err := g.Invoke(c); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
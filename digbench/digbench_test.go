@@ -0,0 +1,86 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package digbench_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/digbench"
+)
+
+func TestGraph(t *testing.T) {
+	t.Parallel()
+
+	t.Run("builds every constructor", func(t *testing.T) {
+		t.Parallel()
+
+		g, err := digbench.New(digbench.Shape{Layers: 4, Width: 3, FanIn: 5})
+		require.NoError(t, err)
+		assert.Equal(t, 12, g.Len())
+
+		c := dig.New()
+		require.NoError(t, g.Provide(c))
+		require.NoError(t, g.Invoke(c))
+	})
+
+	t.Run("invalid shape", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := digbench.New(digbench.Shape{Layers: 0, Width: 3})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "layers and width must be positive")
+
+		_, err = digbench.New(digbench.Shape{Layers: 1, Width: 1, FanIn: -1})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "fan-in cannot be negative")
+	})
+
+	t.Run("String", func(t *testing.T) {
+		t.Parallel()
+
+		s := digbench.Shape{Layers: 2, Width: 3, FanIn: 1}
+		assert.Equal(t, "layers=2/width=3/fanin=1", s.String())
+	})
+}
+
+func TestMeasure(t *testing.T) {
+	t.Parallel()
+
+	r, err := digbench.Measure(digbench.Shape{Layers: 3, Width: 4, FanIn: 2})
+	require.NoError(t, err)
+	assert.Positive(t, r.Provide)
+	assert.Positive(t, r.Validate)
+	assert.Positive(t, r.Invoke)
+}
+
+func BenchmarkGraph(b *testing.B) {
+	for _, s := range []digbench.Shape{
+		{Layers: 5, Width: 20, FanIn: 3},
+		{Layers: 50, Width: 2, FanIn: 2},
+	} {
+		b.Run(s.String(), func(b *testing.B) {
+			digbench.Benchmark(b, s)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package dighttp provides a net.Listener and an *http.Server to a dig
+// container, tied to the container's lifecycle.
+//
+//	c := dig.New()
+//	c.Provide(newRouter) // provides an http.Handler
+//	c.Use(dighttp.Module(dighttp.Config{Addr: ":8080"}))
+//
+//	// Start serving.
+//	if err := c.Build(); err != nil {
+//	  return err
+//	}
+//
+//	// Stop serving gracefully, then tear down everything else.
+//	report, err := c.Shutdown(ctx)
+//
+// The server is provided with dig.Eager, so Container.Build starts it. It
+// serves the http.Handler provided to the container, if any, and
+// http.DefaultServeMux otherwise. Container.Cleanup and Container.Shutdown
+// shut the server down gracefully, waiting for active requests to finish,
+// and close the listener.
+//
+// The package also serves as an example of constructors integrated with
+// the lifecycle of a container: they start work when they're called and
+// return a cleanup function that stops it.
+package dighttp
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net"
+	"net/http"
+	"time"
+
+	"go.uber.org/dig"
+)
+
+// DefaultShutdownTimeout is the time that the server is given to shut
+// down gracefully unless configured otherwise.
+const DefaultShutdownTimeout = 10 * time.Second
+
+// Config configures the listener and server provided by Module.
+type Config struct {
+	// Network address to listen on, such as ":8080". Use ":0" to listen on
+	// any available port.
+	Addr string
+
+	// Network to listen on. Defaults to "tcp".
+	Network string
+
+	// Time allowed for active requests to finish once the server is shut
+	// down. Requests still active afterwards are interrupted. Defaults to
+	// DefaultShutdownTimeout.
+	ShutdownTimeout time.Duration
+}
+
+// Module returns a Module named "http" that provides a net.Listener
+// listening on the configured address and an *http.Server serving on it.
+func Module(cfg Config) *dig.Module {
+	return dig.NewModule("http").
+		Provide(NewListener(cfg), dig.SkipClose(), dig.ReturnsCleanup()).
+		Provide(NewServer(cfg), dig.SkipClose(), dig.ReturnsCleanup(), dig.Eager())
+}
+
+// NewListener returns a constructor of a net.Listener listening on the
+// configured address. The listener is closed by the cleanup function that
+// the constructor returns, so provide it with dig.ReturnsCleanup.
+func NewListener(cfg Config) func() (net.Listener, func(), error) {
+	network := cfg.Network
+	if network == "" {
+		network = "tcp"
+	}
+	return func() (net.Listener, func(), error) {
+		ln, err := net.Listen(network, cfg.Addr)
+		if err != nil {
+			return nil, nil, err
+		}
+		// A server closes its listener when it shuts down.
+		return ln, func() { _ = ln.Close() }, nil
+	}
+}
+
+// ServerParams are the dependencies of the server built by NewServer.
+type ServerParams struct {
+	dig.In
+
+	Listener net.Listener
+
+	// Handler to serve. Defaults to http.DefaultServeMux.
+	Handler http.Handler `optional:"true"`
+
+	// Logger for errors of the server. Defaults to the standard logger.
+	ErrorLog *log.Logger `optional:"true"`
+}
+
+// NewServer returns a constructor of an *http.Server that starts serving
+// on the listener of the container once it's called. The server is shut
+// down gracefully by the cleanup function that the constructor returns, so
+// provide it with dig.ReturnsCleanup.
+func NewServer(cfg Config) func(ServerParams) (*http.Server, func()) {
+	timeout := cfg.ShutdownTimeout
+	if timeout <= 0 {
+		timeout = DefaultShutdownTimeout
+	}
+	return func(p ServerParams) (*http.Server, func()) {
+		srv := &http.Server{
+			Handler:  p.Handler,
+			ErrorLog: p.ErrorLog,
+		}
+		logf := log.Printf
+		if p.ErrorLog != nil {
+			logf = p.ErrorLog.Printf
+		}
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			if err := srv.Serve(p.Listener); !errors.Is(err, http.ErrServerClosed) {
+				logf("dighttp: serving on %v failed: %v", p.Listener.Addr(), err)
+			}
+		}()
+
+		return srv, func() {
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+			if err := srv.Shutdown(ctx); err != nil {
+				logf("dighttp: graceful shutdown of %v failed: %v", p.Listener.Addr(), err)
+				_ = srv.Close()
+			}
+			<-done
+		}
+	}
+}
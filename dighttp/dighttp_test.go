@@ -0,0 +1,100 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dighttp_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/dighttp"
+)
+
+func TestModule(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	c := dig.New()
+	require.NoError(t, c.Provide(func() http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/slow" {
+				<-release
+			}
+			_, _ = io.WriteString(w, "hello")
+		})
+	}))
+	require.NoError(t, c.Use(dighttp.Module(dighttp.Config{Addr: "127.0.0.1:0"})))
+	require.NoError(t, c.Build(), "Build must start the server")
+
+	var url string
+	require.NoError(t, c.Invoke(func(ln net.Listener) {
+		url = "http://" + ln.Addr().String()
+	}))
+
+	res, err := http.Get(url + "/")
+	require.NoError(t, err)
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	require.NoError(t, res.Body.Close())
+	assert.Equal(t, "hello", string(body))
+
+	// A request in flight is allowed to finish.
+	slow := make(chan string, 1)
+	go func() {
+		res, err := http.Get(url + "/slow")
+		if !assert.NoError(t, err) {
+			slow <- ""
+			return
+		}
+		defer res.Body.Close()
+		body, _ := io.ReadAll(res.Body)
+		slow <- string(body)
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	shutdown := make(chan error, 1)
+	go func() {
+		_, err := c.Shutdown(context.Background())
+		shutdown <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+
+	assert.Equal(t, "hello", <-slow)
+	require.NoError(t, <-shutdown)
+
+	_, err = http.Get(url + "/")
+	assert.Error(t, err, "server must be stopped")
+}
+
+func TestListenFailure(t *testing.T) {
+	t.Parallel()
+
+	c := dig.New()
+	require.NoError(t, c.Use(dighttp.Module(dighttp.Config{Addr: "256.0.0.1:http"})))
+	assert.Error(t, c.Build())
+}
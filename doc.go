@@ -122,6 +122,16 @@
 // Any error returned by the invoked function is propagated back to the
 // caller.
 //
+// Constructors and decorators are called one at a time on the goroutine
+// that called Invoke, even in containers built with Concurrent. Dig
+// doesn't start goroutines to build values, so constructors that must run
+// on a specific goroutine, such as those of UI toolkits or of cgo
+// libraries that require runtime.LockOSThread, only need to be invoked
+// from it. Values are built in the background only by Futures returned by
+// constructors; see Go. Teardown is different: Container.Shutdown runs
+// cleanup functions and Close methods that may time out on goroutines of
+// their own.
+//
 // # Parameter Objects
 //
 // Constructors declare their dependencies as function parameters. This can
@@ -218,6 +228,17 @@
 // The optional tag also allows adding new dependencies without breaking
 // existing consumers of the constructor.
 //
+// Optional fields of string, boolean, numeric, and time.Duration types may
+// specify the value to use instead of the zero value with a default tag.
+// The tag is ignored on fields that aren't optional.
+//
+//	type ServerParams struct {
+//	  dig.In
+//
+//	  Port    int           `name:"port" optional:"true" default:"8080"`
+//	  Timeout time.Duration `name:"timeout" optional:"true" default:"30s"`
+//	}
+//
 // # Named Values
 //
 // Some use cases call for multiple values of the same type. Dig allows adding
@@ -345,4 +366,39 @@
 //	  Handler []int `group:"server"`         // [][]int from dig.In
 //	  Handler []int `group:"server,flatten"` // []int from dig.In
 //	}
+//
+// A value group may be requested with the `soft` modifier so that only
+// values from constructors that were already called for other reasons are
+// included. Requesting a soft value group doesn't call any constructors,
+// so providing a value to a group doesn't force it to be built if nothing
+// else needs it.
+//
+//	type ServerParams struct {
+//	  dig.In
+//
+//	  Handlers []Handler `group:"server,soft"`
+//	}
+//
+// Values added to a group may also be labeled with dig.GroupLabels or a
+// labels tag, and consumers may request only the values with certain
+// labels with a labels tag of their own. See GroupLabels for details.
+//
+//	type AdminParams struct {
+//	  dig.In
+//
+//	  Handlers []Handler `group:"server" labels:"admin"`
+//	}
+//
+// Values added to a group may be given a key with dig.GroupKey or a key tag.
+// A single value can then be requested from the group by its key, without
+// building the rest of the group, with a field that isn't a slice, and all
+// values that have a key can be requested as a map keyed by them. See
+// GroupKey and SelectMember for details.
+//
+//	type ServerParams struct {
+//	  dig.In
+//
+//	  Admin    Handler            `group:"server" key:"admin"`
+//	  Handlers map[string]Handler `group:"server"`
+//	}
 package dig // import "go.uber.org/dig"
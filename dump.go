@@ -0,0 +1,246 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"go.uber.org/dig/internal/digreflect"
+	"go.uber.org/dig/internal/dot"
+)
+
+// DumpOnFailure is an Option that writes a diagnostic bundle to w when a
+// call to Invoke fails. The bundle is a JSON document that describes the
+// failure, the constructors that the Invoke needed, the constructors
+// that were called while resolving it along with how long they took, and
+// the full dependency graph of the container, so that a single artifact
+// can be attached to a bug report.
+//
+// Only the outermost Invoke writes a bundle; failures of Invokes made by
+// constructors are included in it. Errors writing the bundle are ignored.
+func DumpOnFailure(w io.Writer) Option {
+	return dumpOnFailureOption{w: w}
+}
+
+// DumpOnFailureToFile is an Option that writes a diagnostic bundle to a
+// new file in dir when a call to Invoke fails, and reports the path to the
+// file in the error returned by Invoke. If dir is empty, the default
+// directory for temporary files is used. See DumpOnFailure for details.
+func DumpOnFailureToFile(dir string) Option {
+	return dumpOnFailureOption{dir: dir, toFile: true}
+}
+
+type dumpOnFailureOption struct {
+	w      io.Writer
+	dir    string
+	toFile bool
+}
+
+func (o dumpOnFailureOption) String() string {
+	if o.toFile {
+		return fmt.Sprintf("DumpOnFailureToFile(%q)", o.dir)
+	}
+	return fmt.Sprintf("DumpOnFailure(%v)", o.w)
+}
+
+func (o dumpOnFailureOption) applyOption(c *Container) {
+	c.scope.dump = &failureDumper{dumpOnFailureOption: o}
+}
+
+// failureDumper writes diagnostic bundles for failed Invokes. Only the
+// root Scope holds one.
+type failureDumper struct {
+	dumpOnFailureOption
+
+	// Constructors called since the outermost Invoke started.
+	calls []dumpCall
+}
+
+// reset forgets the calls recorded for a previous Invoke.
+func (d *failureDumper) reset() {
+	d.calls = d.calls[:0]
+}
+
+// record records a call to a constructor.
+func (d *failureDumper) record(s *Scope, f *digreflect.Func, start time.Time, err error) {
+	c := dumpCall{
+		Func:     f.String(),
+		Scope:    s.path(),
+		Duration: time.Since(start).String(),
+	}
+	if err != nil {
+		c.Error = err.Error()
+	}
+	d.calls = append(d.calls, c)
+}
+
+// write writes a bundle describing the failure of an Invoke of fn in s,
+// made with the given trace ID, and returns err amended to refer to the
+// bundle if needed.
+func (d *failureDumper) write(s *Scope, fn *digreflect.Func, traceID string, pl paramList, err error) error {
+	bundle := dumpBundle{
+		Error:   fmt.Sprintf("%+v", err),
+		Summary: Summary(err),
+		TraceID: traceID,
+		Scope:   s.path(),
+		Invoke:  fn.String(),
+		Calls:   d.calls,
+	}
+	for _, n := range s.plan(pl) {
+		bundle.Plan = append(bundle.Plan, n.Location().String())
+	}
+	snap := s.rootScope().inspectSnapshot()
+	for _, p := range snap.Providers {
+		dp := dumpProvider{
+			ID:       p.ID,
+			Scope:    p.Scope,
+			Location: p.Location.String(),
+			Called:   p.Called,
+			Disabled: p.Disabled,
+		}
+		for _, in := range p.Inputs {
+			dp.Inputs = append(dp.Inputs, in.String())
+		}
+		for _, out := range p.Outputs {
+			dp.Outputs = append(dp.Outputs, out.String())
+		}
+		bundle.Providers = append(bundle.Providers, dp)
+	}
+	for _, e := range snap.Edges {
+		bundle.Edges = append(bundle.Edges, dumpEdge{From: e.From, To: e.To, Input: e.Input.String()})
+	}
+
+	if !d.toFile {
+		_ = writeBundle(d.w, bundle)
+		return err
+	}
+
+	f, ferr := os.CreateTemp(d.dir, "dig-failure-*.json")
+	if ferr != nil {
+		return err
+	}
+	werr := writeBundle(f, bundle)
+	if cerr := f.Close(); werr == nil {
+		werr = cerr
+	}
+	if werr != nil {
+		_ = os.Remove(f.Name())
+		return err
+	}
+	return errDumped{Path: f.Name(), Reason: err}
+}
+
+func writeBundle(w io.Writer, b dumpBundle) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(b)
+}
+
+// dumpBundle is the JSON document written by DumpOnFailure.
+type dumpBundle struct {
+	Error     string         `json:"error"`
+	Summary   string         `json:"summary"`
+	TraceID   string         `json:"traceID,omitempty"`
+	Scope     string         `json:"scope,omitempty"`
+	Invoke    string         `json:"invoke"`
+	Plan      []string       `json:"plan"`
+	Calls     []dumpCall     `json:"calls"`
+	Providers []dumpProvider `json:"providers"`
+	Edges     []dumpEdge     `json:"edges"`
+}
+
+type dumpCall struct {
+	Func     string `json:"func"`
+	Scope    string `json:"scope,omitempty"`
+	Duration string `json:"duration"`
+	Error    string `json:"error,omitempty"`
+}
+
+type dumpProvider struct {
+	ID       ID       `json:"id"`
+	Scope    string   `json:"scope,omitempty"`
+	Location string   `json:"location"`
+	Inputs   []string `json:"inputs,omitempty"`
+	Outputs  []string `json:"outputs"`
+	Called   bool     `json:"called"`
+	Disabled bool     `json:"disabled,omitempty"`
+}
+
+type dumpEdge struct {
+	From  ID     `json:"from"`
+	To    ID     `json:"to"`
+	Input string `json:"input"`
+}
+
+// plan returns the constructors that are needed to build the given
+// parameters in this Scope, with dependencies before their dependents.
+func (s *Scope) plan(pl paramList) []provider {
+	var (
+		order []provider
+		seen  = make(map[provider]struct{})
+		visit func(params []*dot.Param)
+	)
+	visit = func(params []*dot.Param) {
+		for _, p := range params {
+			var providers []provider
+			if p.Group != "" {
+				providers = s.getAllGroupProviders(p.Group, p.Type.Elem())
+			} else {
+				providers = s.getAllValueProviders(p.Name, p.Type)
+			}
+			for _, pr := range providers {
+				if _, ok := seen[pr]; ok {
+					continue
+				}
+				seen[pr] = struct{}{}
+				visit(pr.ParamList().DotParam())
+				order = append(order, pr)
+			}
+		}
+	}
+	visit(pl.DotParam())
+	return order
+}
+
+// errDumped is returned by Invoke when a diagnostic bundle was written to
+// a file for its failure.
+type errDumped struct {
+	Path   string
+	Reason error
+}
+
+var _ digError = errDumped{}
+
+func (e errDumped) Error() string { return fmt.Sprint(e) }
+
+func (e errDumped) Unwrap() error { return e.Reason }
+
+func (e errDumped) writeMessage(w io.Writer, _ string) {
+	fmt.Fprintf(w, "diagnostics written to %v", e.Path)
+}
+
+func (e errDumped) Format(w fmt.State, c rune) {
+	formatError(e, w, c)
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestDumpOnFailure(t *testing.T) {
+	t.Parallel()
+
+	type (
+		A struct{}
+		B struct{}
+		C struct{}
+	)
+
+	type bundle struct {
+		Error     string
+		Summary   string
+		TraceID   string
+		Invoke    string
+		Plan      []string
+		Calls     []struct{ Func, Duration, Error string }
+		Providers []struct {
+			Location string
+			Called   bool
+		}
+		Edges []struct{ Input string }
+	}
+
+	provide := func(c *digtest.Container) {
+		c.RequireProvide(func() *A { return &A{} })
+		c.RequireProvide(func(*A) (*B, error) { return nil, errors.New("great sadness") })
+		c.RequireProvide(func(*B) *C { return &C{} })
+	}
+
+	t.Run("writer", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		c := digtest.New(t, dig.DumpOnFailure(&buf))
+		provide(c)
+
+		err := c.Invoke(func(*C) {}, dig.TraceID("abc"))
+		require.Error(t, err)
+		assert.NotContains(t, err.Error(), "diagnostics written to")
+
+		var b bundle
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &b))
+		assert.Contains(t, b.Error, "great sadness")
+		assert.Contains(t, b.Summary, "failed: great sadness")
+		assert.Equal(t, "abc", b.TraceID)
+		assert.Contains(t, b.Invoke, "dump_test.go")
+		assert.Len(t, b.Plan, 3)
+		require.Len(t, b.Calls, 2)
+		assert.Empty(t, b.Calls[0].Error)
+		assert.NotEmpty(t, b.Calls[0].Duration)
+		assert.Equal(t, "great sadness", b.Calls[1].Error)
+		assert.Len(t, b.Providers, 3)
+		assert.Len(t, b.Edges, 2)
+	})
+
+	t.Run("file", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		c := digtest.New(t, dig.DumpOnFailureToFile(dir))
+		provide(c)
+
+		err := c.Invoke(func(*C) {})
+		require.Error(t, err)
+
+		m := regexp.MustCompile(`diagnostics written to (\S+):`).FindStringSubmatch(err.Error())
+		require.Len(t, m, 2, "path must be in error: %v", err)
+		assert.Equal(t, dir, filepath.Dir(m[1]))
+
+		data, rerr := os.ReadFile(m[1])
+		require.NoError(t, rerr)
+		var b bundle
+		require.NoError(t, json.Unmarshal(data, &b))
+		assert.Contains(t, b.Error, "great sadness")
+	})
+
+	t.Run("missing dependency", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		c := digtest.New(t, dig.DumpOnFailure(&buf))
+		err := c.Invoke(func(*A) {})
+		require.Error(t, err)
+
+		var b bundle
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &b))
+		assert.Contains(t, b.Summary, "missing type *dig_test.A")
+		assert.Empty(t, b.Plan)
+	})
+
+	t.Run("success writes nothing", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		c := digtest.New(t, dig.DumpOnFailure(&buf))
+		c.RequireProvide(func() *A { return &A{} })
+		c.RequireInvoke(func(*A) {})
+		assert.Zero(t, buf.Len())
+	})
+
+	t.Run("nested invoke writes once", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		c := digtest.New(t, dig.DumpOnFailure(&buf))
+		c.RequireProvide(func() (*A, error) {
+			return nil, c.Invoke(func(*B) {})
+		})
+
+		require.Error(t, c.Invoke(func(*A) {}))
+		dec := json.NewDecoder(&buf)
+		var b bundle
+		require.NoError(t, dec.Decode(&b))
+		assert.False(t, dec.More(), "only one bundle must be written")
+	})
+}
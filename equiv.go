@@ -0,0 +1,209 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"reflect"
+
+	"go.uber.org/dig/internal/digreflect"
+)
+
+// KeyEquivalence is an Option that lets the container treat values of
+// different types as the same value if the given function reports their
+// types as equivalent. This helps migrating codebases that are
+// inconsistent about, for example, providing and consuming values by
+// pointer.
+//
+//	c := dig.New(dig.KeyEquivalence(dig.SamePointee))
+//	c.Provide(func() *Config { ... })
+//	c.Invoke(func(cfg Config) { ... }) // satisfied by *Config
+//
+// The function is applied consistently: a value requested with no
+// constructor of its own is satisfied by the only value with the same
+// name whose type is equivalent, and providing a value equivalent to one
+// already provided to the same Scope fails as if it were the same value.
+// Value groups are not affected.
+//
+// Values are converted to the requested type when possible: pointers are
+// dereferenced, values are copied to a new pointer, and interfaces are
+// converted to each other. Values that can't be converted fail to resolve.
+// Like other values, a converted value is built at most once: consumers of
+// *Config provided as Config share the same pointer.
+func KeyEquivalence(equal func(a, b reflect.Type) bool) Option {
+	return keyEquivalenceOption{equal: equal}
+}
+
+type keyEquivalenceOption struct {
+	equal func(a, b reflect.Type) bool
+}
+
+func (o keyEquivalenceOption) String() string {
+	if o.equal == nil {
+		return "KeyEquivalence(nil)"
+	}
+	return fmt.Sprintf("KeyEquivalence(%v)", digreflect.InspectFunc(o.equal).Name)
+}
+
+func (o keyEquivalenceOption) applyOption(c *Container) {
+	c.scope.keyEqual = o.equal
+}
+
+// SamePointee reports whether a and b are the same type, or one of them is
+// a pointer to the other. Use it with KeyEquivalence.
+func SamePointee(a, b reflect.Type) bool {
+	switch {
+	case a == b:
+		return true
+	case a.Kind() == reflect.Ptr && a.Elem() == b:
+		return true
+	case b.Kind() == reflect.Ptr && b.Elem() == a:
+		return true
+	default:
+		return false
+	}
+}
+
+// SameMethodSet reports whether a and b are the same type, or interfaces
+// with the same method set. Use it with KeyEquivalence.
+func SameMethodSet(a, b reflect.Type) bool {
+	if a == b {
+		return true
+	}
+	if a.Kind() != reflect.Interface || b.Kind() != reflect.Interface {
+		return false
+	}
+	return a.Implements(b) && b.Implements(a)
+}
+
+// equivalentKey returns the key of the only value with a constructor
+// whose type is equivalent to the given type under KeyEquivalence.
+func (s *Scope) equivalentKey(name string, t reflect.Type) (key, bool) {
+	equal := s.rootScope().keyEqual
+	if equal == nil {
+		return key{}, false
+	}
+
+	var candidates []key
+	seen := make(map[key]struct{})
+	for _, scope := range s.ancestors() {
+		for k := range scope.providers {
+			if k.group != "" || k.name != name || k.t == t || !equal(k.t, t) {
+				continue
+			}
+			if _, ok := seen[k]; ok || len(scope.getProviders(k)) == 0 {
+				continue
+			}
+			seen[k] = struct{}{}
+			candidates = append(candidates, k)
+		}
+	}
+	if len(candidates) != 1 {
+		return key{}, false
+	}
+	return candidates[0], true
+}
+
+// equivalentProviders returns the constructors in this Scope of values
+// equivalent to, but not the same as, the given key under KeyEquivalence.
+func (s *Scope) equivalentProviders(k key) (key, []*constructorNode) {
+	equal := s.rootScope().keyEqual
+	if equal == nil || k.group != "" {
+		return key{}, nil
+	}
+	for pk, ps := range s.providers {
+		if pk.group == "" && pk.name == k.name && pk.t != k.t && len(ps) > 0 && equal(pk.t, k.t) {
+			return pk, ps
+		}
+	}
+	return key{}, nil
+}
+
+// buildEquivalent builds the value for the given equivalent key and
+// converts it to the type of this parameter.
+func (ps paramSingle) buildEquivalent(c containerStore, k key) (reflect.Value, error) {
+	// Equivalent dependencies are not part of the graph, so cycles
+	// through them must be caught while resolving.
+	for _, f := range c.resolutionPath() {
+		if f.Key == k {
+			return _noValue, errResolveCycle(fmt.Sprintf(
+				"%v is equivalent to %v, which is already being built", ps.Type, k))
+		}
+	}
+
+	// Converted values are memoized alongside the values they were
+	// converted from, so that all consumers share the same value.
+	stores := c.storesToRoot()
+	for _, s := range stores {
+		if v, ok := s.getValue(ps.Name, ps.Type); ok {
+			return v, nil
+		}
+	}
+
+	v, err := paramSingle{Name: k.name, Type: k.t}.Build(c)
+	if err != nil {
+		return _noValue, err
+	}
+	out, err := convertEquivalent(v, ps.Type)
+	if err != nil {
+		return _noValue, err
+	}
+	for _, s := range stores {
+		if _, ok := s.getValue(k.name, k.t); ok {
+			s.setValue(ps.Name, ps.Type, out)
+			break
+		}
+	}
+	return out, nil
+}
+
+// convertEquivalent converts v to the given type.
+func convertEquivalent(v reflect.Value, to reflect.Type) (reflect.Value, error) {
+	from := v.Type()
+	out := reflect.New(to).Elem()
+	switch {
+	case from.AssignableTo(to):
+		out.Set(v)
+	case from.Kind() == reflect.Ptr && from.Elem() == to:
+		if v.IsNil() {
+			return _noValue, newErrInvalidInput(
+				fmt.Sprintf("cannot use nil %v as %v", from, to), nil)
+		}
+		out.Set(v.Elem())
+	case to.Kind() == reflect.Ptr && to.Elem() == from:
+		p := reflect.New(from)
+		p.Elem().Set(v)
+		out.Set(p)
+	case from.Kind() == reflect.Interface && to.Kind() == reflect.Interface:
+		if v.IsNil() {
+			break
+		}
+		if !v.Elem().Type().Implements(to) {
+			return _noValue, newErrInvalidInput(
+				fmt.Sprintf("cannot use %v as %v", v.Elem().Type(), to), nil)
+		}
+		out.Set(v.Elem())
+	default:
+		return _noValue, newErrInvalidInput(
+			fmt.Sprintf("cannot convert %v to %v", from, to), nil)
+	}
+	return out, nil
+}
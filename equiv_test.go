@@ -0,0 +1,136 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+type byteReader interface {
+	Read(p []byte) (int, error)
+}
+
+func TestKeyEquivalence(t *testing.T) {
+	t.Parallel()
+
+	type Config struct{ Port int }
+
+	t.Run("pointer provided, value requested", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.KeyEquivalence(dig.SamePointee))
+		c.RequireProvide(func() *Config { return &Config{Port: 80} })
+		c.RequireInvoke(func(cfg Config, p *Config) {
+			assert.Equal(t, 80, cfg.Port)
+			assert.Equal(t, 80, p.Port)
+		})
+	})
+
+	t.Run("value provided, pointer requested", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.KeyEquivalence(dig.SamePointee))
+		c.RequireProvide(func() Config { return Config{Port: 80} }, dig.Name("cfg"))
+
+		type params struct {
+			dig.In
+
+			Config *Config `name:"cfg"`
+		}
+		var first *Config
+		c.RequireInvoke(func(p params) {
+			assert.Equal(t, 80, p.Config.Port)
+			first = p.Config
+		})
+		c.RequireInvoke(func(p params) {
+			assert.Same(t, first, p.Config, "converted pointer must be memoized")
+		})
+	})
+
+	t.Run("interfaces with the same method set", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.KeyEquivalence(dig.SameMethodSet))
+		c.RequireProvide(func() io.Reader { return strings.NewReader("hello") })
+		c.RequireInvoke(func(r byteReader) {
+			b, err := io.ReadAll(r)
+			require.NoError(t, err)
+			assert.Equal(t, "hello", string(b))
+		})
+	})
+
+	t.Run("equivalent values conflict", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.KeyEquivalence(dig.SamePointee))
+		c.RequireProvide(func() *Config { return &Config{} })
+		err := c.Provide(func() Config { return Config{} })
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "equivalent *dig_test.Config already provided by")
+	})
+
+	t.Run("nil pointer", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.KeyEquivalence(dig.SamePointee))
+		c.RequireProvide(func() *Config { return nil })
+		err := c.Invoke(func(Config) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot use nil *dig_test.Config as dig_test.Config")
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() *Config { return &Config{} })
+		c.RequireProvide(func() Config { return Config{} })
+	})
+
+	t.Run("predicates", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := reflect.TypeOf(Config{})
+		assert.True(t, dig.SamePointee(cfg, reflect.PtrTo(cfg)))
+		assert.True(t, dig.SamePointee(reflect.PtrTo(cfg), cfg))
+		assert.False(t, dig.SamePointee(cfg, reflect.PtrTo(reflect.PtrTo(cfg))))
+
+		reader := reflect.TypeOf((*io.Reader)(nil)).Elem()
+		writer := reflect.TypeOf((*io.Writer)(nil)).Elem()
+		assert.True(t, dig.SameMethodSet(reader, reflect.TypeOf((*byteReader)(nil)).Elem()))
+		assert.False(t, dig.SameMethodSet(reader, writer))
+	})
+
+	t.Run("String", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "KeyEquivalence(SamePointee)", fmt.Sprint(dig.KeyEquivalence(dig.SamePointee)))
+	})
+}
@@ -0,0 +1,235 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"go.uber.org/dig/internal/digreflect"
+)
+
+// EncodeError encodes an error returned by dig as JSON for log
+// aggregation and other tooling. Each error in the chain is encoded as an
+// object with its kind, its own message, the structured details that dig
+// knows about, such as missing keys, cycle paths, and constructor
+// locations, and the error that caused it.
+//
+//	{
+//	  "kind": "missing_dependencies",
+//	  "message": "missing dependencies for function \"main\".run (main.go:12)",
+//	  "function": {"name": "run", "package": "main", "file": "main.go", "line": 12},
+//	  "cause": {
+//	    "kind": "missing_types",
+//	    "message": "missing type: *main.Config",
+//	    "missing": [{"key": {"type": "*main.Config"}}]
+//	  }
+//	}
+//
+// Errors aggregating several failures list them under "errors". Errors
+// that don't come from dig are encoded with their message only.
+func EncodeError(err error) ([]byte, error) {
+	if err == nil {
+		return nil, errors.New("cannot encode a nil error")
+	}
+	return json.Marshal(newJSONError(err))
+}
+
+type jsonError struct {
+	Kind     string        `json:"kind,omitempty"`
+	Message  string        `json:"message"`
+	Function *jsonLocation `json:"function,omitempty"`
+	Module   string        `json:"module,omitempty"`
+	Scope    string        `json:"scope,omitempty"`
+	TraceID  string        `json:"trace_id,omitempty"`
+	Key      *jsonKey      `json:"key,omitempty"`
+	Panic    string        `json:"panic,omitempty"`
+
+	Missing   []jsonMissing    `json:"missing,omitempty"`
+	Cycle     []jsonCycleEntry `json:"cycle,omitempty"`
+	Conflicts []jsonLocation   `json:"conflicts,omitempty"`
+
+	Errors []*jsonError `json:"errors,omitempty"`
+	Cause  *jsonError   `json:"cause,omitempty"`
+}
+
+type jsonLocation struct {
+	Name    string `json:"name"`
+	Package string `json:"package"`
+	File    string `json:"file"`
+	Line    int    `json:"line"`
+}
+
+type jsonKey struct {
+	Type  string `json:"type"`
+	Name  string `json:"name,omitempty"`
+	Group string `json:"group,omitempty"`
+}
+
+type jsonMissing struct {
+	Key         jsonKey   `json:"key"`
+	Suggestions []jsonKey `json:"suggestions,omitempty"`
+}
+
+type jsonCycleEntry struct {
+	Key      jsonKey       `json:"key"`
+	Via      *jsonKey      `json:"via,omitempty"`
+	Function *jsonLocation `json:"function,omitempty"`
+}
+
+func newJSONLocation(f *digreflect.Func) *jsonLocation {
+	if f == nil {
+		return nil
+	}
+	return &jsonLocation{Name: f.Name, Package: f.Package, File: f.File, Line: f.Line}
+}
+
+func newJSONKey(k key) jsonKey {
+	jk := jsonKey{Name: k.name, Group: k.group}
+	if k.t != nil {
+		jk.Type = k.t.String()
+	}
+	return jk
+}
+
+func newJSONError(err error) *jsonError {
+	if errs, ok := err.(errMultiple); ok {
+		je := &jsonError{Kind: errorKind(err), Message: fmt.Sprintf("%d errors occurred", len(errs))}
+		for _, err := range errs {
+			je.Errors = append(je.Errors, newJSONError(err))
+		}
+		return je
+	}
+
+	je := &jsonError{Message: err.Error()}
+	if de, ok := err.(Error); ok {
+		var b bytes.Buffer
+		de.writeMessage(&b, "%v")
+		je.Kind = errorKind(err)
+		je.Message = b.String()
+	}
+
+	switch e := err.(type) {
+	case PanicError:
+		je.Kind = errorKind(err)
+		je.Function = newJSONLocation(e.fn)
+		je.Panic = fmt.Sprint(e.Panic)
+	case errProvide:
+		je.Function = newJSONLocation(e.Func)
+	case errConstructorFailed:
+		je.Function = newJSONLocation(e.Func)
+		je.Module = e.Module
+	case errArgumentsFailed:
+		je.Function = newJSONLocation(e.Func)
+		je.Module = e.Module
+	case errMissingDependencies:
+		je.Function = newJSONLocation(e.Func)
+		je.Module = e.Module
+	case errTeardownFailed:
+		je.Function = newJSONLocation(e.Func)
+		if e.Key.t != nil {
+			k := newJSONKey(e.Key)
+			je.Key = &k
+		}
+	case errRequestScopeRequired:
+		je.Function = newJSONLocation(e.Func)
+		k := newJSONKey(e.Key)
+		je.Key = &k
+	case errParamSingleFailed:
+		k := newJSONKey(e.Key)
+		je.Key = &k
+	case errParamGroupFailed:
+		k := newJSONKey(e.Key)
+		je.Key = &k
+	case errLazyCycle:
+		k := newJSONKey(e.Key)
+		je.Key = &k
+	case errScopeFailed:
+		je.Scope = e.Scope
+	case errModuleFailed:
+		je.Module = e.Module
+	case errTraced:
+		je.TraceID = e.TraceID
+	case errMissingTypes:
+		for _, mt := range e {
+			m := jsonMissing{Key: newJSONKey(mt.Key)}
+			for _, s := range mt.suggestions {
+				m.Suggestions = append(m.Suggestions, newJSONKey(s))
+			}
+			je.Missing = append(je.Missing, m)
+		}
+	case errCycleDetected:
+		je.Scope = e.scope.name
+		for _, entry := range e.Path {
+			ce := jsonCycleEntry{
+				Key:      newJSONKey(entry.Key),
+				Function: newJSONLocation(entry.Func),
+			}
+			if entry.Via != nil {
+				via := newJSONKey(*entry.Via)
+				ce.Via = &via
+			}
+			je.Cycle = append(je.Cycle, ce)
+		}
+	case DuplicateProvideError:
+		k := newJSONKey(e.key())
+		je.Key = &k
+		for _, site := range e.Conflicts {
+			l := site.Location
+			je.Conflicts = append(je.Conflicts, jsonLocation{
+				Name: l.Name, Package: l.Package, File: l.File, Line: l.Line,
+			})
+		}
+	}
+
+	if cause := errors.Unwrap(err); cause != nil {
+		je.Cause = newJSONError(cause)
+	}
+	return je
+}
+
+// errorKind names the kind of a dig error after its type, such as
+// "missing_types" for errMissingTypes and "panic" for PanicError.
+func errorKind(err error) string {
+	t := reflect.TypeOf(err)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	name := t.Name()
+	name = strings.TrimSuffix(strings.TrimPrefix(name, "err"), "Error")
+
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestEncodeError(t *testing.T) {
+	t.Parallel()
+
+	type A struct{}
+	type B struct{}
+
+	decode := func(t *testing.T, err error) map[string]interface{} {
+		b, encErr := dig.EncodeError(err)
+		require.NoError(t, encErr)
+		var out map[string]interface{}
+		require.NoError(t, json.Unmarshal(b, &out))
+		return out
+	}
+
+	t.Run("missing dependency of a constructor", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func(*B) *A { return &A{} })
+		out := decode(t, c.Invoke(func(*A) {}))
+
+		assert.Equal(t, "arguments_failed", out["kind"])
+		fn := out["function"].(map[string]interface{})
+		assert.Equal(t, "go.uber.org/dig_test", fn["package"])
+		assert.Contains(t, fn["file"], "errjson_test.go")
+
+		// Invoke -> constructor -> missing type.
+		assert.Contains(t, fmt.Sprint(out["cause"]), "missing_dependencies")
+		cause := out["cause"].(map[string]interface{})
+		for cause["kind"] != "missing_types" {
+			cause = cause["cause"].(map[string]interface{})
+		}
+		missing := cause["missing"].([]interface{})
+		require.Len(t, missing, 1)
+		key := missing[0].(map[string]interface{})["key"].(map[string]interface{})
+		assert.Equal(t, "*dig_test.B", key["type"])
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.Compatibility(dig.CompatExtended))
+		c.RequireProvide(func(*B) *A { return &A{} })
+		out := decode(t, c.Provide(func(*A) *B { return &B{} }))
+
+		assert.Equal(t, "provide", out["kind"])
+		var cycle []interface{}
+		for e := out; e != nil; {
+			if c, ok := e["cycle"].([]interface{}); ok {
+				cycle = c
+				break
+			}
+			e, _ = e["cause"].(map[string]interface{})
+		}
+		require.Len(t, cycle, 3)
+		entry := cycle[0].(map[string]interface{})
+		assert.Contains(t, entry["key"].(map[string]interface{})["type"], "dig_test.")
+		assert.NotEmpty(t, entry["function"])
+		assert.Nil(t, entry["via"])
+		via := cycle[1].(map[string]interface{})["via"].(map[string]interface{})
+		assert.Contains(t, via["type"], "dig_test.")
+	})
+
+	t.Run("multiple errors", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.Compatibility(dig.CompatExtended))
+		c.RequireProvide(func(*B) *A { return &A{} })
+		c.RequireProvide(func(*A) int { return 0 })
+		child := c.Scope("child")
+		require.NoError(t, child.Provide(func(*B) string { return "" }))
+
+		out := decode(t, c.Verify())
+		assert.Equal(t, "multiple", out["kind"])
+		errs := out["errors"].([]interface{})
+		require.Len(t, errs, 2)
+		assert.Equal(t, "scope_failed", errs[1].(map[string]interface{})["kind"])
+		assert.Equal(t, "child", errs[1].(map[string]interface{})["scope"])
+	})
+
+	t.Run("non-dig error", func(t *testing.T) {
+		t.Parallel()
+
+		out := decode(t, errors.New("great sadness"))
+		assert.Equal(t, map[string]interface{}{"message": "great sadness"}, out)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := dig.EncodeError(nil)
+		assert.Error(t, err)
+	})
+}
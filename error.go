@@ -26,6 +26,7 @@ import (
 	"io"
 	"reflect"
 	"sort"
+	"strings"
 
 	"go.uber.org/dig/internal/digreflect"
 	"go.uber.org/dig/internal/dot"
@@ -49,6 +50,29 @@ type Error interface {
 	writeMessage(w io.Writer, v string)
 }
 
+// Classes of errors returned by dig. Use errors.Is to handle a class of
+// failures without matching on error messages.
+//
+//	if errors.Is(err, dig.ErrMissingDependencies) {
+//	  // ...
+//	}
+//
+// Errors of each class also match this package's other error types where
+// applicable, such as DuplicateProvideError, with errors.As.
+var (
+	// ErrProvide matches errors returned when a constructor can't be
+	// provided to a container.
+	ErrProvide = errors.New("cannot provide function")
+
+	// ErrMissingDependencies matches errors returned when values required
+	// by a constructor or invoked function are not available.
+	ErrMissingDependencies = errors.New("missing dependencies")
+
+	// ErrCycle matches errors returned when values depend on themselves,
+	// directly or indirectly.
+	ErrCycle = errors.New("cycle detected in dependency graph")
+)
+
 // a digError is a dig.Error with additional functionality for
 // internal use - namely the ability to be formatted.
 type digError interface {
@@ -88,12 +112,23 @@ type PanicError struct {
 	// The function the panic occurred at
 	fn *digreflect.Func
 
+	// The values that were being resolved when the panic occurred, if the
+	// panic occurred in a constructor or decorator of a container built
+	// with Compatibility(CompatExtended).
+	path resolutionPath
+
 	// The panic that was returned from recover()
 	Panic any
 }
 
 // Format will format the PanicError, expanding the corresponding function if in +v mode.
+// In containers built with Compatibility(CompatExtended), if the panic occurred
+// while building a dependency, the message is prefixed with the chain of values
+// that were being resolved.
 func (e PanicError) Format(w fmt.State, c rune) {
+	if len(e.path) > 0 {
+		fmt.Fprintf(w, "%v: ", e.path)
+	}
 	if w.Flag('+') && c == 'v' {
 		fmt.Fprintf(w, "panic: %q in func: %+v", e.Panic, e.fn)
 	} else {
@@ -163,6 +198,89 @@ func RootCause(err error) error {
 	return err
 }
 
+// Summary returns a one-line description of the most actionable failure
+// in a chain of wrapped errors returned by the container, suitable for
+// command line tools that must report failures concisely:
+//
+//	missing type *sql.DB needed by "example.com/app".NewServer (server.go:12)
+//	"example.com/app".NewDB (db.go:20) failed: dial tcp: connection refused
+//	panic in "example.com/app".NewCache (cache.go:8): out of memory
+//
+// If more than one operation failed, such as when a Container is closed,
+// the first failure is summarized. Errors that don't come from Dig are
+// summarized by their own message.
+func Summary(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	var last error // innermost error worth summarizing
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		switch e := e.(type) {
+		case errMultiple:
+			return fmt.Sprintf("%v (and %d more)", Summary(e[0]), len(e)-1)
+		case errMissingDependencies, errConstructorFailed, PanicError:
+			last = e
+		}
+	}
+
+	switch e := last.(type) {
+	case errMissingDependencies:
+		var mts errMissingTypes
+		if errors.As(e.Reason, &mts) {
+			keys := make([]string, len(mts))
+			for i, mt := range mts {
+				keys[i] = mt.Key.String()
+			}
+			noun := "type"
+			if len(keys) > 1 {
+				noun = "types"
+			}
+			return fmt.Sprintf("missing %v %v needed by %v", noun, strings.Join(keys, ", "), e.Func)
+		}
+	case errConstructorFailed:
+		return fmt.Sprintf("%v failed: %v", e.Func, RootCause(e.Reason))
+	case PanicError:
+		return fmt.Sprintf("panic in %v: %v", e.fn, e.Panic)
+	}
+	return fmt.Sprint(RootCause(err))
+}
+
+// ErrorsByScope groups the failures aggregated in an error returned by the
+// container by the path of the Scope they occurred in, such as
+// "api/handlers". Failures outside of named Scopes are listed under "".
+//
+//	for scope, errs := range dig.ErrorsByScope(c.Build()) {
+//	  log.Printf("scope %q: %d failures", scope, len(errs))
+//	}
+//
+// Failures are attributed to Scopes only by containers built with
+// Compatibility(CompatExtended); other containers don't identify Scopes in
+// their errors, so all failures are listed under "".
+//
+// ErrorsByScope returns nil if err is nil.
+func ErrorsByScope(err error) map[string][]error {
+	if err == nil {
+		return nil
+	}
+	byScope := make(map[string][]error)
+	collectScopeErrors(byScope, "", err)
+	return byScope
+}
+
+func collectScopeErrors(byScope map[string][]error, scope string, err error) {
+	switch e := err.(type) {
+	case errMultiple:
+		for _, err := range e {
+			collectScopeErrors(byScope, scope, err)
+		}
+	case errScopeFailed:
+		collectScopeErrors(byScope, e.Scope, e.Reason)
+	default:
+		byScope[scope] = append(byScope[scope], err)
+	}
+}
+
 // errInvalidInput is returned whenever the user provides bad input when
 // interacting with the container. May optionally have a more detailed
 // error wrapped underneath.
@@ -207,6 +325,8 @@ func (e errProvide) Error() string { return fmt.Sprint(e) }
 
 func (e errProvide) Unwrap() error { return e.Reason }
 
+func (e errProvide) Is(target error) bool { return target == ErrProvide }
+
 func (e errProvide) writeMessage(w io.Writer, verb string) {
 	fmt.Fprintf(w, "cannot provide function "+verb, e.Func)
 }
@@ -219,6 +339,7 @@ func (e errProvide) Format(w fmt.State, c rune) {
 // with a non-nil error.
 type errConstructorFailed struct {
 	Func   *digreflect.Func
+	Module string // name of the Module that Func was provided through, if any
 	Reason error
 }
 
@@ -230,6 +351,7 @@ func (e errConstructorFailed) Unwrap() error { return e.Reason }
 
 func (e errConstructorFailed) writeMessage(w io.Writer, verb string) {
 	fmt.Fprintf(w, "received non-nil error from function "+verb, e.Func)
+	writeModule(w, e.Module)
 }
 
 func (e errConstructorFailed) Format(w fmt.State, c rune) {
@@ -240,6 +362,7 @@ func (e errConstructorFailed) Format(w fmt.State, c rune) {
 // of its dependencies failed to build for any reason.
 type errArgumentsFailed struct {
 	Func   *digreflect.Func
+	Module string // name of the Module that Func was provided through, if any
 	Reason error
 }
 
@@ -251,6 +374,7 @@ func (e errArgumentsFailed) Unwrap() error { return e.Reason }
 
 func (e errArgumentsFailed) writeMessage(w io.Writer, verb string) {
 	fmt.Fprintf(w, "could not build arguments for function "+verb, e.Func)
+	writeModule(w, e.Module)
 }
 
 func (e errArgumentsFailed) Format(w fmt.State, c rune) {
@@ -261,6 +385,7 @@ func (e errArgumentsFailed) Format(w fmt.State, c rune) {
 // not available in the container.
 type errMissingDependencies struct {
 	Func   *digreflect.Func
+	Module string // name of the Module that Func was provided through, if any
 	Reason error
 }
 
@@ -270,14 +395,45 @@ func (e errMissingDependencies) Error() string { return fmt.Sprint(e) }
 
 func (e errMissingDependencies) Unwrap() error { return e.Reason }
 
+func (e errMissingDependencies) Is(target error) bool { return target == ErrMissingDependencies }
+
 func (e errMissingDependencies) writeMessage(w io.Writer, verb string) {
 	fmt.Fprintf(w, "missing dependencies for function "+verb, e.Func)
+	writeModule(w, e.Module)
 }
 
 func (e errMissingDependencies) Format(w fmt.State, c rune) {
 	formatError(e, w, c)
 }
 
+// writeModule attributes an error to the given Module, if any.
+func writeModule(w io.Writer, module string) {
+	if module != "" {
+		fmt.Fprintf(w, " in module %q", module)
+	}
+}
+
+// errScopeFailed is returned when a value could not be resolved in a
+// child Scope. It identifies the Scope by its path from the root.
+type errScopeFailed struct {
+	Scope  string
+	Reason error
+}
+
+var _ digError = errScopeFailed{}
+
+func (e errScopeFailed) Error() string { return fmt.Sprint(e) }
+
+func (e errScopeFailed) Unwrap() error { return e.Reason }
+
+func (e errScopeFailed) writeMessage(w io.Writer, _ string) {
+	fmt.Fprintf(w, "in scope %q", e.Scope)
+}
+
+func (e errScopeFailed) Format(w fmt.State, c rune) {
+	formatError(e, w, c)
+}
+
 // errParamSingleFailed is returned when a paramSingle could not be built.
 type errParamSingleFailed struct {
 	Key    key
@@ -310,6 +466,25 @@ func (e errParamSingleFailed) updateGraph(g *dot.Graph) {
 	g.FailNodes([]*dot.Result{failed}, e.CtorID)
 }
 
+// errRequestScopeRequired is returned when a request-scoped value is
+// requested outside of a request Scope.
+type errRequestScopeRequired struct {
+	Key  key
+	Func *digreflect.Func
+}
+
+var _ digError = errRequestScopeRequired{}
+
+func (e errRequestScopeRequired) Error() string { return fmt.Sprint(e) }
+
+func (e errRequestScopeRequired) writeMessage(w io.Writer, _ string) {
+	fmt.Fprintf(w, "%v provided by %v is request-scoped and can only be used from a request Scope", e.Key, e.Func)
+}
+
+func (e errRequestScopeRequired) Format(w fmt.State, c rune) {
+	formatError(e, w, c)
+}
+
 // errParamGroupFailed is returned when a value group cannot be built because
 // any of the values in the group failed to build.
 type errParamGroupFailed struct {
@@ -336,6 +511,108 @@ func (e errParamGroupFailed) updateGraph(g *dot.Graph) {
 	g.FailGroupNodes(e.Key.group, e.Key.t, e.CtorID)
 }
 
+// errTeardownFailed is returned when a value produced by a constructor could
+// not be closed, or the cleanup function returned by a constructor did not
+// complete.
+type errTeardownFailed struct {
+	// Key of the value that could not be closed. Unset for cleanup
+	// functions.
+	Key    key
+	Func   *digreflect.Func
+	Reason error
+}
+
+var _ digError = errTeardownFailed{}
+
+func (e errTeardownFailed) Error() string { return fmt.Sprint(e) }
+
+func (e errTeardownFailed) Unwrap() error { return e.Reason }
+
+func (e errTeardownFailed) writeMessage(w io.Writer, verb string) {
+	if e.Key.t == nil {
+		fmt.Fprintf(w, "failed to run cleanup function returned by "+verb, e.Func)
+		return
+	}
+	fmt.Fprintf(w, "failed to close %v provided by "+verb, e.Key, e.Func)
+}
+
+func (e errTeardownFailed) Format(w fmt.State, c rune) {
+	formatError(e, w, c)
+}
+
+// errMultiple aggregates the errors encountered by an operation that
+// continues past failures, such as Container.Close.
+type errMultiple []error // inv: len > 1
+
+var _ digError = errMultiple(nil)
+
+// newErrMultiple returns nil if errs is empty, the only error if errs has a
+// single item, and an errMultiple otherwise.
+func newErrMultiple(errs []error) error {
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	default:
+		return errMultiple(errs)
+	}
+}
+
+func (e errMultiple) Error() string { return fmt.Sprint(e) }
+
+// Unwrap returns the aggregated errors for use with errors.Is and errors.As.
+func (e errMultiple) Unwrap() []error { return e }
+
+// Is reports whether any of the aggregated errors matches target. Unlike
+// Unwrap, this is used by errors.Is before Go 1.20.
+func (e errMultiple) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first of the aggregated errors that matches target. Unlike
+// Unwrap, this is used by errors.As before Go 1.20.
+func (e errMultiple) As(target interface{}) bool {
+	for _, err := range e {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e errMultiple) writeMessage(w io.Writer, v string) {
+	multiline := v == "%+v"
+
+	fmt.Fprintf(w, "%d errors occurred:", len(e))
+	if !multiline {
+		io.WriteString(w, " ")
+	}
+	for i, err := range e {
+		if multiline {
+			io.WriteString(w, "\n\t- ")
+		} else if i > 0 {
+			io.WriteString(w, "; ")
+		}
+		fmt.Fprintf(w, v, err)
+	}
+}
+
+// Format is implemented directly rather than through formatError because
+// errMultiple wraps more than one error.
+func (e errMultiple) Format(w fmt.State, c rune) {
+	verb := "%v"
+	if w.Flag('+') && c == 'v' {
+		verb = "%+v"
+	}
+	e.writeMessage(w, verb)
+}
+
 // missingType holds information about a type that was missing in the
 // container.
 type missingType struct {
@@ -344,6 +621,15 @@ type missingType struct {
 	// If non-empty, we will include suggestions for what the user may have
 	// meant.
 	suggestions []key
+
+	// Constructors for this key that were disabled with
+	// ProviderHandle.Disable, if any.
+	disabled []*digreflect.Func
+
+	// Platforms that constructors for this key were restricted to with
+	// Platforms, none of which is the current platform.
+	platforms []string
+	platform  string
 }
 
 // Format prints a string representation of missingType.
@@ -359,10 +645,30 @@ type missingType struct {
 //	io.Writer: did you mean to Provide it?
 //	io.Writer: did you mean to use *bytes.Buffer?
 //	io.Writer: did you mean to use one of *bytes.Buffer, or *os.File?
+//
+// Containers built with Compatibility(CompatExtended) also suggest the
+// same type under other names, such as io.Writer[name="stderr"].
 func (mt missingType) Format(w fmt.State, v rune) {
 	plusV := w.Flag('+') && v == 'v'
 
 	fmt.Fprint(w, mt.Key)
+	if len(mt.disabled) > 0 {
+		io.WriteString(w, " (provided by ")
+		for i, f := range mt.disabled {
+			if i > 0 {
+				io.WriteString(w, ", ")
+			}
+			fmt.Fprint(w, f)
+		}
+		io.WriteString(w, ", which is disabled)")
+		return
+	}
+	if len(mt.platforms) > 0 {
+		fmt.Fprintf(w, " (provided only for %v; not for %v)",
+			strings.Join(mt.platforms, ", "), mt.platform)
+		return
+	}
+
 	switch len(mt.suggestions) {
 	case 0:
 		if plusV {
@@ -438,10 +744,22 @@ func newErrMissingTypes(c containerStore, k key) errMissingTypes {
 	sort.Sort(byTypeName(suggestions))
 
 	mt := missingType{Key: k}
+	for _, p := range findDisabledProviders(c, k) {
+		mt.disabled = append(mt.disabled, p.Location())
+	}
+	if platforms := c.platformsFor(k); len(platforms) > 0 {
+		mt.platforms = platforms
+		mt.platform = c.platform()
+	}
+	// Maybe we have the same type under a different name.
+	for _, name := range c.knownNames(k.t) {
+		if name != k.name && !c.isUpstream() {
+			mt.suggestions = append(mt.suggestions, key{t: k.t, name: name})
+		}
+	}
 	for _, t := range suggestions {
 		if len(c.getValueProviders(k.name, t)) > 0 {
-			k.t = t
-			mt.suggestions = append(mt.suggestions, k)
+			mt.suggestions = append(mt.suggestions, key{t: t, name: k.name})
 		}
 	}
 
@@ -450,6 +768,8 @@ func newErrMissingTypes(c containerStore, k key) errMissingTypes {
 
 func (e errMissingTypes) Error() string { return fmt.Sprint(e) }
 
+func (e errMissingTypes) Is(target error) bool { return target == ErrMissingDependencies }
+
 func (e errMissingTypes) writeMessage(w io.Writer, v string) {
 
 	multiline := v == "%+v"
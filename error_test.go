@@ -282,6 +282,198 @@ func TestRootCauseEndToEnd(t *testing.T) {
 	}
 }
 
+func TestErrorClasses(t *testing.T) {
+	t.Parallel()
+
+	type A struct{}
+	type B struct{}
+
+	tests := []struct {
+		desc string
+		run  func(c *Container) error
+		want []error
+	}{
+		{
+			desc: "invalid constructor",
+			run: func(c *Container) error {
+				return c.Provide(func() {})
+			},
+			want: []error{ErrProvide},
+		},
+		{
+			desc: "duplicate provide",
+			run: func(c *Container) error {
+				if err := c.Provide(func() *A { return &A{} }); err != nil {
+					return err
+				}
+				return c.Provide(func() *A { return &A{} })
+			},
+			want: []error{ErrProvide},
+		},
+		{
+			desc: "cycle on provide",
+			run: func(c *Container) error {
+				if err := c.Provide(func(*B) *A { return &A{} }); err != nil {
+					return err
+				}
+				return c.Provide(func(*A) *B { return &B{} })
+			},
+			want: []error{ErrProvide, ErrCycle},
+		},
+		{
+			desc: "missing dependency of invoked function",
+			run: func(c *Container) error {
+				return c.Invoke(func(*A) {})
+			},
+			want: []error{ErrMissingDependencies},
+		},
+		{
+			desc: "missing dependency of constructor",
+			run: func(c *Container) error {
+				if err := c.Provide(func(*B) *A { return &A{} }); err != nil {
+					return err
+				}
+				return c.Invoke(func(*A) {})
+			},
+			want: []error{ErrMissingDependencies},
+		},
+		{
+			desc: "cycle through a lazy dependency",
+			run: func(c *Container) error {
+				if err := c.Provide(func(l Lazy[*B]) (*A, error) {
+					_, err := l.Get()
+					return &A{}, err
+				}); err != nil {
+					return err
+				}
+				if err := c.Provide(func(*A) *B { return &B{} }); err != nil {
+					return err
+				}
+				return c.Invoke(func(*A) {})
+			},
+			want: []error{ErrCycle},
+		},
+	}
+
+	classes := []error{ErrProvide, ErrMissingDependencies, ErrCycle}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.desc, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.run(New())
+			if !assert.Error(t, err) {
+				return
+			}
+			for _, class := range classes {
+				want := false
+				for _, w := range tt.want {
+					want = want || w == class
+				}
+				assert.Equal(t, want, errors.Is(err, class), "errors.Is(%v)", class)
+			}
+		})
+	}
+}
+
+func TestSummary(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		desc   string
+		setup  func(c *Container)
+		invoke interface{}
+		want   string // regular expression
+	}{
+		{
+			desc: "missing type",
+			setup: func(c *Container) {
+				assert.NoError(t, c.Provide(func(int, float64) string { return "" }))
+			},
+			invoke: func(string) {},
+			want: `^missing types int, float64 needed by "go.uber.org/dig".TestSummary.func[\d.]+ ` +
+				`\(\S+/error_test.go:\d+\)$`,
+		},
+		{
+			desc:   "missing type in invoke",
+			setup:  func(c *Container) {},
+			invoke: func(string) {},
+			want:   `^missing type string needed by "go.uber.org/dig".TestSummary.func[\d.]+ \(\S+\)$`,
+		},
+		{
+			desc: "constructor failed",
+			setup: func(c *Container) {
+				assert.NoError(t, c.Provide(func() (int, error) { return 0, nil }))
+				assert.NoError(t, c.Provide(func(int) (string, error) {
+					return "", errors.New("great sadness")
+				}))
+			},
+			invoke: func(string) {},
+			want:   `^"go.uber.org/dig".TestSummary.func[\d.]+ \(\S+\) failed: great sadness$`,
+		},
+		{
+			desc: "panic",
+			setup: func(c *Container) {
+				assert.NoError(t, c.Provide(func() string { panic("terrible") }))
+			},
+			invoke: func(string) {},
+			want:   `^panic in "go.uber.org/dig".TestSummary.func[\d.]+ \(\S+\): terrible$`,
+		},
+		{
+			desc:   "error in invoke",
+			setup:  func(c *Container) {},
+			invoke: func() error { return errors.New("terrible unhappiness") },
+			want:   `^terrible unhappiness$`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.desc, func(t *testing.T) {
+			t.Parallel()
+
+			c := New(RecoverFromPanics())
+			tt.setup(c)
+			err := c.Invoke(tt.invoke)
+			assert.Regexp(t, tt.want, Summary(err))
+		})
+	}
+
+	t.Run("multiple errors", func(t *testing.T) {
+		t.Parallel()
+
+		err := newErrMultiple([]error{
+			errors.New("great sadness"),
+			errors.New("terrible unhappiness"),
+			errors.New("utter despair"),
+		})
+		assert.Equal(t, "great sadness (and 2 more)", Summary(err))
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, Summary(nil))
+	})
+}
+
+func TestErrMultipleIsAs(t *testing.T) {
+	t.Parallel()
+
+	sad := errors.New("great sadness")
+	err := errMultiple{errors.New("terrible unhappiness"), fmt.Errorf("wrapped: %w", sad)}
+
+	// Called directly, as errors.Is and errors.As do before Go 1.20.
+	assert.True(t, err.Is(sad))
+	assert.False(t, err.Is(errors.New("great sadness")))
+
+	var pe PanicError
+	assert.False(t, err.As(&pe))
+	err = append(err, PanicError{Panic: "utter despair"})
+	assert.True(t, err.As(&pe))
+	assert.Equal(t, "utter despair", pe.Panic)
+}
+
 func joinLines(ls ...string) string { return strings.Join(ls, "\n") }
 
 // Simple error fake that provides control of %v and %+v representations.
@@ -517,6 +709,43 @@ func TestErrorFormatting(t *testing.T) {
 				"	- *dig.anotherType (did you mean to Provide it?)",
 			),
 		},
+		{
+			desc: "errTeardownFailed/closer",
+			give: errTeardownFailed{
+				Key:    key{t: reflect.TypeOf(someType{})},
+				Func:   someFunc,
+				Reason: simpleErr,
+			},
+			wantString: `failed to close dig.someType provided by "foo".Bar (foo/bar.go:42): great sadness`,
+			wantPlusV: joinLines(
+				`failed to close dig.someType provided by "foo".Bar`,
+				"	foo/bar.go:42:",
+				"great sadness",
+			),
+		},
+		{
+			desc: "errTeardownFailed/cleanup",
+			give: errTeardownFailed{
+				Func:   someFunc,
+				Reason: simpleErr,
+			},
+			wantString: `failed to run cleanup function returned by "foo".Bar (foo/bar.go:42): great sadness`,
+			wantPlusV: joinLines(
+				`failed to run cleanup function returned by "foo".Bar`,
+				"	foo/bar.go:42:",
+				"great sadness",
+			),
+		},
+		{
+			desc:       "errMultiple",
+			give:       errMultiple{simpleErr, errors.New("another sadness")},
+			wantString: "2 errors occurred: great sadness; another sadness",
+			wantPlusV: joinLines(
+				"2 errors occurred:",
+				"	- great sadness",
+				"	- another sadness",
+			),
+		},
 	}
 
 	for _, tt := range tests {
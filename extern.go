@@ -0,0 +1,84 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// Extern declares that a value of type T with the given name, or no name
+// if empty, will be provided to the container later. Until then, Invoke,
+// Build, and other checks for missing dependencies treat it as present.
+//
+// This supports two-phase assembly, where the graph is declared before
+// infrastructure values such as connections become available:
+//
+//	c := dig.New()
+//	dig.Extern[*sql.DB](c, "")
+//	c.Provide(NewUserStore) // depends on *sql.DB
+//	if err := c.Build(); err != nil {
+//	  // ...
+//	}
+//	// later
+//	c.Provide(func() *sql.DB { return db })
+//
+// Requesting the value before it has been provided fails with an error
+// stating that it has not been supplied yet. Build skips Eager
+// constructors that depend on such values.
+func Extern[T any](c *Container, name string) error {
+	if strings.ContainsRune(name, '`') {
+		return newErrInvalidInput(
+			fmt.Sprintf("invalid dig.Extern name %q: names cannot contain backquotes", name), nil)
+	}
+
+	root := c.scope
+	if root.externs == nil {
+		root.externs = make(map[key]struct{})
+	}
+	root.externs[key{t: reflect.TypeOf((*T)(nil)).Elem(), name: name}] = struct{}{}
+	return nil
+}
+
+func (s *Scope) isExtern(name string, t reflect.Type) bool {
+	_, ok := s.rootScope().externs[key{name: name, t: t}]
+	return ok
+}
+
+// errExternNotSupplied is returned when a value declared with Extern is
+// requested before it has been provided.
+type errExternNotSupplied struct {
+	Key key
+}
+
+var _ digError = errExternNotSupplied{}
+
+func (e errExternNotSupplied) Error() string { return fmt.Sprint(e) }
+
+func (e errExternNotSupplied) writeMessage(w io.Writer, _ string) {
+	fmt.Fprintf(w, "%v was declared with dig.Extern but has not been provided yet", e.Key)
+}
+
+func (e errExternNotSupplied) Format(w fmt.State, c rune) {
+	formatError(e, w, c)
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestExtern(t *testing.T) {
+	t.Parallel()
+
+	type DB struct{ Name string }
+	type Store struct{ DB *DB }
+
+	t.Run("two-phase assembly", func(t *testing.T) {
+		c := digtest.New(t)
+		require.NoError(t, dig.Extern[*DB](c.Container, ""))
+
+		var built bool
+		c.RequireProvide(func(db *DB) *Store {
+			built = true
+			return &Store{DB: db}
+		}, dig.Eager())
+		require.NoError(t, c.Build())
+		assert.False(t, built, "constructor must wait for the extern value")
+
+		err := c.Invoke(func(*Store) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(),
+			"*dig_test.DB was declared with dig.Extern but has not been provided yet")
+
+		c.RequireProvide(func() *DB { return &DB{Name: "primary"} })
+		require.NoError(t, c.Build())
+		assert.True(t, built)
+		c.RequireInvoke(func(s *Store) {
+			assert.Equal(t, "primary", s.DB.Name)
+		})
+	})
+
+	t.Run("named", func(t *testing.T) {
+		c := digtest.New(t)
+		require.NoError(t, dig.Extern[*DB](c.Container, "replica"))
+
+		type params struct {
+			dig.In
+
+			DB *DB `name:"replica"`
+		}
+		err := c.Invoke(func(params) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `*dig_test.DB[name="replica"] was declared with dig.Extern`)
+
+		err = c.Invoke(func(*DB) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing type: *dig_test.DB")
+	})
+
+	t.Run("optional", func(t *testing.T) {
+		c := digtest.New(t)
+		require.NoError(t, dig.Extern[*DB](c.Container, ""))
+
+		type params struct {
+			dig.In
+
+			DB *DB `optional:"true"`
+		}
+		c.RequireInvoke(func(p params) { assert.Nil(t, p.DB) })
+	})
+
+	t.Run("child scope", func(t *testing.T) {
+		c := digtest.New(t)
+		require.NoError(t, dig.Extern[*DB](c.Container, ""))
+
+		child := c.Scope("child")
+		child.RequireProvide(func(db *DB) *Store { return &Store{DB: db} })
+
+		err := child.Invoke(func(*Store) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "was declared with dig.Extern")
+	})
+
+	t.Run("clone", func(t *testing.T) {
+		c := digtest.New(t)
+		require.NoError(t, dig.Extern[*DB](c.Container, ""))
+
+		err := c.Clone().Invoke(func(*DB) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "was declared with dig.Extern")
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		err := dig.Extern[*DB](digtest.New(t).Container, "foo`bar")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "names cannot contain backquotes")
+	})
+}
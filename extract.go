@@ -0,0 +1,139 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"reflect"
+
+	"go.uber.org/dig/internal/digreflect"
+)
+
+// Extract builds a new Container with only the constructors and decorators
+// needed to call the given functions, as if they were passed to Invoke.
+//
+//	minimal, err := c.Extract(func(*http.Server) {})
+//
+// This allows wiring shared by many programs to be trimmed down for each of
+// them. The new Container is built as with Clone: it has the same options,
+// and constructors keep their options and locations, but values built so
+// far are not copied. Decorators are kept if they decorate a value that
+// one of the kept constructors provides.
+//
+// Extract fails if a function's direct dependencies are missing from the
+// Container.
+func (c *Container) Extract(roots ...interface{}) (*Container, error) {
+	s := c.scope
+	defer s.lock()()
+
+	needed := make(map[*constructorNode]struct{})
+	need := func(_ *Scope, _ param, n *constructorNode) bool {
+		if _, ok := needed[n]; ok {
+			return false
+		}
+		needed[n] = struct{}{}
+		return true
+	}
+
+	for _, root := range roots {
+		ftype := reflect.TypeOf(root)
+		if ftype == nil || ftype.Kind() != reflect.Func {
+			return nil, newErrInvalidInput(
+				fmt.Sprintf("can't extract values for non-function %v (type %v)", root, ftype), nil)
+		}
+		pl, err := newParamList(ftype, s)
+		if err != nil {
+			return nil, err
+		}
+		if err := shallowCheckDependencies(s, pl); err != nil {
+			return nil, errMissingDependencies{
+				Func:   digreflect.InspectFunc(root),
+				Reason: err,
+			}
+		}
+		walkDependencies(s, pl, need)
+	}
+
+	// Decorators may depend on values that no other kept constructor
+	// needs, so keep adding them until none are left.
+	decorators := make(map[*decoratorNode]struct{})
+	for added := true; added; {
+		added = false
+		keys := make(map[key]struct{})
+		for n := range needed {
+			for _, k := range resultKeys(n) {
+				keys[k] = struct{}{}
+			}
+		}
+
+		for _, op := range s.wiring {
+			dn := op.dnode
+			if dn == nil || !dn.isLive() {
+				continue
+			}
+			if _, ok := decorators[dn]; ok {
+				continue
+			}
+			for _, k := range dn.keys {
+				if _, ok := keys[k]; ok {
+					decorators[dn] = struct{}{}
+					walkDependencies(dn.s, dn.params, need)
+					added = true
+					break
+				}
+			}
+		}
+	}
+
+	var wiring []wiringOp
+	for _, op := range s.wiring {
+		switch {
+		case op.child != nil:
+			wiring = append(wiring, op)
+		case op.ctor != nil:
+			if _, ok := needed[op.node]; ok {
+				wiring = append(wiring, op)
+			}
+		case op.dcor != nil:
+			if _, ok := decorators[op.dnode]; ok {
+				wiring = append(wiring, op)
+			}
+		}
+		// Removals only affect constructors and decorators that are
+		// no longer live, none of which are kept.
+	}
+
+	extracted := newCloneOf(s)
+	if _, err := extracted.scope.replay(s, wiring); err != nil {
+		return nil, err
+	}
+	return extracted, nil
+}
+
+// isLive reports whether this decorator is still in use by its Scope.
+func (n *decoratorNode) isLive() bool {
+	for _, dn := range n.s.decoratorNodes {
+		if dn == n {
+			return true
+		}
+	}
+	return false
+}
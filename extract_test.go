@@ -0,0 +1,133 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestExtract(t *testing.T) {
+	t.Parallel()
+
+	type (
+		Config    struct{ name string }
+		Logger    struct{ name string }
+		Server    struct{ log *Logger }
+		Worker    struct{}
+		Unrelated struct{}
+	)
+
+	newContainer := func(t *testing.T) *digtest.Container {
+		c := digtest.New(t)
+		c.RequireProvide(func() *Config { return &Config{name: "prod"} })
+		c.RequireProvide(func() *Logger { return &Logger{name: "base"} })
+		c.RequireProvide(func(log *Logger) *Server { return &Server{log: log} })
+		c.RequireProvide(func(*Logger) *Worker { return &Worker{} })
+		c.RequireProvide(func() *Unrelated { return &Unrelated{} })
+		c.RequireProvide(func() int { return 1 }, dig.Group("ints"))
+		c.RequireProvide(func() int { return 2 }, dig.Group("ints"))
+		return c
+	}
+
+	t.Run("keeps only needed constructors", func(t *testing.T) {
+		c := newContainer(t)
+
+		extracted, err := c.Extract(func(*Server) {})
+		require.NoError(t, err)
+
+		require.NoError(t, extracted.Invoke(func(s *Server) {
+			assert.Equal(t, "base", s.log.name)
+		}))
+		assert.Error(t, extracted.Invoke(func(*Worker) {}))
+		assert.Error(t, extracted.Invoke(func(*Unrelated) {}))
+		assert.Error(t, extracted.Invoke(func(*Config) {}))
+	})
+
+	t.Run("value groups and parameter objects", func(t *testing.T) {
+		c := newContainer(t)
+
+		extracted, err := c.Extract(func(p struct {
+			dig.In
+
+			Ints []int `group:"ints"`
+		}) {
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, extracted.Invoke(func(p struct {
+			dig.In
+
+			Ints []int `group:"ints"`
+		}) {
+			assert.ElementsMatch(t, []int{1, 2}, p.Ints)
+		}))
+		assert.Error(t, extracted.Invoke(func(*Logger) {}))
+	})
+
+	t.Run("keeps decorators and their dependencies", func(t *testing.T) {
+		c := newContainer(t)
+		c.RequireDecorate(func(log *Logger, cfg *Config) *Logger {
+			return &Logger{name: log.name + " " + cfg.name}
+		})
+		c.RequireDecorate(func(*Unrelated) *Unrelated { return &Unrelated{} })
+
+		extracted, err := c.Extract(func(*Server) {})
+		require.NoError(t, err)
+
+		require.NoError(t, extracted.Invoke(func(s *Server) {
+			assert.Equal(t, "base prod", s.log.name)
+		}))
+		assert.Len(t, extracted.Decorators(), 1)
+	})
+
+	t.Run("values are not copied", func(t *testing.T) {
+		c := newContainer(t)
+		var original *Logger
+		c.RequireInvoke(func(log *Logger) { original = log })
+
+		extracted, err := c.Extract(func(*Logger) {})
+		require.NoError(t, err)
+		require.NoError(t, extracted.Invoke(func(log *Logger) {
+			assert.NotSame(t, original, log)
+		}))
+	})
+
+	t.Run("missing dependency", func(t *testing.T) {
+		c := digtest.New(t)
+
+		_, err := c.Extract(func(*Server) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing type: *dig_test.Server")
+	})
+
+	t.Run("non-function", func(t *testing.T) {
+		c := digtest.New(t)
+
+		_, err := c.Extract(42)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "can't extract values for non-function 42 (type int)")
+	})
+}
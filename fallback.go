@@ -0,0 +1,67 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+// Fallback is a ProvideOption that marks a constructor as a default, used
+// only if no other constructor is provided for the same values. This lets
+// libraries ship sensible defaults that applications can override without
+// having to Replace them.
+//
+//	// In the library:
+//	c.Provide(NewStdoutLogger, dig.Fallback())
+//
+//	// In the application, before or after the library:
+//	c.Provide(NewZapLogger)
+//
+// A value provided by a fallback constructor is overridden by a regular
+// constructor provided to the same Scope or any of its ancestors. Fallback
+// constructors conflict only with each other: providing the same value
+// with two fallback constructors fails as with Provide.
+//
+// Values built by a fallback constructor can't be overridden.
+// Fallback cannot be used with value groups.
+func Fallback() ProvideOption {
+	return fallbackOption{}
+}
+
+type fallbackOption struct{}
+
+func (fallbackOption) String() string {
+	return "Fallback()"
+}
+
+func (fallbackOption) applyProvideOption(opts *provideOptions) {
+	opts.Fallback = true
+}
+
+// overridesFallback reports whether a regular constructor provides the
+// given key in this Scope or any of its ancestors, overriding fallback
+// constructors for it.
+func (s *Scope) overridesFallback(k key) bool {
+	for _, scope := range s.ancestors() {
+		for _, n := range scope.providers[k] {
+			if !n.fallback && !n.disabled {
+				return true
+			}
+		}
+	}
+	return false
+}
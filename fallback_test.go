@@ -0,0 +1,157 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestFallback(t *testing.T) {
+	t.Parallel()
+
+	t.Run("used without other constructors", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() string { return "default" }, dig.Fallback())
+		c.RequireInvoke(func(s string) {
+			assert.Equal(t, "default", s)
+		})
+	})
+
+	t.Run("overridden before or after", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() string { return "default" }, dig.Fallback())
+		c.RequireProvide(func() string { return "app" })
+		c.RequireProvide(func() int { return 42 })
+		c.RequireProvide(func() int { return 0 }, dig.Fallback())
+
+		c.RequireInvoke(func(s string, i int) {
+			assert.Equal(t, "app", s)
+			assert.Equal(t, 42, i)
+		})
+	})
+
+	t.Run("overridden by ancestor scope", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		child := c.Scope("child")
+		require.NoError(t, child.Provide(func() string { return "default" }, dig.Fallback()))
+		c.RequireProvide(func() string { return "app" })
+
+		require.NoError(t, child.Invoke(func(s string) {
+			assert.Equal(t, "app", s)
+		}))
+	})
+
+	t.Run("named values", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() string { return "default" }, dig.Name("x"), dig.Fallback())
+		c.RequireProvide(func() string { return "unnamed" })
+
+		type params struct {
+			dig.In
+
+			X string `name:"x"`
+		}
+		c.RequireInvoke(func(p params) {
+			assert.Equal(t, "default", p.X)
+		})
+	})
+
+	t.Run("multiple results", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() (string, int) { return "default", 42 }, dig.Fallback())
+		c.RequireProvide(func() string { return "app" })
+
+		c.RequireInvoke(func(i int) {
+			assert.Equal(t, 42, i)
+		})
+		c.RequireInvoke(func(s string) {
+			assert.Equal(t, "app", s, "fallback's string must not be used")
+		})
+	})
+
+	t.Run("multiple results with disabled override", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		var h dig.ProviderHandle
+		c.RequireProvide(func() (string, int) { return "default", 42 }, dig.Fallback())
+		c.RequireProvide(func() string { return "app" }, dig.FillProviderHandle(&h))
+
+		c.RequireInvoke(func(int) {})
+		h.Disable()
+		c.RequireInvoke(func(s string) {
+			assert.Equal(t, "default", s)
+		})
+	})
+
+	t.Run("two fallbacks conflict", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() string { return "a" }, dig.Fallback())
+		err := c.Provide(func() string { return "b" }, dig.Fallback())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "already provided by")
+	})
+
+	t.Run("already built", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() string { return "default" }, dig.Fallback())
+		c.RequireInvoke(func(string) {})
+
+		err := c.Provide(func() string { return "app" })
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "has already been built")
+	})
+
+	t.Run("value groups", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		err := c.Provide(func() string { return "a" }, dig.Group("g"), dig.Fallback())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot use dig.Fallback with value groups")
+	})
+
+	t.Run("String", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "Fallback()", fmt.Sprint(dig.Fallback()))
+	})
+}
@@ -0,0 +1,270 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package federation combines the dependency graphs of containers from
+// several services into one graph, giving an organization-wide view of
+// how shared libraries are wired across binaries.
+//
+// Each service exports the graph of its container with Export and
+// publishes it, for example at build time or from a debug endpoint.
+//
+//	g := federation.Export("billing", c)
+//	if err := g.Encode(f); err != nil {
+//	  return err
+//	}
+//
+// A central tool then decodes the graphs of all services and merges them.
+//
+//	var graphs []*federation.Graph
+//	for _, f := range files {
+//	  g, err := federation.Decode(f)
+//	  if err != nil {
+//	    return err
+//	  }
+//	  graphs = append(graphs, g)
+//	}
+//	merged, err := federation.Merge(graphs...)
+//
+// Constructors are identified across binaries by the import path of their
+// package and their name, so the same library constructor used by several
+// services appears once in the merged graph, listing those services.
+package federation
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"go.uber.org/dig"
+)
+
+// Version is the version of the format written by Encode. Decode rejects
+// graphs written with other versions.
+const Version = 1
+
+// Graph is the dependency graph of one or more services.
+type Graph struct {
+	// Version of the format. See Version.
+	Version int `json:"version"`
+
+	// Services whose containers make up this graph, sorted.
+	Services []string `json:"services"`
+
+	// Providers in the graph, sorted by ID.
+	Providers []Provider `json:"providers"`
+
+	// Edges between providers, sorted by From, To, and Input.
+	Edges []Edge `json:"edges"`
+}
+
+// Provider is a constructor in a Graph.
+type Provider struct {
+	// ID identifies the constructor across binaries. It's the import path
+	// of the constructor's package followed by its name.
+	ID string `json:"id"`
+
+	// Module the constructor was provided through, if any. If services
+	// provide the constructor through different Modules, this is the
+	// one from the first graph given to Merge.
+	Module string `json:"module,omitempty"`
+
+	// File and Line where the constructor is defined.
+	File string `json:"file"`
+	Line int    `json:"line"`
+
+	// Inputs and Outputs of the constructor, as described by dig.
+	Inputs  []string `json:"inputs,omitempty"`
+	Outputs []string `json:"outputs,omitempty"`
+
+	// Services that provide the constructor, sorted.
+	Services []string `json:"services"`
+}
+
+// Edge is a dependency of one Provider on another in a Graph.
+type Edge struct {
+	// From is the ID of the consuming Provider.
+	From string `json:"from"`
+
+	// To is the ID of the Provider that satisfies Input.
+	To string `json:"to"`
+
+	// Input of the consuming Provider that this edge satisfies.
+	Input string `json:"input"`
+
+	// Services in which the dependency exists, sorted.
+	Services []string `json:"services"`
+}
+
+type edgeKey struct{ from, to, input string }
+
+// Export returns the graph of the given container, attributed to the
+// named service.
+func Export(service string, c *dig.Container) *Graph {
+	snap := c.InspectSnapshot()
+
+	ids := make(map[dig.ID]string, len(snap.Providers))
+	g := &Graph{Version: Version, Services: []string{service}}
+	for _, p := range snap.Providers {
+		if p.Disabled {
+			continue
+		}
+		id := p.Location.Package + "." + p.Location.Name
+		ids[p.ID] = id
+		g.Providers = append(g.Providers, Provider{
+			ID:       id,
+			Module:   p.Module,
+			File:     p.Location.File,
+			Line:     p.Location.Line,
+			Inputs:   stringify(p.Inputs),
+			Outputs:  stringify(p.Outputs),
+			Services: []string{service},
+		})
+	}
+	for _, e := range snap.Edges {
+		from, ok := ids[e.From]
+		to, ok2 := ids[e.To]
+		if !ok || !ok2 {
+			continue
+		}
+		g.Edges = append(g.Edges, Edge{
+			From:     from,
+			To:       to,
+			Input:    e.Input.String(),
+			Services: []string{service},
+		})
+	}
+
+	// A constructor provided to several Scopes appears once.
+	merged, _ := Merge(g)
+	return merged
+}
+
+func stringify[T fmt.Stringer](items []T) []string {
+	out := make([]string, len(items))
+	for i, item := range items {
+		out[i] = item.String()
+	}
+	return out
+}
+
+// Merge combines the given graphs into one. Providers and edges present in
+// several graphs appear once, listing the services of all of them.
+//
+// Merge fails if the graphs were written with an unsupported version.
+func Merge(graphs ...*Graph) (*Graph, error) {
+	var (
+		services  = make(map[string]struct{})
+		providers = make(map[string]*Provider)
+		edges     = make(map[edgeKey]*Edge)
+	)
+	for _, g := range graphs {
+		if g.Version != Version {
+			return nil, fmt.Errorf("unsupported graph version %d for services %v", g.Version, g.Services)
+		}
+		for _, s := range g.Services {
+			services[s] = struct{}{}
+		}
+		for _, p := range g.Providers {
+			if q, ok := providers[p.ID]; ok {
+				q.Services = union(q.Services, p.Services)
+				q.Inputs = union(q.Inputs, p.Inputs)
+				q.Outputs = union(q.Outputs, p.Outputs)
+				continue
+			}
+			p := p
+			p.Services = union(nil, p.Services)
+			p.Inputs = union(nil, p.Inputs)
+			p.Outputs = union(nil, p.Outputs)
+			providers[p.ID] = &p
+		}
+		for _, e := range g.Edges {
+			k := edgeKey{from: e.From, to: e.To, input: e.Input}
+			if f, ok := edges[k]; ok {
+				f.Services = union(f.Services, e.Services)
+				continue
+			}
+			e := e
+			e.Services = union(nil, e.Services)
+			edges[k] = &e
+		}
+	}
+
+	out := &Graph{Version: Version}
+	for s := range services {
+		out.Services = append(out.Services, s)
+	}
+	sort.Strings(out.Services)
+
+	for _, p := range providers {
+		out.Providers = append(out.Providers, *p)
+	}
+	sort.Slice(out.Providers, func(i, j int) bool {
+		return out.Providers[i].ID < out.Providers[j].ID
+	})
+
+	for _, e := range edges {
+		out.Edges = append(out.Edges, *e)
+	}
+	sort.Slice(out.Edges, func(i, j int) bool {
+		a, b := out.Edges[i], out.Edges[j]
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		if a.To != b.To {
+			return a.To < b.To
+		}
+		return a.Input < b.Input
+	})
+	return out, nil
+}
+
+// union returns the sorted union of a and b without duplicates.
+func union(a, b []string) []string {
+	seen := make(map[string]struct{}, len(a)+len(b))
+	var out []string
+	for _, s := range append(append([]string(nil), a...), b...) {
+		if _, ok := seen[s]; !ok {
+			seen[s] = struct{}{}
+			out = append(out, s)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Encode writes the graph to w as JSON.
+func (g *Graph) Encode(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(g)
+}
+
+// Decode reads a graph written by Encode.
+func Decode(r io.Reader) (*Graph, error) {
+	var g Graph
+	if err := json.NewDecoder(r).Decode(&g); err != nil {
+		return nil, fmt.Errorf("decode graph: %w", err)
+	}
+	if g.Version != Version {
+		return nil, fmt.Errorf("decode graph: unsupported version %d", g.Version)
+	}
+	return &g, nil
+}
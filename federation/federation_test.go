@@ -0,0 +1,128 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package federation_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/federation"
+)
+
+type (
+	config struct{}
+	db     struct{}
+	cache  struct{}
+)
+
+func newConfig() *config          { return &config{} }
+func newDB(*config) *db           { return &db{} }
+func newCache(*config) *cache     { return &cache{} }
+func newAuditDB(*config) *db      { return &db{} }
+func newCacheFromDB(*db) *cache   { return &cache{} }
+func newService(*db, *cache) bool { return true }
+
+func TestFederation(t *testing.T) {
+	t.Parallel()
+
+	billing := dig.New()
+	require.NoError(t, billing.Use(dig.NewModule("storage").Provide(newConfig).Provide(newDB)))
+	require.NoError(t, billing.Provide(newCache))
+
+	search := dig.New()
+	require.NoError(t, search.Provide(newConfig))
+	require.NoError(t, search.Provide(newDB))
+	require.NoError(t, search.Provide(newCache))
+
+	b := federation.Export("billing", billing)
+	assert.Equal(t, []string{"billing"}, b.Services)
+	require.Len(t, b.Providers, 3)
+	assert.Equal(t, "go.uber.org/dig/federation_test.newConfig", b.Providers[1].ID)
+	assert.Equal(t, "storage", b.Providers[1].Module)
+	assert.Equal(t, []string{"*federation_test.config"}, b.Providers[1].Outputs)
+
+	var buf bytes.Buffer
+	require.NoError(t, b.Encode(&buf))
+	decoded, err := federation.Decode(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, b, decoded)
+
+	merged, err := federation.Merge(decoded, federation.Export("search", search))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"billing", "search"}, merged.Services)
+	require.Len(t, merged.Providers, 3)
+	for _, p := range merged.Providers {
+		assert.Equal(t, []string{"billing", "search"}, p.Services, p.ID)
+	}
+	require.Len(t, merged.Edges, 2)
+	assert.Equal(t, federation.Edge{
+		From:     "go.uber.org/dig/federation_test.newCache",
+		To:       "go.uber.org/dig/federation_test.newConfig",
+		Input:    "*federation_test.config",
+		Services: []string{"billing", "search"},
+	}, merged.Edges[0])
+}
+
+func TestFederationDistinctProviders(t *testing.T) {
+	t.Parallel()
+
+	a := dig.New()
+	require.NoError(t, a.Provide(newConfig))
+	require.NoError(t, a.Provide(newDB))
+	require.NoError(t, a.Provide(newCacheFromDB))
+	require.NoError(t, a.Provide(newService))
+
+	b := dig.New()
+	require.NoError(t, b.Provide(newConfig))
+	require.NoError(t, b.Provide(newAuditDB))
+
+	merged, err := federation.Merge(federation.Export("a", a), federation.Export("b", b))
+	require.NoError(t, err)
+	require.Len(t, merged.Providers, 5)
+
+	services := make(map[string][]string)
+	for _, p := range merged.Providers {
+		services[p.ID[strings.LastIndex(p.ID, ".")+1:]] = p.Services
+	}
+	assert.Equal(t, map[string][]string{
+		"newConfig":      {"a", "b"},
+		"newDB":          {"a"},
+		"newAuditDB":     {"b"},
+		"newCacheFromDB": {"a"},
+		"newService":     {"a"},
+	}, services)
+}
+
+func TestFederationVersion(t *testing.T) {
+	t.Parallel()
+
+	_, err := federation.Decode(strings.NewReader(`{"version": 2}`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported version 2")
+
+	_, err = federation.Merge(&federation.Graph{Version: 0})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported graph version 0")
+}
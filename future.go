@@ -0,0 +1,128 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Future is a value of type T that's being produced in the background.
+//
+// Constructors may return a *Future[T] instead of a T to initialize the
+// value concurrently with the rest of the application. The container
+// provides T, and waits for the Future only when T is first needed.
+//
+//	c.Provide(func(cfg *Config) *dig.Future[*sql.DB] {
+//	  return dig.Go(func() (*sql.DB, error) {
+//	    return openPool(cfg.DSN)
+//	  })
+//	}, dig.Eager())
+//
+// If the Future fails, everything that depends on T fails with its error.
+// Futures returned by decorators, or through dig.Out structs, are treated
+// as ordinary values.
+type Future[T any] struct {
+	done  chan struct{}
+	value T
+	err   error
+}
+
+// Go calls the given function in a new goroutine and returns a Future for
+// its result. A panic in the function fails the Future.
+func Go[T any](fn func() (T, error)) *Future[T] {
+	f := &Future[T]{done: make(chan struct{})}
+	go func() {
+		defer close(f.done)
+		defer func() {
+			if p := recover(); p != nil {
+				f.err = fmt.Errorf("panic: %v", p)
+			}
+		}()
+		f.value, f.err = fn()
+	}()
+	return f
+}
+
+// Await blocks until the value is available and returns it, or the error
+// that the Future failed with.
+func (f *Future[T]) Await() (T, error) {
+	<-f.done
+	return f.value, f.err
+}
+
+// Done returns a channel that's closed once the value is available or the
+// Future has failed.
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+func (*Future[T]) valueType() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+func (f *Future[T]) await() (reflect.Value, error) {
+	if f == nil {
+		var zero T
+		return reflect.ValueOf(&zero).Elem(), nil
+	}
+	v, err := f.Await()
+	return reflect.ValueOf(&v).Elem(), err
+}
+
+// future is implemented by all instantiations of *Future.
+type future interface {
+	valueType() reflect.Type
+	await() (reflect.Value, error)
+}
+
+var _futureType = reflect.TypeOf((*future)(nil)).Elem()
+
+// isFuture reports whether the given type is a *Future.
+func isFuture(t reflect.Type) bool {
+	return t.Kind() == reflect.Ptr && t.Implements(_futureType)
+}
+
+// futureValueType returns the type of the values produced by Futures of
+// the given type, which must be a *Future.
+func futureValueType(t reflect.Type) reflect.Type {
+	return reflect.Zero(t).Interface().(future).valueType()
+}
+
+// awaitValue waits for the given value retrieved for a key if it's a
+// Future for that key, and caches the result in its place in the given
+// store. Other values are returned as-is.
+func awaitValue(store containerWriter, k key, v reflect.Value) (reflect.Value, error) {
+	if !v.IsValid() || k.t.Implements(_futureType) {
+		return v, nil
+	}
+	f, ok := v.Interface().(future)
+	if !ok {
+		return v, nil
+	}
+
+	av, err := f.await()
+	if err != nil {
+		return _noValue, err
+	}
+	store.setValue(k.name, k.t, av)
+	return av, nil
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestFuture(t *testing.T) {
+	t.Parallel()
+
+	type DB struct{ Name string }
+
+	t.Run("awaited when needed", func(t *testing.T) {
+		c := digtest.New(t)
+
+		release := make(chan struct{})
+		var started bool
+		c.RequireProvide(func() *dig.Future[*DB] {
+			return dig.Go(func() (*DB, error) {
+				<-release
+				return &DB{Name: "primary"}, nil
+			})
+		}, dig.Eager())
+		c.RequireProvide(func() string { return "other" })
+
+		require.NoError(t, c.Build())
+		c.RequireInvoke(func(s string) { started = true })
+		assert.True(t, started, "unrelated values must not wait for the future")
+
+		close(release)
+		c.RequireInvoke(func(db *DB) {
+			assert.Equal(t, "primary", db.Name)
+		})
+
+		c.RequireProvide(func(db *DB) int { return len(db.Name) })
+		c.RequireInvoke(func(n int) { assert.Equal(t, 7, n) })
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *dig.Future[*DB] {
+			return dig.Go(func() (*DB, error) {
+				return nil, errors.New("great sadness")
+			})
+		})
+
+		err := c.Invoke(func(*DB) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "great sadness")
+		assert.Contains(t, err.Error(), "failed to build *dig_test.DB")
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *dig.Future[*DB] {
+			return dig.Go(func() (*DB, error) {
+				panic("great sadness")
+			})
+		})
+
+		err := c.Invoke(func(*DB) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "panic: great sadness")
+	})
+
+	t.Run("named and As", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *dig.Future[*strings.Reader] {
+			return dig.Go(func() (*strings.Reader, error) {
+				return strings.NewReader("hello"), nil
+			})
+		}, dig.Name("r"), dig.As(new(io.Reader)))
+
+		type params struct {
+			dig.In
+
+			R io.Reader `name:"r"`
+		}
+		c.RequireInvoke(func(p params) {
+			b, err := io.ReadAll(p.R)
+			require.NoError(t, err)
+			assert.Equal(t, "hello", string(b))
+		})
+	})
+
+	t.Run("transient", func(t *testing.T) {
+		c := digtest.New(t)
+		var calls int
+		c.RequireProvide(func() *dig.Future[int] {
+			calls++
+			n := calls
+			return dig.Go(func() (int, error) { return n, nil })
+		}, dig.Transient())
+
+		c.RequireInvoke(func(n int) { assert.Equal(t, 1, n) })
+		c.RequireInvoke(func(n int) { assert.Equal(t, 2, n) })
+	})
+
+	t.Run("groups are rejected", func(t *testing.T) {
+		c := digtest.New(t)
+		err := c.Provide(func() *dig.Future[int] { return nil }, dig.Group("ints"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "futures cannot be grouped")
+	})
+
+	t.Run("Await", func(t *testing.T) {
+		f := dig.Go(func() (string, error) { return "hello", nil })
+		<-f.Done()
+		v, err := f.Await()
+		require.NoError(t, err)
+		assert.Equal(t, "hello", v)
+	})
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// GraphJSON writes the dependency graph of the container and all of its
+// Scopes to w as JSON, so that tools can analyze or render it without
+// parsing the output of Visualize.
+//
+//	{
+//	  "nodes": [
+//	    {"id": 1, "kind": "constructor", "scope": "", "function": {...}, "results": [{"type": "*main.Server"}]},
+//	    {"id": 2, "kind": "constructor", "scope": "", "function": {...}, "results": [{"type": "http.Handler", "group": "routes"}]},
+//	    {"id": 3, "kind": "group", "scope": "", "key": {"type": "http.Handler", "group": "routes"}}
+//	  ],
+//	  "edges": [
+//	    {"from": 1, "to": 3, "key": {"type": "http.Handler", "group": "routes"}},
+//	    {"from": 3, "to": 2, "key": {"type": "http.Handler", "group": "routes"}}
+//	  ]
+//	}
+//
+// The nodes and edges are those of the Graph returned by Container.Graph.
+func (c *Container) GraphJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(newJSONGraph(c.Graph()))
+}
+
+type jsonGraph struct {
+	Nodes []jsonGraphNode `json:"nodes"`
+	Edges []jsonGraphEdge `json:"edges"`
+}
+
+type jsonGraphNode struct {
+	ID    int    `json:"id"`
+	Kind  string `json:"kind"`
+	Scope string `json:"scope"`
+
+	// Set for constructors.
+	Function *jsonLocation `json:"function,omitempty"`
+	Results  []jsonKey     `json:"results,omitempty"`
+
+	// Set for value groups.
+	Key *jsonKey `json:"key,omitempty"`
+}
+
+type jsonGraphEdge struct {
+	From     int     `json:"from"`
+	To       int     `json:"to"`
+	Key      jsonKey `json:"key"`
+	Optional bool    `json:"optional,omitempty"`
+}
+
+func newJSONGraph(g *Graph) *jsonGraph {
+	jg := &jsonGraph{
+		Nodes: make([]jsonGraphNode, len(g.Nodes)),
+		Edges: make([]jsonGraphEdge, len(g.Edges)),
+	}
+	for i, n := range g.Nodes {
+		jn := jsonGraphNode{ID: n.ID, Kind: n.Kind.String(), Scope: n.Scope}
+		switch n.Kind {
+		case GraphConstructorNode:
+			l := n.Location
+			jn.Function = &jsonLocation{Name: l.Name, Package: l.Package, File: l.File, Line: l.Line}
+			for _, k := range n.Produces {
+				jn.Results = append(jn.Results, newJSONGraphKey(k))
+			}
+		case GraphGroupNode:
+			jk := newJSONGraphKey(n.Key)
+			jn.Key = &jk
+		}
+		jg.Nodes[i] = jn
+	}
+	for i, e := range g.Edges {
+		jg.Edges[i] = jsonGraphEdge{
+			From:     e.From,
+			To:       e.To,
+			Key:      newJSONGraphKey(e.Key),
+			Optional: e.Optional,
+		}
+	}
+	return jg
+}
+
+func newJSONGraphKey(k GraphKey) jsonKey {
+	return newJSONKey(key{t: k.Type, name: k.Name, group: k.Group})
+}
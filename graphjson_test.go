@@ -0,0 +1,148 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestGraphJSON(t *testing.T) {
+	t.Parallel()
+
+	type node struct {
+		ID       int
+		Kind     string
+		Scope    string
+		Function *struct{ Name, Package, File string }
+		Results  []struct{ Type, Name, Group string }
+		Key      *struct{ Type, Name, Group string }
+	}
+	type edge struct {
+		From, To int
+		Key      struct{ Type, Name, Group string }
+		Optional bool
+	}
+	type graph struct {
+		Nodes []node
+		Edges []edge
+	}
+	decode := func(t *testing.T, c *digtest.Container) graph {
+		var buf bytes.Buffer
+		require.NoError(t, c.GraphJSON(&buf))
+		var g graph
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &g))
+		return g
+	}
+
+	type A struct{}
+	type B struct{}
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		require.NoError(t, digtest.New(t).GraphJSON(&buf))
+		assert.JSONEq(t, `{"nodes": [], "edges": []}`, buf.String())
+	})
+
+	t.Run("constructors and edges", func(t *testing.T) {
+		t.Parallel()
+
+		type params struct {
+			dig.In
+
+			A *A       `name:"a"`
+			B *B       `optional:"true"`
+			S []string `group:"strs"`
+		}
+
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{} }, dig.Name("a"))
+		c.RequireProvide(func() *B { return &B{} })
+		c.RequireProvide(func() string { return "x" }, dig.Group("strs"))
+		c.RequireProvide(func(params) int { return 0 })
+
+		g := decode(t, c)
+		require.Len(t, g.Nodes, 5)
+
+		ctor := g.Nodes[3]
+		assert.Equal(t, 4, ctor.ID)
+		assert.Equal(t, "constructor", ctor.Kind)
+		require.NotNil(t, ctor.Function)
+		assert.Equal(t, "go.uber.org/dig_test", ctor.Function.Package)
+		assert.Contains(t, ctor.Function.File, "graphjson_test.go")
+		require.Len(t, ctor.Results, 1)
+		assert.Equal(t, "int", ctor.Results[0].Type)
+
+		assert.Equal(t, "a", g.Nodes[0].Results[0].Name)
+		assert.Equal(t, "strs", g.Nodes[2].Results[0].Group)
+
+		group := g.Nodes[4]
+		assert.Equal(t, "group", group.Kind)
+		require.NotNil(t, group.Key)
+		assert.Equal(t, "string", group.Key.Type)
+		assert.Equal(t, "strs", group.Key.Group)
+
+		assert.Len(t, g.Edges, 4)
+		assert.Contains(t, g.Edges, edge{
+			From: 4, To: 1,
+			Key: struct{ Type, Name, Group string }{Type: "*dig_test.A", Name: "a"},
+		})
+		assert.Contains(t, g.Edges, edge{
+			From: 4, To: 2,
+			Key:      struct{ Type, Name, Group string }{Type: "*dig_test.B"},
+			Optional: true,
+		})
+		assert.Contains(t, g.Edges, edge{
+			From: 4, To: 5,
+			Key: struct{ Type, Name, Group string }{Type: "string", Group: "strs"},
+		})
+		assert.Contains(t, g.Edges, edge{
+			From: 5, To: 3,
+			Key: struct{ Type, Name, Group string }{Type: "string", Group: "strs"},
+		})
+	})
+
+	t.Run("scopes", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{} })
+		child := c.Scope("child")
+		require.NoError(t, child.Provide(func(*A) *B { return &B{} }))
+
+		g := decode(t, c)
+		require.Len(t, g.Nodes, 2)
+		assert.Equal(t, "", g.Nodes[0].Scope)
+		assert.Equal(t, "child", g.Nodes[1].Scope)
+		assert.Equal(t, []edge{{
+			From: 2, To: 1,
+			Key: struct{ Type, Name, Group string }{Type: "*dig_test.A"},
+		}}, g.Edges)
+	})
+}
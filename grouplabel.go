@@ -0,0 +1,196 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+const _labelsTag = "labels"
+
+// GroupLabels is a ProvideOption that labels the values a constructor adds
+// to a value group, so that consumers of the group may receive only the
+// values with certain labels. It must be used with Group.
+//
+//	c.Provide(NewUsersHandler, dig.Group("handlers"), dig.GroupLabels("admin"))
+//
+// Fields of dig.Out structs specify their labels with a comma-separated
+// labels tag instead.
+//
+//	type Handlers struct {
+//	  dig.Out
+//
+//	  Users Handler `group:"handlers" labels:"admin,internal"`
+//	}
+//
+// Consumers select values by their labels with a labels tag on the field of
+// a dig.In struct that requests the group. Only values that have all of
+// the listed labels are included, and only the constructors providing
+// them are called.
+//
+//	type AdminParams struct {
+//	  dig.In
+//
+//	  Handlers []Handler `group:"handlers" labels:"admin"`
+//	}
+//
+// Values selected by their labels are not decorated by decorators of the
+// group, and the iter.Seq of a selection is never a live view of the
+// group. See GroupView.
+func GroupLabels(labels ...string) ProvideOption {
+	return provideGroupLabelsOption(labels)
+}
+
+type provideGroupLabelsOption []string
+
+func (o provideGroupLabelsOption) String() string {
+	return fmt.Sprintf("GroupLabels(%q)", []string(o))
+}
+
+func (o provideGroupLabelsOption) applyProvideOption(opts *provideOptions) {
+	opts.GroupLabels = append(opts.GroupLabels, o...)
+}
+
+// parseLabels parses the comma-separated labels of a labels tag.
+func parseLabels(tag string) ([]string, error) {
+	if tag == "" {
+		return nil, nil
+	}
+	labels := strings.Split(tag, ",")
+	for _, l := range labels {
+		if l == "" {
+			return nil, newErrInvalidInput(fmt.Sprintf("invalid labels %q: labels cannot be empty", tag), nil)
+		}
+	}
+	return labels, nil
+}
+
+// labeledValue is a value of a value group along with its labels. See
+// GroupLabels.
+type labeledValue struct {
+	Labels []string
+	Value  reflect.Value
+}
+
+// hasLabels reports whether all the given labels are in have.
+func hasLabels(have, want []string) bool {
+	for _, w := range want {
+		found := false
+		for _, h := range have {
+			if h == w {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Scope) submitLabeledValue(group string, t reflect.Type, labels []string, v reflect.Value) {
+	k := key{t: t, group: group}
+	s.labeledValues[k] = append(s.labeledValues[k], labeledValue{Labels: labels, Value: v})
+}
+
+// getLabeledValues returns the values of the given type in the given value
+// group of this Scope that have all of the given labels.
+func (s *Scope) getLabeledValues(group string, t reflect.Type, labels []string) []reflect.Value {
+	var items []reflect.Value
+	for _, lv := range s.labeledValues[key{t: t, group: group}] {
+		if hasLabels(lv.Labels, labels) {
+			items = append(items, lv.Value)
+		}
+	}
+	return items
+}
+
+// groupLabels returns the labels of the values of the given type that the
+// given result adds to the given value group.
+func groupLabels(r result, group string, t reflect.Type) []string {
+	var labels []string
+	switch r := r.(type) {
+	case resultList:
+		for _, r := range r.Results {
+			labels = append(labels, groupLabels(r, group, t)...)
+		}
+	case resultObject:
+		for _, f := range r.Fields {
+			labels = append(labels, groupLabels(f.Result, group, t)...)
+		}
+	case resultGrouped:
+		if r.Group != group {
+			break
+		}
+		if r.Type == t {
+			labels = append(labels, r.Labels...)
+			break
+		}
+		for _, as := range r.As {
+			if as == t {
+				labels = append(labels, r.Labels...)
+				break
+			}
+		}
+	}
+	return labels
+}
+
+// callLabeledProviders calls the constructors in this Scope and its
+// ancestors that add values with the labels requested by the given
+// parameter to its group.
+func (pt paramGroupedSlice) callLabeledProviders(c containerStore) error {
+	for _, c := range c.storesToRoot() {
+		for _, p := range c.getGroupProviders(pt.Group, pt.Type.Elem()) {
+			n, ok := p.(*constructorNode)
+			if !ok || !hasLabels(groupLabels(n.resultList, pt.Group, pt.Type.Elem()), pt.Labels) {
+				continue
+			}
+			if err := n.Call(c); err != nil {
+				return errParamGroupFailed{
+					CtorID: n.ID(),
+					Key:    key{group: pt.Group, t: pt.Type.Elem()},
+					Reason: err,
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// buildLabeled builds the values of the group requested by the given
+// parameter that have the labels it requests.
+func (pt paramGroupedSlice) buildLabeled(c containerStore) (reflect.Value, error) {
+	if !pt.Soft {
+		if err := pt.callLabeledProviders(c); err != nil {
+			return _noValue, err
+		}
+	}
+
+	result := reflect.MakeSlice(pt.Type, 0, 0)
+	for _, c := range c.storesToRoot() {
+		result = reflect.Append(result, c.getLabeledValues(pt.Group, pt.Type.Elem(), pt.Labels)...)
+	}
+	return result, nil
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestGroupLabels(t *testing.T) {
+	t.Parallel()
+
+	type handlers struct {
+		dig.Out
+
+		Users   string `group:"handlers" labels:"admin,internal"`
+		Billing string `group:"handlers" labels:"admin"`
+	}
+
+	newContainer := func(t *testing.T, calls map[string]int) *digtest.Container {
+		c := digtest.New(t)
+		c.RequireProvide(func() handlers {
+			calls["handlers"]++
+			return handlers{Users: "users", Billing: "billing"}
+		})
+		c.RequireProvide(func() string {
+			calls["health"]++
+			return "health"
+		}, dig.Group("handlers"), dig.GroupLabels("internal"))
+		c.RequireProvide(func() string {
+			calls["index"]++
+			return "index"
+		}, dig.Group("handlers"))
+		return c
+	}
+
+	sorted := func(s []string) []string {
+		sort.Strings(s)
+		return s
+	}
+
+	t.Run("String", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, `GroupLabels(["admin" "internal"])`, fmt.Sprint(dig.GroupLabels("admin", "internal")))
+	})
+
+	t.Run("selects values with all labels", func(t *testing.T) {
+		t.Parallel()
+
+		calls := make(map[string]int)
+		c := newContainer(t, calls)
+
+		c.RequireInvoke(func(p struct {
+			dig.In
+
+			Admin    []string `group:"handlers" labels:"admin"`
+			Internal []string `group:"handlers" labels:"internal"`
+			Both     []string `group:"handlers" labels:"admin,internal"`
+		}) {
+			assert.Equal(t, []string{"billing", "users"}, sorted(p.Admin))
+			assert.Equal(t, []string{"health", "users"}, sorted(p.Internal))
+			assert.Equal(t, []string{"users"}, p.Both)
+		})
+		assert.Equal(t, map[string]int{"handlers": 1, "health": 1}, calls,
+			"constructors of values without the labels must not be called")
+
+		c.RequireInvoke(func(p struct {
+			dig.In
+
+			All []string `group:"handlers"`
+		}) {
+			assert.Len(t, p.All, 4)
+		})
+	})
+
+	t.Run("soft", func(t *testing.T) {
+		t.Parallel()
+
+		calls := make(map[string]int)
+		c := newContainer(t, calls)
+
+		type params struct {
+			dig.In
+
+			Internal []string `group:"handlers,soft" labels:"internal"`
+		}
+		c.RequireInvoke(func(p params) {
+			assert.Empty(t, p.Internal)
+		})
+		assert.Empty(t, calls)
+
+		c.RequireInvoke(func(p struct {
+			dig.In
+
+			Admin []string `group:"handlers" labels:"admin"`
+		}) {
+		})
+		c.RequireInvoke(func(p params) {
+			assert.Equal(t, []string{"users"}, p.Internal)
+		})
+	})
+
+	t.Run("child Scope", func(t *testing.T) {
+		t.Parallel()
+
+		c := newContainer(t, make(map[string]int))
+		child := c.Scope("child")
+		require.NoError(t, child.Provide(func() []string {
+			return []string{"metrics", "debug"}
+		}, dig.Group("handlers,flatten"), dig.GroupLabels("internal")))
+
+		require.NoError(t, child.Invoke(func(p struct {
+			dig.In
+
+			Internal []string `group:"handlers" labels:"internal"`
+		}) {
+			assert.Equal(t, []string{"debug", "health", "metrics", "users"}, sorted(p.Internal))
+		}))
+	})
+
+	t.Run("GroupLabels requires Group", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		err := c.Provide(func() string { return "" }, dig.GroupLabels("admin"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `cannot use dig.GroupLabels(["admin"]) without dig.Group`)
+	})
+
+	t.Run("empty labels", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		err := c.Provide(func() string { return "" }, dig.Group("handlers"), dig.GroupLabels(""))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "labels cannot be empty")
+
+		err = c.Invoke(func(struct {
+			dig.In
+
+			Handlers []string `group:"handlers" labels:"admin,"`
+		}) {
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid labels "admin,"`)
+	})
+}
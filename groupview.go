@@ -0,0 +1,125 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// GroupViewMode selects what a consumer of a value group sees when the
+// group changes after the consumer received it. See GroupView.
+type GroupViewMode int
+
+const (
+	// SnapshotGroups gives each consumer of a value group its own copy of
+	// the group's members at the time it was resolved. Members added to
+	// the group later, including from other goroutines with Concurrent,
+	// are not seen by the consumer. This is the default.
+	SnapshotGroups GroupViewMode = iota
+
+	// LiveGroups makes value groups consumed as iter.Seq live views of the
+	// group: each range over the sequence yields the members that have
+	// been produced in the consumer's Scope and its ancestors so far, in
+	// the order they were produced. Groups consumed as slices are still
+	// snapshots.
+	LiveGroups
+)
+
+func (m GroupViewMode) String() string {
+	switch m {
+	case SnapshotGroups:
+		return "SnapshotGroups"
+	case LiveGroups:
+		return "LiveGroups"
+	default:
+		return fmt.Sprintf("GroupViewMode(%d)", int(m))
+	}
+}
+
+// GroupView is an Option that selects whether consumers of value groups
+// see a snapshot of the group or a live view of it.
+//
+//	c := dig.New(dig.GroupView(dig.LiveGroups))
+//
+// A live view doesn't call constructors of the group on its own: members
+// show up as other Invokes cause their constructors to be called. Ranging
+// over a live view is safe while other goroutines use a container built
+// with Concurrent, including from within constructors.
+func GroupView(mode GroupViewMode) Option {
+	return groupViewOption(mode)
+}
+
+type groupViewOption GroupViewMode
+
+func (o groupViewOption) String() string {
+	return fmt.Sprintf("GroupView(%v)", GroupViewMode(o))
+}
+
+func (o groupViewOption) applyOption(c *Container) {
+	c.scope.groupView = GroupViewMode(o)
+}
+
+// liveGroup returns a function that lists the current members of the
+// given group as a slice of type t, or nil unless the container was built
+// with GroupView(LiveGroups).
+func (s *Scope) liveGroup(name string, t reflect.Type) func() reflect.Value {
+	root := s.rootScope()
+	if root.groupView != LiveGroups {
+		return nil
+	}
+
+	scopes := s.ancestors()
+	return func() reflect.Value {
+		root.groupsMu.RLock()
+		defer root.groupsMu.RUnlock()
+
+		for _, s := range scopes {
+			if items, ok := s.decoratedGroups[key{group: name, t: t}]; ok {
+				return copySlice(items)
+			}
+		}
+		result := reflect.MakeSlice(t, 0, 0)
+		for _, s := range scopes {
+			result = reflect.Append(result, s.groups[key{group: name, t: t.Elem()}]...)
+		}
+		return result
+	}
+}
+
+// makeLiveSeq builds an iter.Seq of type t that yields the items listed by
+// the given function each time it's ranged over.
+func makeLiveSeq(t reflect.Type, list func() reflect.Value) reflect.Value {
+	return reflect.MakeFunc(t, func(args []reflect.Value) []reflect.Value {
+		return makeSeq(t, list()).Call(args)
+	})
+}
+
+// copySlice returns a copy of the given slice so that consumers of a value
+// group can't change what other consumers see.
+func copySlice(items reflect.Value) reflect.Value {
+	if items.IsNil() {
+		return items
+	}
+	cp := reflect.MakeSlice(items.Type(), items.Len(), items.Len())
+	reflect.Copy(cp, items)
+	return cp
+}
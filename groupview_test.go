@@ -0,0 +1,151 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build go1.23
+
+package dig_test
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestGroupView(t *testing.T) {
+	t.Parallel()
+
+	type params struct {
+		dig.In
+
+		Slice []int         `group:"nums"`
+		Seq   iter.Seq[int] `group:"nums"`
+	}
+
+	t.Run("String", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "GroupView(SnapshotGroups)", fmt.Sprint(dig.GroupView(dig.SnapshotGroups)))
+		assert.Equal(t, "GroupView(LiveGroups)", fmt.Sprint(dig.GroupView(dig.LiveGroups)))
+		assert.Equal(t, "GroupViewMode(5)", fmt.Sprint(dig.GroupViewMode(5)))
+	})
+
+	t.Run("snapshot is stable while child scopes add members", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.Concurrent())
+		c.RequireProvide(func() int { return 1 }, dig.Group("nums"))
+
+		c.RequireInvoke(func(p params) {
+			var wg sync.WaitGroup
+			for i := 0; i < 4; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+
+					child := c.Scope(fmt.Sprintf("child%d", i))
+					assert.NoError(t, child.Provide(func() int { return 10 + i }, dig.Group("nums")))
+					assert.NoError(t, child.Invoke(func(p params) {
+						assert.Len(t, p.Slice, 2)
+					}))
+				}(i)
+			}
+
+			for i := 0; i < 10; i++ {
+				assert.Equal(t, []int{1}, p.Slice)
+				assert.Equal(t, []int{1}, slices.Collect(p.Seq))
+			}
+			wg.Wait()
+			assert.Equal(t, []int{1}, slices.Collect(p.Seq))
+		})
+	})
+
+	t.Run("consumers get their own copy of decorated groups", func(t *testing.T) {
+		t.Parallel()
+
+		type in struct {
+			dig.In
+
+			Nums []int `group:"nums"`
+		}
+		type out struct {
+			dig.Out
+
+			Nums []int `group:"nums"`
+		}
+
+		c := digtest.New(t)
+		c.RequireProvide(func() int { return 1 }, dig.Group("nums"))
+		c.RequireDecorate(func(p in) out {
+			return out{Nums: append(p.Nums, 2)}
+		})
+
+		c.RequireInvoke(func(p in) {
+			p.Nums[0] = 42
+		})
+		c.RequireInvoke(func(p in) {
+			assert.Equal(t, []int{1, 2}, p.Nums)
+		})
+	})
+
+	t.Run("live view sees members produced later", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.GroupView(dig.LiveGroups))
+		c.RequireProvide(func() int { return 1 }, dig.Group("nums"))
+
+		c.RequireInvoke(func(p params) {
+			assert.Equal(t, []int{1}, slices.Collect(p.Seq))
+
+			require.NoError(t, c.Provide(func() int { return 2 }, dig.Group("nums")))
+			assert.Equal(t, []int{1}, slices.Collect(p.Seq),
+				"constructors must not be called by the view")
+
+			require.NoError(t, c.Invoke(func(params) {}))
+			assert.Equal(t, []int{1, 2}, slices.Collect(p.Seq))
+			assert.Equal(t, []int{1}, p.Slice, "slices must remain snapshots")
+		})
+	})
+
+	t.Run("live view includes members of ancestors", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.GroupView(dig.LiveGroups), dig.Concurrent())
+		c.RequireProvide(func() int { return 1 }, dig.Group("nums"))
+		child := c.Scope("child")
+		require.NoError(t, child.Provide(func() int { return 2 }, dig.Group("nums")))
+
+		var seq iter.Seq[int]
+		require.NoError(t, child.Invoke(func(p params) { seq = p.Seq }))
+		assert.ElementsMatch(t, []int{1, 2}, slices.Collect(seq))
+
+		// Members of sibling scopes aren't part of the view.
+		sibling := c.Scope("sibling")
+		require.NoError(t, sibling.Provide(func() int { return 3 }, dig.Group("nums")))
+		require.NoError(t, sibling.Invoke(func(params) {}))
+		assert.ElementsMatch(t, []int{1, 2}, slices.Collect(seq))
+	})
+}
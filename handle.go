@@ -0,0 +1,118 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import "fmt"
+
+// ProviderHandle controls a constructor after it has been provided to the
+// container. Use the FillProviderHandle option to obtain one.
+//
+// A ProviderHandle may be used to turn optional functionality off and on
+// in a running process. A disabled constructor behaves as if it was never
+// provided: optional dependencies on its values receive zero values, and
+// required dependencies fail with an error naming the disabled
+// constructor. Values it already produced are kept, and are used again
+// once it's re-enabled.
+//
+// Disabling a constructor that contributes to a value group stops it from
+// being called, but values it already contributed remain in the group.
+//
+// A ProviderHandle may be used concurrently with the container if the
+// container is concurrent. The zero ProviderHandle isn't associated with
+// a constructor: Disable and Enable do nothing, and Enabled reports false.
+type ProviderHandle struct {
+	n *constructorNode
+}
+
+// FillProviderHandle is a ProvideOption that writes a handle to the
+// provided constructor into the given ProviderHandle.
+//
+//	var h dig.ProviderHandle
+//	c.Provide(NewMetricsExporter, dig.FillProviderHandle(&h))
+//	// ...
+//	h.Disable()
+func FillProviderHandle(h *ProviderHandle) ProvideOption {
+	return fillProviderHandleOption{h: h}
+}
+
+type fillProviderHandleOption struct{ h *ProviderHandle }
+
+func (o fillProviderHandleOption) String() string {
+	return fmt.Sprintf("FillProviderHandle(%p)", o.h)
+}
+
+func (o fillProviderHandleOption) applyProvideOption(opts *provideOptions) {
+	opts.Handle = o.h
+}
+
+// Disable disables the constructor. Subsequent calls to Invoke treat its
+// values as missing.
+func (h *ProviderHandle) Disable() {
+	if h.n == nil {
+		return
+	}
+	defer h.n.s.lock()()
+
+	if h.n.disabled {
+		return
+	}
+	h.n.disabled = true
+	h.n.s.rootScope().generation++
+}
+
+// Enable re-enables a constructor disabled with Disable.
+func (h *ProviderHandle) Enable() {
+	if h.n == nil {
+		return
+	}
+	defer h.n.s.lock()()
+
+	if !h.n.disabled {
+		return
+	}
+	h.n.disabled = false
+	h.n.s.rootScope().generation++
+
+	// Disabled constructors are ignored by cycle detection, so Provides
+	// made in the meantime may have introduced a cycle through this one.
+	for _, s := range h.n.s.rootScope().appendSubscopes(nil) {
+		s.isVerifiedAcyclic = false
+	}
+}
+
+// Enabled reports whether the constructor is enabled.
+func (h *ProviderHandle) Enabled() bool {
+	if h.n == nil {
+		return false
+	}
+	defer h.n.s.lock()()
+	return !h.n.disabled
+}
+
+// findDisabledProviders returns the disabled providers for the given key
+// that are visible from the given containerStore.
+func findDisabledProviders(c containerStore, k key) []provider {
+	var providers []provider
+	for _, s := range c.storesToRoot() {
+		providers = append(providers, s.getDisabledValueProviders(k.name, k.t)...)
+	}
+	return providers
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestProviderHandle(t *testing.T) {
+	t.Parallel()
+
+	type A struct{}
+
+	t.Run("disabled optional dependency is zero", func(t *testing.T) {
+		c := digtest.New(t)
+
+		var h dig.ProviderHandle
+		c.RequireProvide(func() *A { return &A{} }, dig.FillProviderHandle(&h))
+
+		type params struct {
+			dig.In
+
+			A *A `optional:"true"`
+		}
+
+		c.RequireInvoke(func(p params) { assert.NotNil(t, p.A) })
+
+		h.Disable()
+		assert.False(t, h.Enabled())
+		c.RequireInvoke(func(p params) { assert.Nil(t, p.A) })
+
+		h.Enable()
+		assert.True(t, h.Enabled())
+		c.RequireInvoke(func(p params) { assert.NotNil(t, p.A) })
+	})
+
+	t.Run("disabled required dependency fails", func(t *testing.T) {
+		c := digtest.New(t)
+
+		var h dig.ProviderHandle
+		c.RequireProvide(func() *A { return &A{} }, dig.FillProviderHandle(&h))
+		h.Disable()
+
+		err := c.Invoke(func(*A) {})
+		require.Error(t, err)
+		dig.AssertErrorMatches(t, err,
+			`missing dependencies for function "go.uber.org/dig_test".TestProviderHandle\S+`,
+			`handle_test.go:\d+`,
+			`missing type:`,
+			`\*dig_test.A \(provided by "go.uber.org/dig_test".TestProviderHandle\S+ \(\S+\), which is disabled\)`,
+		)
+	})
+
+	t.Run("disabled in child scope falls back to parent", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() string { return "parent" })
+
+		child := c.Scope("child")
+		var h dig.ProviderHandle
+		child.RequireProvide(func() string { return "child" }, dig.FillProviderHandle(&h))
+
+		child.RequireInvoke(func(s string) { assert.Equal(t, "child", s) })
+		h.Disable()
+		child.RequireInvoke(func(s string) { assert.Equal(t, "parent", s) })
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		c := digtest.New(t)
+
+		var h dig.ProviderHandle
+		c.RequireProvide(func() *A { return &A{} }, dig.FillProviderHandle(&h))
+		h.Disable()
+
+		snap := c.InspectSnapshot()
+		require.Len(t, snap.Providers, 1)
+		assert.True(t, snap.Providers[0].Disabled)
+	})
+
+	t.Run("zero handle", func(t *testing.T) {
+		var h dig.ProviderHandle
+		h.Disable()
+		h.Enable()
+		assert.False(t, h.Enabled())
+	})
+
+	t.Run("concurrent toggles", func(t *testing.T) {
+		c := digtest.New(t, dig.Concurrent())
+
+		var h dig.ProviderHandle
+		c.RequireProvide(func() *A { return &A{} }, dig.FillProviderHandle(&h))
+
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				h.Disable()
+				h.Enable()
+				h.Enabled()
+			}()
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, c.Invoke(func(struct {
+					dig.In
+
+					A *A `optional:"true"`
+				}) {
+				}))
+			}()
+		}
+		wg.Wait()
+		assert.True(t, h.Enabled())
+	})
+
+	t.Run("String", func(t *testing.T) {
+		var h dig.ProviderHandle
+		assert.Equal(t,
+			fmt.Sprintf("FillProviderHandle(%p)", &h),
+			fmt.Sprint(dig.FillProviderHandle(&h)))
+	})
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// ConstructorCall describes a call to a constructor reported to the hooks
+// registered with CallHooks.
+type ConstructorCall struct {
+	// Location where the constructor was defined.
+	Location Location
+
+	// Names of the Scopes from the root to the Scope that the constructor
+	// was provided to, separated by "/". Unnamed Scopes are omitted.
+	Scope string
+
+	// Trace ID of the innermost Invoke that the constructor is called for,
+	// if it was given one with TraceID or ContextWithTraceID.
+	TraceID string
+
+	// Arguments that the constructor is called with. Parameter objects
+	// are reported as a single argument.
+	Inputs []interface{}
+
+	// Time the constructor took to run, and the error that it returned, if
+	// any. Only set for hooks called after the constructor.
+	Duration time.Duration
+	Err      error
+}
+
+// CallHooks is an Option that registers functions called right before and
+// right after each call to a constructor, once its arguments have been
+// built. Either function may be nil.
+//
+//	c := dig.New(dig.CallHooks(nil, func(call dig.ConstructorCall) {
+//	  log.Printf("%v took %v: %v", call.Location, call.Duration, call.Err)
+//	}))
+//
+// Hooks registered by multiple CallHooks options are called in the order
+// in which they were given. Hooks are called while the container resolves
+// dependencies, so they must not use the container. Constructors whose
+// values are restored with Persist are not called, and neither are the
+// hooks.
+func CallHooks(before, after func(ConstructorCall)) Option {
+	return callHooksOption{before: before, after: after}
+}
+
+type callHooksOption struct {
+	before, after func(ConstructorCall)
+}
+
+func (o callHooksOption) String() string {
+	return fmt.Sprintf("CallHooks(%v, %v)", funcName(o.before), funcName(o.after))
+}
+
+func (o callHooksOption) applyOption(c *Container) {
+	c.scope.callHooks = append(c.scope.callHooks, o)
+}
+
+// funcName reports a function given to an option, or nil.
+func funcName(f func(ConstructorCall)) string {
+	if f == nil {
+		return "nil"
+	}
+	return fmt.Sprintf("%p", f)
+}
+
+// callBefore reports a call to the constructor n with the given arguments
+// to the hooks registered with CallHooks.
+func (s *Scope) callBefore(n *constructorNode, args []reflect.Value) ConstructorCall {
+	hooks := s.rootScope().callHooks
+	if len(hooks) == 0 {
+		return ConstructorCall{}
+	}
+
+	call := ConstructorCall{
+		Location: newLocation(n.location),
+		Scope:    n.s.path(),
+		TraceID:  s.resolutionPath().traceID(),
+		Inputs:   make([]interface{}, len(args)),
+	}
+	for i, arg := range args {
+		call.Inputs[i] = arg.Interface()
+	}
+	for _, h := range hooks {
+		if h.before != nil {
+			h.before(call)
+		}
+	}
+	return call
+}
+
+// callAfter reports the outcome of a call reported with callBefore.
+func (s *Scope) callAfter(call ConstructorCall, d time.Duration, err error) {
+	call.Duration = d
+	call.Err = err
+	for _, h := range s.rootScope().callHooks {
+		if h.after != nil {
+			h.after(call)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestCallHooks(t *testing.T) {
+	t.Parallel()
+
+	type A struct{ n int }
+	type B struct{}
+
+	t.Run("before and after", func(t *testing.T) {
+		t.Parallel()
+
+		var events []string
+		var calls []dig.ConstructorCall
+		c := digtest.New(t, dig.CallHooks(
+			func(call dig.ConstructorCall) {
+				events = append(events, "before "+call.Location.Name)
+				assert.Zero(t, call.Duration)
+			},
+			func(call dig.ConstructorCall) {
+				events = append(events, "after "+call.Location.Name)
+				calls = append(calls, call)
+			},
+		))
+
+		c.RequireProvide(func() *A {
+			events = append(events, "call A")
+			return &A{n: 42}
+		})
+		c.RequireProvide(func(*A) (*B, error) {
+			events = append(events, "call B")
+			return nil, errors.New("great sadness")
+		})
+		require.Error(t, c.Invoke(func(*B) {}, dig.TraceID("trace-1")))
+
+		require.Len(t, calls, 2)
+		provideA, provideB := calls[0].Location.Name, calls[1].Location.Name
+		assert.Equal(t, []string{
+			"before " + provideA, "call A", "after " + provideA,
+			"before " + provideB, "call B", "after " + provideB,
+		}, events)
+
+		assert.Equal(t, "go.uber.org/dig_test", calls[0].Location.Package)
+		assert.Contains(t, calls[0].Location.File, "hooks_test.go")
+		assert.Empty(t, calls[0].Inputs)
+		assert.NoError(t, calls[0].Err)
+		assert.Equal(t, "trace-1", calls[0].TraceID)
+
+		assert.Equal(t, []interface{}{&A{n: 42}}, calls[1].Inputs)
+		assert.EqualError(t, calls[1].Err, "great sadness")
+	})
+
+	t.Run("scopes and multiple hooks", func(t *testing.T) {
+		t.Parallel()
+
+		var events []string
+		hook := func(name string) func(dig.ConstructorCall) {
+			return func(call dig.ConstructorCall) {
+				events = append(events, name+" "+call.Scope)
+			}
+		}
+		c := digtest.New(t,
+			dig.CallHooks(nil, hook("first")),
+			dig.CallHooks(nil, hook("second")),
+		)
+		child := c.Scope("child")
+		require.NoError(t, child.Provide(func() *A { return &A{} }))
+		require.NoError(t, child.Invoke(func(*A) {}))
+
+		assert.Equal(t, []string{"first child", "second child"}, events)
+	})
+
+	t.Run("not called for invoked functions", func(t *testing.T) {
+		t.Parallel()
+
+		called := false
+		hook := func(dig.ConstructorCall) { called = true }
+		c := digtest.New(t, dig.CallHooks(hook, hook))
+		c.RequireInvoke(func() {})
+		assert.False(t, called)
+	})
+
+	t.Run("String", func(t *testing.T) {
+		t.Parallel()
+
+		hook := func(dig.ConstructorCall) {}
+		assert.Equal(t,
+			fmt.Sprintf("CallHooks(nil, %p)", hook),
+			fmt.Sprint(dig.CallHooks(nil, hook)))
+	})
+}
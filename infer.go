@@ -0,0 +1,90 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import "reflect"
+
+// InferInterfaces is an Option that lets the container satisfy a
+// dependency on an interface that has no provider with the only value
+// provided to the container that implements it, without the need for
+// dig.As or a separate binding constructor.
+//
+//	c := dig.New(dig.InferInterfaces())
+//	c.Provide(NewFileStore)             // returns *FileStore
+//	c.Invoke(func(s Store) { /* ... */ }) // Store is satisfied by *FileStore
+//
+// If more than one value implements the interface, it's reported as
+// missing as usual. Values with different names, and value groups, are
+// never considered. Inferences are listed in Snapshot.Inferred.
+func InferInterfaces() Option {
+	return inferInterfacesOption{}
+}
+
+type inferInterfacesOption struct{}
+
+func (inferInterfacesOption) String() string {
+	return "InferInterfaces()"
+}
+
+func (inferInterfacesOption) applyOption(c *Container) {
+	c.scope.inferInterfaces = true
+}
+
+// InferredBinding describes an interface satisfied through
+// InferInterfaces.
+type InferredBinding struct {
+	// Interface that was requested.
+	Interface *Output
+
+	// Implementation is the value used to satisfy it.
+	Implementation *Output
+}
+
+func (s *Scope) inferredKey(name string, t reflect.Type) (key, bool) {
+	root := s.rootScope()
+	if !root.inferInterfaces || t.Kind() != reflect.Interface {
+		return key{}, false
+	}
+
+	var candidates []key
+	seen := make(map[key]struct{})
+	for _, scope := range s.ancestors() {
+		for k := range scope.providers {
+			if k.group != "" || k.name != name || k.t == t || !k.t.Implements(t) {
+				continue
+			}
+			if _, ok := seen[k]; ok || len(scope.getProviders(k)) == 0 {
+				continue // already seen, or all providers are disabled
+			}
+			seen[k] = struct{}{}
+			candidates = append(candidates, k)
+		}
+	}
+	if len(candidates) != 1 {
+		return key{}, false
+	}
+
+	if root.inferred == nil {
+		root.inferred = make(map[key]key)
+	}
+	root.inferred[key{name: name, t: t}] = candidates[0]
+	return candidates[0], true
+}
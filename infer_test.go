@@ -0,0 +1,127 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+type inferStore interface{ Get() string }
+
+type fileStore struct{ path string }
+
+func (s *fileStore) Get() string { return s.path }
+
+type memStore struct{}
+
+func (*memStore) Get() string { return "mem" }
+
+func TestInferInterfaces(t *testing.T) {
+	t.Parallel()
+
+	t.Run("single implementation", func(t *testing.T) {
+		c := digtest.New(t, dig.InferInterfaces())
+
+		var calls int
+		c.RequireProvide(func() *fileStore {
+			calls++
+			return &fileStore{path: "/tmp"}
+		})
+
+		c.RequireInvoke(func(s inferStore, fs *fileStore) {
+			assert.Equal(t, "/tmp", s.Get())
+			assert.Same(t, fs, s)
+		})
+		assert.Equal(t, 1, calls)
+
+		snap := c.InspectSnapshot()
+		require.Len(t, snap.Inferred, 1)
+		assert.Equal(t, "dig_test.inferStore", snap.Inferred[0].Interface.String())
+		assert.Equal(t, "*dig_test.fileStore", snap.Inferred[0].Implementation.String())
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *fileStore { return &fileStore{} })
+
+		err := c.Invoke(func(inferStore) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing type: dig_test.inferStore")
+	})
+
+	t.Run("ambiguous", func(t *testing.T) {
+		c := digtest.New(t, dig.InferInterfaces())
+		c.RequireProvide(func() *fileStore { return &fileStore{} })
+		c.RequireProvide(func() *memStore { return &memStore{} })
+
+		err := c.Invoke(func(inferStore) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing type: dig_test.inferStore")
+	})
+
+	t.Run("explicit provider wins", func(t *testing.T) {
+		c := digtest.New(t, dig.InferInterfaces())
+		c.RequireProvide(func() *fileStore { return &fileStore{} })
+		c.RequireProvide(func() inferStore { return &memStore{} })
+
+		c.RequireInvoke(func(s inferStore) {
+			assert.Equal(t, "mem", s.Get())
+		})
+		assert.Empty(t, c.InspectSnapshot().Inferred)
+	})
+
+	t.Run("names must match", func(t *testing.T) {
+		c := digtest.New(t, dig.InferInterfaces())
+		c.RequireProvide(func() *fileStore { return &fileStore{path: "a"} }, dig.Name("a"))
+
+		type params struct {
+			dig.In
+
+			Store inferStore `name:"a"`
+		}
+		c.RequireInvoke(func(p params) {
+			assert.Equal(t, "a", p.Store.Get())
+		})
+		assert.Error(t, c.Invoke(func(inferStore) {}))
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		type A struct{}
+		c := digtest.New(t, dig.InferInterfaces())
+		c.RequireProvide(func(io.Reader) *A { return &A{} })
+		c.RequireProvide(func(*A) *bytesReader { return &bytesReader{} })
+
+		err := c.Invoke(func(*A) {})
+		require.Error(t, err)
+		assert.Contains(t, fmt.Sprint(err), "cycle detected in dependency graph: io.Reader inferred from *dig_test.bytesReader")
+	})
+}
+
+type bytesReader struct{}
+
+func (*bytesReader) Read([]byte) (int, error) { return 0, io.EOF }
@@ -25,15 +25,19 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"time"
 )
 
 var (
-	_noValue    reflect.Value
-	_errType    = reflect.TypeOf((*error)(nil)).Elem()
-	_inPtrType  = reflect.TypeOf((*In)(nil))
-	_inType     = reflect.TypeOf(In{})
-	_outPtrType = reflect.TypeOf((*Out)(nil))
-	_outType    = reflect.TypeOf(Out{})
+	_noValue     reflect.Value
+	_errType     = reflect.TypeOf((*error)(nil)).Elem()
+	_cleanupType = reflect.TypeOf(func() {})
+	_inPtrType   = reflect.TypeOf((*In)(nil))
+	_inType      = reflect.TypeOf(In{})
+	_outPtrType  = reflect.TypeOf((*Out)(nil))
+	_outType     = reflect.TypeOf(Out{})
+
+	_durationType = reflect.TypeOf(time.Duration(0))
 )
 
 // Placeholder type placed in dig.In/dig.out to make their special nature
@@ -173,3 +177,51 @@ func isFieldOptional(f reflect.StructField) (bool, error) {
 
 	return optional, err
 }
+
+// fieldDefault parses the value of the default tag on the given optional
+// field, if any. Only strings, booleans, numbers, and time.Duration values
+// may have defaults.
+//
+// The tag is ignored on fields that aren't optional, as it was before dig
+// supported it, so that structs using the tag for other purposes still work.
+func fieldDefault(f reflect.StructField, optional bool) (reflect.Value, error) {
+	tag, ok := f.Tag.Lookup(_defaultTag)
+	if !ok || !optional {
+		return _noValue, nil
+	}
+
+	v := reflect.New(f.Type).Elem()
+	var err error
+	switch k := f.Type.Kind(); {
+	case f.Type == _durationType:
+		var d time.Duration
+		d, err = time.ParseDuration(tag)
+		v.SetInt(int64(d))
+	case k == reflect.String:
+		v.SetString(tag)
+	case k == reflect.Bool:
+		var b bool
+		b, err = strconv.ParseBool(tag)
+		v.SetBool(b)
+	case k >= reflect.Int && k <= reflect.Int64:
+		var i int64
+		i, err = strconv.ParseInt(tag, 0, f.Type.Bits())
+		v.SetInt(i)
+	case k >= reflect.Uint && k <= reflect.Uintptr:
+		var u uint64
+		u, err = strconv.ParseUint(tag, 0, f.Type.Bits())
+		v.SetUint(u)
+	case k == reflect.Float32 || k == reflect.Float64:
+		var x float64
+		x, err = strconv.ParseFloat(tag, f.Type.Bits())
+		v.SetFloat(x)
+	default:
+		return _noValue, newErrInvalidInput(fmt.Sprintf(
+			"%q tag on field %v: default values are not supported for %v", _defaultTag, f.Name, f.Type), nil)
+	}
+	if err != nil {
+		return _noValue, newErrInvalidInput(fmt.Sprintf(
+			"invalid value %q for %q tag on field %v", tag, _defaultTag, f.Name), err)
+	}
+	return v, nil
+}
@@ -86,6 +86,18 @@ func InspectFuncPC(pc uintptr) *Func {
 	}
 }
 
+// InspectFrame returns runtime information about the function call
+// described by the given stack frame.
+func InspectFrame(frame runtime.Frame) *Func {
+	pkgName, funcName := splitFuncName(frame.Function)
+	return &Func{
+		Name:    funcName,
+		Package: pkgName,
+		File:    frame.File,
+		Line:    frame.Line,
+	}
+}
+
 const _vendor = "/vendor/"
 
 func splitFuncName(function string) (pname string, fname string) {
@@ -22,6 +22,7 @@ package digreflect
 
 import (
 	"fmt"
+	"runtime"
 	"strings"
 	"testing"
 
@@ -127,6 +128,17 @@ func TestInspectFunc(t *testing.T) {
 	}
 }
 
+func TestInspectFrame(t *testing.T) {
+	pcs := make([]uintptr, 1)
+	frame, _ := runtime.CallersFrames(pcs[:runtime.Callers(1, pcs)]).Next()
+
+	f := InspectFrame(frame)
+	assert.Equal(t, "go.uber.org/dig/internal/digreflect", f.Package)
+	assert.Equal(t, "TestInspectFrame", f.Name)
+	assert.True(t, strings.HasSuffix(f.File, "func_test.go"), "file %q", f.File)
+	assert.Equal(t, frame.Line, f.Line)
+}
+
 func TestSplitFunc(t *testing.T) {
 	t.Run("empty string", func(t *testing.T) {
 		pname, fname := splitFuncName("")
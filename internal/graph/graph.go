@@ -37,7 +37,8 @@ type Graph interface {
 // IsAcyclic uses depth-first search to find cycles
 // in a generic graph represented by Graph interface.
 // If a cycle is found, it returns a list of nodes that
-// are in the cyclic path, identified by their orders.
+// are in the cyclic path, identified by their orders,
+// with the first node repeated at the end.
 func IsAcyclic(g Graph) (bool, []int) {
 	// cycleStart is a node that introduces a cycle in
 	// the graph. Values in the range [1, g.Order()) mean
@@ -97,6 +98,49 @@ func isAcyclic(g Graph, u int, info cycleInfo, path []int) []int {
 	return nil
 }
 
+// ShortestCycle looks for a cycle shorter than the given one, as returned
+// by IsAcyclic, by running a breadth-first search from each of its nodes
+// back to itself. Depth-first search reports cycles in discovery order,
+// which can take a long detour before it returns to the start.
+func ShortestCycle(g Graph, cycle []int) []int {
+	best := cycle
+	for _, u := range cycle[:len(cycle)-1] {
+		if c := shortestCycleFrom(g, u); len(c) > 0 && len(c) < len(best) {
+			best = c
+		}
+	}
+	return best
+}
+
+// shortestCycleFrom returns the shortest path from u back to itself,
+// or nil if there isn't one.
+func shortestCycleFrom(g Graph, u int) []int {
+	parent := make(map[int]int)
+	queue := []int{u}
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		for _, w := range g.EdgesFrom(v) {
+			if w == u {
+				path := []int{u}
+				for n := v; n != u; n = parent[n] {
+					path = append(path, n)
+				}
+				// The path was built backwards from v.
+				for i, j := 1, len(path)-1; i < j; i, j = i+1, j-1 {
+					path[i], path[j] = path[j], path[i]
+				}
+				return append(path, u)
+			}
+			if _, ok := parent[w]; !ok {
+				parent[w] = v
+				queue = append(queue, w)
+			}
+		}
+	}
+	return nil
+}
+
 // cycleNode keeps track of a single node's info for cycle detection.
 type cycleNode struct {
 	Visited bool
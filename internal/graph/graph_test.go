@@ -24,6 +24,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type TestGraph struct {
@@ -155,3 +156,62 @@ func TestGraphIsCyclic(t *testing.T) {
 		assert.Equal(t, tt.cycle, c)
 	}
 }
+
+func TestShortestCycle(t *testing.T) {
+	testCases := []struct {
+		edges [][]int
+		cycle []int
+	}{
+		//
+		// 0 ---> 1 ---> 2
+		//        ^      |
+		//        '------'
+		{
+			edges: [][]int{
+				{1},
+				{2},
+				{1},
+			},
+			cycle: []int{1, 2, 1},
+		},
+		//
+		// 0 ---> 1 ---> 2 ---> 3
+		// ^      |             |
+		// |<-----'             |
+		// '--------------------'
+		{
+			edges: [][]int{
+				{1},
+				{2, 0},
+				{3},
+				{0},
+			},
+			cycle: []int{0, 1, 0},
+		},
+		//
+		// 0 ---> 1 ---> 2 ---> 3 ---> 4
+		//        ^      |             |
+		//        |      '---> 5 ------|
+		//        '--------------------'
+		{
+			edges: [][]int{
+				{1},
+				{2},
+				{3, 5},
+				{4},
+				{1},
+				{1},
+			},
+			cycle: []int{1, 2, 5, 1},
+		},
+	}
+	for _, tt := range testCases {
+		g := newTestGraph()
+		for i, neighbors := range tt.edges {
+			g.Nodes[i] = neighbors
+		}
+		ok, c := IsAcyclic(g)
+		require.False(t, ok)
+		assert.Equal(t, tt.cycle, ShortestCycle(g, c))
+	}
+}
@@ -21,6 +21,7 @@
 package dig
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 
@@ -28,10 +29,36 @@ import (
 	"go.uber.org/dig/internal/graph"
 )
 
-// An InvokeOption modifies the default behavior of Invoke. It's included for
-// future functionality; currently, there are no concrete implementations.
+// An InvokeOption modifies the default behavior of Invoke.
 type InvokeOption interface {
-	unimplemented()
+	applyInvokeOption(*invokeOptions)
+}
+
+type invokeOptions struct {
+	TraceID  string
+	Location *digreflect.Func
+	Context  context.Context
+	Report   *DegradationReport
+
+	// Info, if set, is filled with the inputs of the function.
+	Info *InvokeInfo
+
+	// If set, dependencies are checked without calling anything.
+	ValidateOnly bool
+
+	// Tags annotating positional parameters with ParamName and
+	// ParamGroup.
+	ParamTags []paramTag
+
+	// Keys of the values selected from value groups with SelectMember, by
+	// group.
+	Selections map[string]string
+
+	// Calls the function in place of the Scope's invokerFn if set.
+	invoker invokerFn
+
+	// Whether the function is invoked to resolve a Lazy dependency.
+	lazy bool
 }
 
 // Invoke runs the given function after instantiating its dependencies.
@@ -58,64 +85,175 @@ func (c *Container) Invoke(function interface{}, opts ...InvokeOption) error {
 //
 // The function may return an error to indicate failure. The error will be
 // returned to the caller as-is.
-func (s *Scope) Invoke(function interface{}, opts ...InvokeOption) (err error) {
+func (s *Scope) Invoke(function interface{}, opts ...InvokeOption) error {
+	var options invokeOptions
+	for _, o := range opts {
+		o.applyInvokeOption(&options)
+	}
+
+	return invokeError(s.invoke(function, options))
+}
+
+// invokeError returns the error of a call to invoke: the error returned by
+// invoke, if any, or else the error returned by the invoked function.
+func invokeError(returned []reflect.Value, err error) error {
+	if err != nil {
+		return err
+	}
+	if len(returned) == 0 {
+		return nil
+	}
+	if last := returned[len(returned)-1]; isError(last.Type()) {
+		if err, _ := last.Interface().(error); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// invoke runs the given function after instantiating its dependencies and
+// returns its results. The returned error is non-nil only if the function
+// could not be called, or if it panicked and the Scope recovers from panics.
+func (s *Scope) invoke(function interface{}, opts invokeOptions) (returned []reflect.Value, err error) {
+	ticket := s.takeTicket()
+	unlock := s.lock()
+	defer func() { unlock() }()
+
+	traceID := opts.TraceID
+	if traceID == "" {
+		traceID = s.resolutionPath().traceID()
+	}
+	if traceID != "" {
+		defer func() {
+			err = wrapTraceError(traceID, err)
+		}()
+	}
+
 	ftype := reflect.TypeOf(function)
 	if ftype == nil {
-		return newErrInvalidInput("can't invoke an untyped nil", nil)
+		return nil, newErrInvalidInput("can't invoke an untyped nil", nil)
 	}
 	if ftype.Kind() != reflect.Func {
-		return newErrInvalidInput(
+		return nil, newErrInvalidInput(
 			fmt.Sprintf("can't invoke non-function %v (type %v)", function, ftype), nil)
 	}
 
 	pl, err := newParamList(ftype, s)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	pl, err = pl.annotate(s, opts.ParamTags)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := opts.Location
+	if loc == nil {
+		loc = digreflect.InspectFunc(function)
+	}
+
+	if info := opts.Info; info != nil {
+		info.fill(s, pl)
+	}
+	if opts.ValidateOnly {
+		return nil, s.validateInvoke(pl, loc)
+	}
+
+	root := s.rootScope()
+	var released bool
+	defer func() {
+		if invokeError(returned, err) != nil {
+			return
+		}
+		if released {
+			defer s.lock()()
+		}
+		root.invoked = true
+	}()
+	if root.dump != nil && s.resolution() == nil {
+		root.dump.reset()
+		defer func() {
+			if err != nil {
+				if released {
+					defer s.lock()()
+				}
+				err = root.dump.write(s, loc, traceID, pl, err)
+			}
+		}()
 	}
 
+	popFrame := s.pushResolveFrame(resolveFrame{
+		Invoke:  loc,
+		TraceID: traceID,
+		Context: opts.Context,
+		Report:  opts.Report,
+		Ticket:  ticket,
+		Lazy:    opts.lazy,
+
+		Selections: opts.Selections,
+	})
+	popCaller := s.pushCaller(caller{Scope: s})
+	popSpan, endSpan := s.startSpan(opts.Context, "Invoke ", loc)
+	defer func() { endSpan(invokeError(returned, err)) }()
+	pop := func() {
+		popSpan()
+		popCaller()
+		popFrame()
+	}
+	defer func() { pop() }()
+
 	if err := shallowCheckDependencies(s, pl); err != nil {
-		return errMissingDependencies{
-			Func:   digreflect.InspectFunc(function),
+		return nil, s.wrapScopeError(errMissingDependencies{
+			Func:   loc,
 			Reason: err,
-		}
+		})
 	}
 
-	if !s.isVerifiedAcyclic {
-		if ok, cycle := graph.IsAcyclic(s.gh); !ok {
-			return newErrInvalidInput("cycle detected in dependency graph", s.cycleDetectedError(cycle))
+	if gs := s.graphScope(); !gs.isVerifiedAcyclic {
+		if ok, cycle := graph.IsAcyclic(gs.gh); !ok {
+			return nil, newErrInvalidInput("cycle detected in dependency graph", gs.cycleDetectedError(cycle))
 		}
-		s.isVerifiedAcyclic = true
+		gs.isVerifiedAcyclic = true
 	}
 
 	args, err := pl.BuildList(s)
 	if err != nil {
-		return errArgumentsFailed{
-			Func:   digreflect.InspectFunc(function),
+		s.skipCanceled(err, pl)
+		return nil, s.wrapScopeError(errArgumentsFailed{
+			Func:   loc,
 			Reason: err,
-		}
+		})
+	}
+	if err := s.checkCanceled(); err != nil {
+		return nil, s.wrapScopeError(err)
+	}
+	s.deriveArgs(pl, args, loc)
+
+	if s.isConcurrent() {
+		// Concurrent containers call the function without
+		// their lock held, so that functions run alongside other Invokes
+		// and may use the container.
+		pop()
+		unlock()
+		pop, unlock, released = func() {}, func() {}, true
 	}
 	if s.recoverFromPanics {
 		defer func() {
 			if p := recover(); p != nil {
 				err = PanicError{
-					fn:    digreflect.InspectFunc(function),
+					fn:    loc,
 					Panic: p,
 				}
 			}
 		}()
 	}
 
-	returned := s.invokerFn(reflect.ValueOf(function), args)
-	if len(returned) == 0 {
-		return nil
+	invoker := s.invokerFn
+	if opts.invoker != nil {
+		invoker = opts.invoker
 	}
-	if last := returned[len(returned)-1]; isError(last.Type()) {
-		if err, _ := last.Interface().(error); err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return invoker(reflect.ValueOf(function), args), nil
 }
 
 // Checks that all direct dependencies of the provided parameters are present in
@@ -146,8 +284,27 @@ func findMissingDependencies(c containerStore, params ...param) []paramSingle {
 			// and it is NOT being decorated and is NOT optional.
 			// In the case that there is no providers but there is a decorated value
 			// of this type, it can be provided safely so we can safely skip this.
-			if len(allProviders) == 0 && !hasDecoratedValue && !p.Optional {
-				missingDeps = append(missingDeps, p)
+			// Values declared with Extern are expected to be provided later.
+			if len(allProviders) == 0 && !hasDecoratedValue && !p.Optional &&
+				!c.isExtern(p.Name, p.Type) {
+				if _, ok := p.implicitContext(c); ok {
+					continue
+				}
+				if p.isCallInfo() {
+					continue
+				}
+				if _, _, ok := lazyValueType(p.Type); ok {
+					continue
+				}
+				if _, ok := c.inferredKey(p.Name, p.Type); ok {
+					continue
+				}
+				if _, ok := c.equivalentKey(p.Name, p.Type); ok {
+					continue
+				}
+				if _, ok := c.stub(p.Name, p.Type); !ok {
+					missingDeps = append(missingDeps, p)
+				}
 			}
 		case paramObject:
 			for _, f := range p.Fields {
@@ -157,3 +314,47 @@ func findMissingDependencies(c containerStore, params ...param) []paramSingle {
 	}
 	return missingDeps
 }
+
+// walkDependencies calls visit for each constructor that provides a value
+// requested by the given parameter when resolved in the given Scope, along
+// with the parameter it provides. The dependencies of a constructor are
+// walked in turn if visit returns true. visit is called at most once per
+// constructor.
+func walkDependencies(s *Scope, p param, visit func(s *Scope, p param, n *constructorNode) bool) {
+	seen := make(map[*constructorNode]struct{})
+	var walk func(s *Scope, p param)
+	walk = func(s *Scope, p param) {
+		var providers []provider
+		switch p := p.(type) {
+		case paramList:
+			for _, p := range p.Params {
+				walk(s, p)
+			}
+		case paramObject:
+			for _, f := range p.Fields {
+				walk(s, f.Param)
+			}
+		case paramSingle:
+			providers = s.getAllValueProviders(p.Name, p.Type)
+		case paramGroupedSlice:
+			providers = s.getAllGroupProviders(p.Group, p.Type.Elem())
+		case paramGroupMember:
+			providers = p.providers(s)
+		}
+
+		for _, pr := range providers {
+			n, ok := pr.(*constructorNode)
+			if !ok {
+				continue
+			}
+			if _, ok := seen[n]; ok {
+				continue
+			}
+			seen[n] = struct{}{}
+			if visit(s, p, n) {
+				walk(n.OrigScope(), n.paramList)
+			}
+		}
+	}
+	walk(s, p)
+}
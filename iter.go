@@ -0,0 +1,73 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Value groups may be consumed as iter.Seq[T] instead of []T, and
+// constructors may contribute the members of an iter.Seq[T] to a group
+// with flatten. The iter package is only available since Go 1.23, so its
+// types are recognized by name instead.
+//
+// An iter.Seq contributed to a group is ranged over once, right after its
+// constructor returns, and its items are added to the group like the
+// elements of a flattened slice. Groups are not lazy: keys, labels,
+// decorators, Strict ordering, and GroupView all work on the members a
+// group holds, and an iter.Seq may not support being ranged over more than
+// once. Likewise, a group consumed as an iter.Seq yields members that were
+// already built; only the consumer's iteration is lazy.
+
+// seqElem reports the element type of t if t is an iter.Seq.
+func seqElem(t reflect.Type) (reflect.Type, bool) {
+	if t.Kind() != reflect.Func || t.PkgPath() != "iter" || !strings.HasPrefix(t.Name(), "Seq[") {
+		return nil, false
+	}
+	return t.In(0).In(0), true
+}
+
+// makeSeq builds an iter.Seq of type t that yields the items of the
+// given slice.
+func makeSeq(t reflect.Type, items reflect.Value) reflect.Value {
+	return reflect.MakeFunc(t, func(args []reflect.Value) []reflect.Value {
+		yield := args[0]
+		for i := 0; i < items.Len(); i++ {
+			if !yield.Call([]reflect.Value{items.Index(i)})[0].Bool() {
+				break
+			}
+		}
+		return nil
+	})
+}
+
+// rangeSeq calls f with each item yielded by the given iter.Seq.
+func rangeSeq(seq reflect.Value, f func(reflect.Value)) {
+	if seq.IsNil() {
+		return
+	}
+	yield := reflect.MakeFunc(seq.Type().In(0), func(args []reflect.Value) []reflect.Value {
+		f(args[0])
+		return []reflect.Value{reflect.ValueOf(true)}
+	})
+	seq.Call([]reflect.Value{yield})
+}
@@ -0,0 +1,169 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build go1.23
+
+package dig_test
+
+import (
+	"iter"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestIterSeqGroups(t *testing.T) {
+	t.Parallel()
+
+	t.Run("consume group as iter.Seq", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() int { return 1 }, dig.Group("nums"))
+		c.RequireProvide(func() int { return 2 }, dig.Group("nums"))
+
+		type params struct {
+			dig.In
+
+			Nums iter.Seq[int] `group:"nums"`
+		}
+		c.RequireInvoke(func(p params) {
+			assert.ElementsMatch(t, []int{1, 2}, slices.Collect(p.Nums))
+		})
+	})
+
+	t.Run("stop early", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		for i := 0; i < 5; i++ {
+			c.RequireProvide(func() int { return 1 }, dig.Group("nums"))
+		}
+
+		type params struct {
+			dig.In
+
+			Nums iter.Seq[int] `group:"nums"`
+		}
+		c.RequireInvoke(func(p params) {
+			var n int
+			for range p.Nums {
+				n++
+				if n == 2 {
+					break
+				}
+			}
+			assert.Equal(t, 2, n)
+		})
+	})
+
+	t.Run("provide iter.Seq with flatten", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() iter.Seq[string] {
+			return slices.Values([]string{"a", "b"})
+		}, dig.Group("letters,flatten"))
+		c.RequireProvide(func() string { return "c" }, dig.Group("letters"))
+
+		type params struct {
+			dig.In
+
+			Letters []string `group:"letters"`
+		}
+		c.RequireInvoke(func(p params) {
+			assert.ElementsMatch(t, []string{"a", "b", "c"}, p.Letters)
+		})
+	})
+
+	t.Run("provide iter.Seq with flatten in result object", func(t *testing.T) {
+		t.Parallel()
+
+		type result struct {
+			dig.Out
+
+			Letters iter.Seq[string] `group:"letters,flatten"`
+		}
+
+		c := digtest.New(t)
+		c.RequireProvide(func() result {
+			return result{Letters: slices.Values([]string{"a", "b"})}
+		})
+
+		type params struct {
+			dig.In
+
+			Letters iter.Seq[string] `group:"letters"`
+		}
+		c.RequireInvoke(func(p params) {
+			assert.ElementsMatch(t, []string{"a", "b"}, slices.Collect(p.Letters))
+		})
+	})
+
+	t.Run("provided iter.Seq is ranged once", func(t *testing.T) {
+		t.Parallel()
+
+		var ranged int
+		c := digtest.New(t)
+		c.RequireProvide(func() iter.Seq[string] {
+			return func(yield func(string) bool) {
+				ranged++
+				for _, s := range []string{"a", "b"} {
+					if !yield(s) {
+						return
+					}
+				}
+			}
+		}, dig.Group("letters,flatten"))
+
+		type params struct {
+			dig.In
+
+			Letters iter.Seq[string] `group:"letters"`
+		}
+		for i := 0; i < 2; i++ {
+			c.RequireInvoke(func(p params) {
+				assert.Equal(t, 1, ranged, "items must be collected when the constructor is called")
+				assert.ElementsMatch(t, []string{"a", "b"}, slices.Collect(p.Letters))
+				assert.ElementsMatch(t, []string{"a", "b"}, slices.Collect(p.Letters))
+			})
+		}
+		assert.Equal(t, 1, ranged)
+	})
+
+	t.Run("nil iter.Seq contributes nothing", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() iter.Seq[string] { return nil }, dig.Group("letters,flatten"))
+
+		type params struct {
+			dig.In
+
+			Letters []string `group:"letters"`
+		}
+		c.RequireInvoke(func(p params) {
+			assert.Empty(t, p.Letters)
+		})
+	})
+}
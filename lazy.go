@@ -0,0 +1,205 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"runtime"
+
+	"go.uber.org/dig/internal/digreflect"
+)
+
+// Lazy is a dependency on a value of type T that is built only when Get is
+// called, rather than before the function depending on it is called.
+//
+// Functions may depend on a Lazy[T] for any T that the container can
+// build, without providing it; the container fills it in. This defers the
+// construction of heavy dependencies that are rarely used.
+//
+//	c.Provide(func(geo dig.Lazy[*GeoIPDatabase]) *Handler {
+//	  return &Handler{geo: geo}
+//	})
+//
+//	func (h *Handler) lookup(ip net.IP) (string, error) {
+//	  db, err := h.geo.Get()
+//	  // ...
+//	}
+//
+// Similarly, functions may depend on a func() (T, error) without
+// providing it. The container fills in a function that behaves like Get.
+//
+// Values built by Get are cached like other values, so T is built at most
+// once. Since lazy dependencies are not part of the dependency graph, they
+// may be used to break dependency cycles. A value that ends up depending
+// on itself through Get fails with an error.
+//
+// Constructors and decorators may call Get, including in containers
+// built with Concurrent or used through Request, as long as they call it
+// on the goroutine they were called on.
+type Lazy[T any] struct {
+	resolve func(loc *digreflect.Func) (reflect.Value, error)
+}
+
+// Get builds the value if it hasn't been built yet and returns it.
+func (l Lazy[T]) Get() (value T, err error) {
+	pc, _, _, _ := runtime.Caller(1)
+	loc := digreflect.InspectFuncPC(pc)
+	if l.resolve == nil {
+		return value, newErrInvalidInput(fmt.Sprintf(
+			"%v was not filled in by a container", reflect.TypeOf(l)), nil)
+	}
+
+	v, err := l.resolve(loc)
+	if err != nil {
+		return value, err
+	}
+	reflect.ValueOf(&value).Elem().Set(v)
+	return value, nil
+}
+
+func (*Lazy[T]) valueType() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+func (l *Lazy[T]) setResolver(resolve func(*digreflect.Func) (reflect.Value, error)) {
+	l.resolve = resolve
+}
+
+// lazy is implemented by all instantiations of *Lazy.
+type lazy interface {
+	valueType() reflect.Type
+	setResolver(func(*digreflect.Func) (reflect.Value, error))
+}
+
+var _lazyType = reflect.TypeOf((*lazy)(nil)).Elem()
+
+// lazyValueType returns the type of the value that a Lazy dependency or a
+// func() (T, error) dependency of the given type builds, if it's either.
+func lazyValueType(t reflect.Type) (_ reflect.Type, isFunc bool, ok bool) {
+	if reflect.PtrTo(t).Implements(_lazyType) && t.Kind() == reflect.Struct {
+		return reflect.New(t).Interface().(lazy).valueType(), false, true
+	}
+	if t.Kind() == reflect.Func && t.NumIn() == 0 && t.NumOut() == 2 && !t.IsVariadic() &&
+		t.Out(1) == _errType && !isError(t.Out(0)) {
+		return t.Out(0), true, true
+	}
+	return nil, false, false
+}
+
+// lazy builds this parameter as a Lazy or a func() (T, error) that builds
+// the value with the same name, if it's either and it wasn't provided.
+func (ps paramSingle) lazy(c containerStore) (reflect.Value, bool) {
+	vt, isFunc, ok := lazyValueType(ps.Type)
+	if !ok {
+		return _noValue, false
+	}
+
+	name := ps.Name
+	resolve := func(loc *digreflect.Func) (reflect.Value, error) {
+		return c.resolveLazily(name, vt, loc)
+	}
+
+	if isFunc {
+		fn := reflect.MakeFunc(ps.Type, func([]reflect.Value) []reflect.Value {
+			pc, _, _, _ := runtime.Caller(1)
+			v, err := resolve(digreflect.InspectFuncPC(pc))
+			if err != nil {
+				return []reflect.Value{reflect.Zero(vt), reflect.ValueOf(&err).Elem()}
+			}
+			return []reflect.Value{v, reflect.Zero(_errType)}
+		})
+		return fn, true
+	}
+
+	l := reflect.New(ps.Type)
+	l.Interface().(lazy).setResolver(resolve)
+	return l.Elem(), true
+}
+
+// resolveLazily builds the value with the given name and type on behalf of
+// a Lazy dependency used at the given location, as if it was invoked.
+func (s *Scope) resolveLazily(name string, t reflect.Type, loc *digreflect.Func) (reflect.Value, error) {
+	// Request a dig.In struct for named values.
+	ftype := reflect.FuncOf([]reflect.Type{t}, nil, false)
+	if name != "" {
+		ftype = reflect.FuncOf([]reflect.Type{reflect.StructOf([]reflect.StructField{
+			{Name: "In", Type: _inType, Anonymous: true},
+			{Name: "Value", Type: t, Tag: reflect.StructTag(fmt.Sprintf("name:%q", name))},
+		})}, nil, false)
+	}
+	fn := reflect.MakeFunc(ftype, func([]reflect.Value) []reflect.Value { return nil })
+
+	var v reflect.Value
+	_, err := s.invoke(fn.Interface(), invokeOptions{
+		Location: loc,
+		lazy:     true,
+		invoker: func(_ reflect.Value, args []reflect.Value) []reflect.Value {
+			v = args[0]
+			if name != "" {
+				v = v.Field(1)
+			}
+			return nil
+		},
+	})
+	return v, err
+}
+
+// checkLazyCycle returns an error if the value being built is already
+// being built further up the resolution path, which is possible only
+// through Lazy dependencies.
+func (s *Scope) checkLazyCycle() error {
+	r := s.resolution()
+	if r.lazy == 0 {
+		return nil
+	}
+
+	path := r.path
+	k := path[len(path)-1].Key
+	for _, f := range path[:len(path)-1] {
+		if f.Invoke == nil && f.Key == k {
+			return errLazyCycle{Key: k, Path: s.resolutionPath()}
+		}
+	}
+	return nil
+}
+
+// errLazyCycle is returned when a value depends on itself through a Lazy
+// dependency.
+type errLazyCycle struct {
+	Key  key
+	Path resolutionPath
+}
+
+var _ digError = errLazyCycle{}
+
+func (e errLazyCycle) Error() string { return fmt.Sprint(e) }
+
+func (e errLazyCycle) writeMessage(w io.Writer, _ string) {
+	fmt.Fprintf(w, "cycle detected through a lazy dependency: %v is already being built %v", e.Key, e.Path)
+}
+
+func (e errLazyCycle) Format(w fmt.State, c rune) {
+	formatError(e, w, c)
+}
+
+func (e errLazyCycle) Is(target error) bool { return target == ErrCycle }
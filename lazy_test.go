@@ -0,0 +1,220 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestLazy(t *testing.T) {
+	t.Parallel()
+
+	type Heavy struct{ n int }
+
+	t.Run("built on Get", func(t *testing.T) {
+		c := digtest.New(t)
+		var calls int
+		c.RequireProvide(func() *Heavy {
+			calls++
+			return &Heavy{n: 42}
+		})
+
+		c.RequireInvoke(func(l dig.Lazy[*Heavy]) {
+			assert.Zero(t, calls)
+
+			h, err := l.Get()
+			require.NoError(t, err)
+			assert.Equal(t, 42, h.n)
+
+			_, err = l.Get()
+			require.NoError(t, err)
+			assert.Equal(t, 1, calls)
+		})
+	})
+
+	t.Run("func", func(t *testing.T) {
+		c := digtest.New(t)
+		var calls int
+		c.RequireProvide(func() *Heavy {
+			calls++
+			return &Heavy{n: 42}
+		})
+
+		c.RequireInvoke(func(get func() (*Heavy, error)) {
+			assert.Zero(t, calls)
+
+			h, err := get()
+			require.NoError(t, err)
+			assert.Equal(t, 42, h.n)
+		})
+	})
+
+	t.Run("provided funcs are used as-is", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() func() (*Heavy, error) {
+			return func() (*Heavy, error) { return &Heavy{n: 1}, nil }
+		})
+		c.RequireProvide(func() *Heavy { return &Heavy{n: 2} })
+
+		c.RequireInvoke(func(get func() (*Heavy, error)) {
+			h, err := get()
+			require.NoError(t, err)
+			assert.Equal(t, 1, h.n)
+		})
+	})
+
+	t.Run("named", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *Heavy { return &Heavy{n: 1} }, dig.Name("primary"))
+
+		c.RequireInvoke(func(p struct {
+			dig.In
+
+			L dig.Lazy[*Heavy] `name:"primary"`
+		}) {
+			h, err := p.L.Get()
+			require.NoError(t, err)
+			assert.Equal(t, 1, h.n)
+		})
+	})
+
+	t.Run("used after construction", func(t *testing.T) {
+		type Handler struct{ geo dig.Lazy[*Heavy] }
+
+		c := digtest.New(t)
+		c.RequireProvide(func(l dig.Lazy[*Heavy]) *Handler { return &Handler{geo: l} })
+		c.RequireProvide(func() *Heavy { return &Heavy{n: 7} })
+
+		var h *Handler
+		c.RequireInvoke(func(hh *Handler) { h = hh })
+
+		heavy, err := h.geo.Get()
+		require.NoError(t, err)
+		assert.Equal(t, 7, heavy.n)
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		c := digtest.New(t)
+
+		c.RequireInvoke(func(l dig.Lazy[*Heavy]) {
+			_, err := l.Get()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "missing type: *dig_test.Heavy")
+			assert.Contains(t, err.Error(), "lazy_test.go")
+		})
+	})
+
+	t.Run("constructor fails", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() (*Heavy, error) { return nil, errors.New("great sadness") })
+
+		c.RequireInvoke(func(get func() (*Heavy, error)) {
+			_, err := get()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "great sadness")
+		})
+	})
+
+	t.Run("breaks cycles", func(t *testing.T) {
+		type A struct{ b dig.Lazy[*Heavy] }
+
+		c := digtest.New(t)
+		c.RequireProvide(func(b dig.Lazy[*Heavy]) *A { return &A{b: b} })
+		c.RequireProvide(func(*A) *Heavy { return &Heavy{n: 3} })
+
+		c.RequireInvoke(func(a *A) {
+			h, err := a.b.Get()
+			require.NoError(t, err)
+			assert.Equal(t, 3, h.n)
+		})
+	})
+
+	t.Run("cycle through Get", func(t *testing.T) {
+		type A struct{}
+
+		c := digtest.New(t)
+		c.RequireProvide(func(b dig.Lazy[*Heavy]) (*A, error) {
+			_, err := b.Get()
+			return &A{}, err
+		})
+		c.RequireProvide(func(*A) *Heavy { return &Heavy{} })
+
+		err := c.Invoke(func(*A) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cycle detected through a lazy dependency: *dig_test.A is already being built")
+	})
+
+	t.Run("concurrent Get", func(t *testing.T) {
+		c := digtest.New(t, dig.Concurrent())
+		var calls int
+		c.RequireProvide(func() *Heavy {
+			calls++
+			return &Heavy{n: 42}
+		})
+
+		var l dig.Lazy[*Heavy]
+		c.RequireInvoke(func(lh dig.Lazy[*Heavy]) { l = lh })
+
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				h, err := l.Get()
+				assert.NoError(t, err)
+				assert.Equal(t, 42, h.n)
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Get from a constructor after Request", func(t *testing.T) {
+		type Light struct{ heavy *Heavy }
+
+		c := digtest.New(t)
+		c.RequireProvide(func() *Heavy { return &Heavy{n: 42} })
+		c.RequireProvide(func(lh dig.Lazy[*Heavy]) (*Light, error) {
+			h, err := lh.Get()
+			return &Light{heavy: h}, err
+		})
+
+		req := c.Request()
+		defer req.Close()
+		require.NoError(t, req.Invoke(func(l *Light) {
+			assert.Equal(t, 42, l.heavy.n)
+		}))
+	})
+
+	t.Run("zero value", func(t *testing.T) {
+		var l dig.Lazy[*Heavy]
+		_, err := l.Get()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "was not filled in by a container")
+	})
+}
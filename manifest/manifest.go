@@ -0,0 +1,206 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package manifest assembles a dig container from a declarative manifest.
+//
+// Constructors are registered under stable names in a Registry by Go code.
+// A Manifest then lists which of those constructors should be provided to
+// the container, along with the names and groups for their results.
+//
+//	reg := manifest.NewRegistry()
+//	reg.MustRegister("postgres", NewPostgresStore)
+//	reg.MustRegister("http", NewHTTPHandler)
+//
+//	m, err := manifest.Parse(f)
+//	if err != nil {
+//	  return err
+//	}
+//	c := dig.New()
+//	if err := m.Apply(c, reg, "production"); err != nil {
+//	  return err
+//	}
+//
+// Manifests are JSON documents of the following form.
+//
+//	{
+//	  "providers": [
+//	    {"constructor": "postgres", "name": "primary"},
+//	    {"constructor": "http", "group": "handlers"},
+//	    {"constructor": "debug", "group": "handlers", "profiles": ["development"]}
+//	  ]
+//	}
+//
+// YAML manifests are not supported directly to keep dig free of
+// third-party dependencies. Convert them to JSON before calling Parse.
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+
+	"go.uber.org/dig"
+)
+
+// Registry maps names used in manifests to constructors.
+// The zero value is not usable; use NewRegistry to create one.
+type Registry struct {
+	ctors map[string]interface{}
+}
+
+// NewRegistry builds a new, empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{ctors: make(map[string]interface{})}
+}
+
+// Register registers a constructor under the given name. The constructor
+// must be a function; it is passed to Container.Provide unchanged.
+//
+// Register fails if a constructor was already registered under the same
+// name.
+func (r *Registry) Register(name string, ctor interface{}) error {
+	if name == "" {
+		return fmt.Errorf("cannot register %T: name must not be empty", ctor)
+	}
+	if ctor == nil || reflect.TypeOf(ctor).Kind() != reflect.Func {
+		return fmt.Errorf("cannot register %q: must provide a constructor function, got %T", name, ctor)
+	}
+	if _, ok := r.ctors[name]; ok {
+		return fmt.Errorf("cannot register %q: a constructor is already registered under that name", name)
+	}
+	r.ctors[name] = ctor
+	return nil
+}
+
+// MustRegister is like Register, but panics if the constructor could not
+// be registered. It's intended for use during program initialization.
+func (r *Registry) MustRegister(name string, ctor interface{}) {
+	if err := r.Register(name, ctor); err != nil {
+		panic(err)
+	}
+}
+
+// Names returns the names of all registered constructors in sorted order.
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.ctors))
+	for name := range r.ctors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Manifest is a declarative description of the constructors to provide to
+// a container.
+type Manifest struct {
+	Providers []Provider `json:"providers"`
+}
+
+// Provider describes a single constructor in a Manifest.
+type Provider struct {
+	// Constructor is the name the constructor was registered under in the
+	// Registry.
+	Constructor string `json:"constructor"`
+
+	// Name and Group correspond to the dig.Name and dig.Group options.
+	// If both are set, Name identifies the values within the group.
+	Name  string `json:"name,omitempty"`
+	Group string `json:"group,omitempty"`
+
+	// Profiles restricts this constructor to the given profiles. If empty,
+	// the constructor is provided regardless of the active profiles.
+	Profiles []string `json:"profiles,omitempty"`
+}
+
+// Parse reads a JSON manifest from the given reader.
+// Unknown fields are rejected to catch typos early.
+func Parse(r io.Reader) (*Manifest, error) {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+
+	var m Manifest
+	if err := dec.Decode(&m); err != nil {
+		return nil, fmt.Errorf("parse manifest: %w", err)
+	}
+	return &m, nil
+}
+
+// Apply provides the constructors listed in the manifest to the container,
+// looking them up in the given registry. Only providers that are enabled
+// for at least one of the given profiles, or that don't list any profiles,
+// are provided.
+//
+// Apply validates the whole manifest against the registry and tries it on
+// a Clone of the container before providing anything, so an unknown
+// constructor name or a constructor that can't be provided leaves the
+// container untouched.
+func (m *Manifest) Apply(c *dig.Container, reg *Registry, profiles ...string) error {
+	for i, p := range m.Providers {
+		if p.Constructor == "" {
+			return fmt.Errorf("provider %d: constructor must not be empty", i)
+		}
+		if _, ok := reg.ctors[p.Constructor]; !ok {
+			return fmt.Errorf("provider %d: unknown constructor %q", i, p.Constructor)
+		}
+	}
+
+	// Provide to a clone first so that failures leave c untouched.
+	if err := m.provide(c.Clone(), reg, profiles); err != nil {
+		return err
+	}
+	return m.provide(c, reg, profiles)
+}
+
+// provide provides the enabled constructors of the manifest to c.
+func (m *Manifest) provide(c *dig.Container, reg *Registry, profiles []string) error {
+	for i, p := range m.Providers {
+		if !p.enabled(profiles) {
+			continue
+		}
+
+		var opts []dig.ProvideOption
+		if p.Name != "" {
+			opts = append(opts, dig.Name(p.Name))
+		}
+		if p.Group != "" {
+			opts = append(opts, dig.Group(p.Group))
+		}
+		if err := c.Provide(reg.ctors[p.Constructor], opts...); err != nil {
+			return fmt.Errorf("provider %d (%q): %w", i, p.Constructor, err)
+		}
+	}
+	return nil
+}
+
+func (p *Provider) enabled(active []string) bool {
+	if len(p.Profiles) == 0 {
+		return true
+	}
+	for _, want := range p.Profiles {
+		for _, got := range active {
+			if want == got {
+				return true
+			}
+		}
+	}
+	return false
+}
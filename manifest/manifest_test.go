@@ -0,0 +1,200 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package manifest_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/manifest"
+)
+
+type store struct{ name string }
+
+type handler struct{ path string }
+
+func newRegistry() *manifest.Registry {
+	reg := manifest.NewRegistry()
+	reg.MustRegister("store", func() *store { return &store{name: "postgres"} })
+	reg.MustRegister("health", func() *handler { return &handler{path: "/health"} })
+	reg.MustRegister("debug", func() *handler { return &handler{path: "/debug"} })
+	return reg
+}
+
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("duplicate", func(t *testing.T) {
+		reg := newRegistry()
+		err := reg.Register("store", func() *store { return nil })
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `cannot register "store": a constructor is already registered`)
+	})
+
+	t.Run("not a function", func(t *testing.T) {
+		err := manifest.NewRegistry().Register("foo", 42)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must provide a constructor function, got int")
+	})
+
+	t.Run("empty name", func(t *testing.T) {
+		err := manifest.NewRegistry().Register("", func() int { return 0 })
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "name must not be empty")
+	})
+
+	t.Run("MustRegister panics", func(t *testing.T) {
+		assert.Panics(t, func() {
+			manifest.NewRegistry().MustRegister("foo", nil)
+		})
+	})
+
+	t.Run("Names", func(t *testing.T) {
+		assert.Equal(t, []string{"debug", "health", "store"}, newRegistry().Names())
+	})
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		m, err := manifest.Parse(strings.NewReader(`{
+			"providers": [
+				{"constructor": "store", "name": "primary"},
+				{"constructor": "debug", "group": "handlers", "profiles": ["dev"]}
+			]
+		}`))
+		require.NoError(t, err)
+		assert.Equal(t, &manifest.Manifest{
+			Providers: []manifest.Provider{
+				{Constructor: "store", Name: "primary"},
+				{Constructor: "debug", Group: "handlers", Profiles: []string{"dev"}},
+			},
+		}, m)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		_, err := manifest.Parse(strings.NewReader(`{"providers": [{"ctor": "store"}]}`))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "parse manifest:")
+		assert.Contains(t, err.Error(), `unknown field "ctor"`)
+	})
+}
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+
+	const doc = `{
+		"providers": [
+			{"constructor": "store", "name": "primary"},
+			{"constructor": "health", "group": "handlers"},
+			{"constructor": "debug", "group": "handlers", "profiles": ["dev"]}
+		]
+	}`
+
+	type params struct {
+		dig.In
+
+		Store    *store     `name:"primary"`
+		Handlers []*handler `group:"handlers"`
+	}
+
+	paths := func(hs []*handler) []string {
+		var ps []string
+		for _, h := range hs {
+			ps = append(ps, h.path)
+		}
+		return ps
+	}
+
+	t.Run("no profiles", func(t *testing.T) {
+		m, err := manifest.Parse(strings.NewReader(doc))
+		require.NoError(t, err)
+
+		c := dig.New()
+		require.NoError(t, m.Apply(c, newRegistry()))
+		require.NoError(t, c.Invoke(func(p params) {
+			assert.Equal(t, "postgres", p.Store.name)
+			assert.Equal(t, []string{"/health"}, paths(p.Handlers))
+		}))
+	})
+
+	t.Run("active profile", func(t *testing.T) {
+		m, err := manifest.Parse(strings.NewReader(doc))
+		require.NoError(t, err)
+
+		c := dig.New()
+		require.NoError(t, m.Apply(c, newRegistry(), "dev"))
+		require.NoError(t, c.Invoke(func(p params) {
+			assert.ElementsMatch(t, []string{"/health", "/debug"}, paths(p.Handlers))
+		}))
+	})
+
+	t.Run("unknown constructor", func(t *testing.T) {
+		m := &manifest.Manifest{Providers: []manifest.Provider{
+			{Constructor: "store"},
+			{Constructor: "cache"},
+		}}
+
+		c := dig.New()
+		err := m.Apply(c, newRegistry())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `provider 1: unknown constructor "cache"`)
+
+		// Nothing must have been provided.
+		assert.Error(t, c.Invoke(func(*store) {}))
+	})
+
+	t.Run("empty constructor", func(t *testing.T) {
+		m := &manifest.Manifest{Providers: []manifest.Provider{{Name: "foo"}}}
+		err := m.Apply(dig.New(), newRegistry())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "provider 0: constructor must not be empty")
+	})
+
+	t.Run("provide error", func(t *testing.T) {
+		m := &manifest.Manifest{Providers: []manifest.Provider{
+			{Constructor: "store", Name: "foo`bar"},
+		}}
+		err := m.Apply(dig.New(), newRegistry())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `provider 0 ("store"):`)
+		assert.Contains(t, err.Error(), "names cannot contain backquotes")
+	})
+
+	t.Run("provide error leaves container untouched", func(t *testing.T) {
+		m := &manifest.Manifest{Providers: []manifest.Provider{
+			{Constructor: "store"},
+			{Constructor: "store"},
+		}}
+
+		c := dig.New()
+		err := m.Apply(c, newRegistry())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `provider 1 ("store"):`)
+
+		// Nothing must have been provided.
+		assert.Error(t, c.Invoke(func(*store) {}))
+	})
+}
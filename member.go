@@ -0,0 +1,455 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"go.uber.org/dig/internal/dot"
+)
+
+const _keyTag = "key"
+
+// GroupKey is a ProvideOption that gives the values a constructor adds to
+// a value group a key that identifies them within the group. It must be
+// used with Group. Keys must be unique among the values of the same type
+// in a group.
+//
+//	c.Provide(NewJSONCodec, dig.Group("codecs"), dig.GroupKey("json"))
+//	c.Provide(NewProtoCodec, dig.Group("codecs"), dig.GroupKey("proto"))
+//
+// Fields of dig.Out structs specify their key with a key tag instead.
+// Names given with Name or name tags along with a group are used as keys
+// as well.
+//
+//	type Codecs struct {
+//	  dig.Out
+//
+//	  JSON Codec `group:"codecs" key:"json"`
+//	}
+//
+// Values with keys are part of the group as usual. In addition, all values
+// with keys may be requested as a map from their keys to their values
+// with a field of a dig.In struct that has the group tag and a map type
+// with string keys,
+//
+//	type Params struct {
+//	  dig.In
+//
+//	  Codecs map[string]Codec `group:"codecs"`
+//	}
+//
+// and a single value may be requested from the group by its key with a
+// field that has the group tag but is neither a slice nor a map. The key
+// is either given with a key tag,
+//
+//	type Params struct {
+//	  dig.In
+//
+//	  Codec Codec `group:"codecs" key:"json"`
+//	}
+//
+// or selected when the function is invoked, if the field has no key tag.
+// See SelectMember for details. Only the constructors providing the
+// requested values are called. Values added to the group without a key are
+// not part of maps.
+//
+// Decorators of a value group transform the group as a whole, so values
+// can't be requested by key from a group that is decorated in the Scope
+// they're requested from or its ancestors; doing so fails.
+func GroupKey(key string) ProvideOption {
+	return provideGroupKeyOption(key)
+}
+
+type provideGroupKeyOption string
+
+func (o provideGroupKeyOption) String() string {
+	return fmt.Sprintf("GroupKey(%q)", string(o))
+}
+
+func (o provideGroupKeyOption) applyProvideOption(opts *provideOptions) {
+	opts.GroupKey = string(o)
+}
+
+// SelectMember is an InvokeOption that selects the value with the given key
+// from the given value group, for fields of dig.In structs that request a
+// single value of the group without a key tag. See GroupKey.
+//
+//	c.Invoke(func(p struct {
+//	  dig.In
+//
+//	  Codec Codec `group:"codecs"`
+//	}) {
+//	  // ...
+//	}, dig.SelectMember("codecs", cfg.Codec))
+//
+// If no value was selected for a group this way, the container consults
+// the MemberSelector provided to it, if any.
+func SelectMember(group, key string) InvokeOption {
+	return selectMemberOption{group: group, key: key}
+}
+
+type selectMemberOption struct{ group, key string }
+
+func (o selectMemberOption) String() string {
+	return fmt.Sprintf("SelectMember(%q, %q)", o.group, o.key)
+}
+
+func (o selectMemberOption) applyInvokeOption(opts *invokeOptions) {
+	if opts.Selections == nil {
+		opts.Selections = make(map[string]string)
+	}
+	opts.Selections[o.group] = o.key
+}
+
+// MemberSelector selects the key of the value to use from the given value
+// group, reporting false if it doesn't select one. A MemberSelector may be
+// provided to the container to select values from groups for Invokes that
+// don't use SelectMember.
+//
+//	c.Provide(func(cfg *Config) dig.MemberSelector {
+//	  return func(group string) (string, bool) {
+//	    key, ok := cfg.Implementations[group]
+//	    return key, ok
+//	  }
+//	})
+type MemberSelector func(group string) (key string, ok bool)
+
+var _memberSelectorType = reflect.TypeOf(MemberSelector(nil))
+
+// selection returns the key selected for the given group by the innermost
+// Invoke in the path that selected one.
+func (p resolutionPath) selection(group string) (string, bool) {
+	for i := len(p) - 1; i >= 0; i-- {
+		if k, ok := p[i].Selections[group]; ok {
+			return k, true
+		}
+	}
+	return "", false
+}
+
+// paramGroupMember is a single value of a value group, requested by its
+// key, or all values of the group that have a key, requested as a map.
+// See GroupKey.
+type paramGroupMember struct {
+	// Name of the group as specified in the `group:".."` tag.
+	Group string
+
+	// Type of the value.
+	Type reflect.Type
+
+	// Key of the value as specified in the `key:".."` tag, or empty if it's
+	// selected when invoked.
+	Key string
+
+	// Type of the map if all values with keys are requested, in which case
+	// Type is the type of its values.
+	Map reflect.Type
+
+	Optional bool
+}
+
+var _ param = paramGroupMember{}
+
+func newParamGroupMember(f reflect.StructField) (paramGroupMember, error) {
+	g, err := parseGroupString(f.Tag.Get(_groupTag))
+	if err != nil {
+		return paramGroupMember{}, err
+	}
+	pm := paramGroupMember{
+		Group: g.Name,
+		Type:  f.Type,
+		Key:   f.Tag.Get(_keyTag),
+	}
+	name := f.Tag.Get(_nameTag)
+
+	pm.Optional, err = isFieldOptional(f)
+	if err != nil {
+		return pm, err
+	}
+	if f.Type.Kind() == reflect.Map {
+		pm.Map, pm.Type = f.Type, f.Type.Elem()
+	}
+
+	switch {
+	case pm.Map != nil && pm.Map.Key().Kind() != reflect.String:
+		return pm, newErrInvalidInput(fmt.Sprintf(
+			"value groups may be consumed as maps only with string keys: field %q (%v)", f.Name, f.Type), nil)
+	case pm.Map != nil && pm.Key != "":
+		return pm, newErrInvalidInput(fmt.Sprintf(
+			"cannot use key tags with value groups consumed as maps: field %q (%v)", f.Name, f.Type), nil)
+	case g.Flatten || g.Soft:
+		return pm, newErrInvalidInput(fmt.Sprintf(
+			"cannot use flatten or soft when requesting a single value of a value group: field %q (%v)", f.Name, f.Type), nil)
+	case name != "" && pm.Key != "" && name != pm.Key:
+		return pm, newErrInvalidInput(fmt.Sprintf(
+			"cannot use different names and keys for values of value groups: name:%q and key:%q requested with group %q",
+			name, pm.Key, pm.Group), nil)
+	case name != "" && pm.Map != nil:
+		return pm, newErrInvalidInput(fmt.Sprintf(
+			"cannot use named values with value groups consumed as maps: field %q (%v)", f.Name, f.Type), nil)
+	}
+	if pm.Key == "" {
+		// Named values of value groups are their keyed members.
+		pm.Key = name
+	}
+	return pm, nil
+}
+
+func (pm paramGroupMember) String() string {
+	if pm.Map != nil {
+		return fmt.Sprintf("%v[group=%q]", pm.Map, pm.Group)
+	}
+	opts := []string{fmt.Sprintf("group=%q", pm.Group)}
+	if pm.Key != "" {
+		opts = append(opts, fmt.Sprintf("key=%q", pm.Key))
+	}
+	if pm.Optional {
+		opts = append(opts, "optional")
+	}
+	return fmt.Sprintf("%v[%v]", pm.Type, strings.Join(opts, ", "))
+}
+
+// DotParam reports the group that the value is selected from.
+func (pm paramGroupMember) DotParam() []*dot.Param {
+	return []*dot.Param{
+		{
+			Node: &dot.Node{
+				Type:  reflect.SliceOf(pm.Type),
+				Group: pm.Group,
+			},
+			Optional: pm.Optional,
+		},
+	}
+}
+
+// providers returns the providers that this parameter may be built with
+// when resolved in the given Scope.
+func (pm paramGroupMember) providers(s *Scope) []provider {
+	switch {
+	case pm.Map != nil:
+		var providers []provider
+		for _, member := range s.groupMemberKeys(pm.Group, pm.Type) {
+			providers = append(providers, s.getAllProviders(key{t: pm.Type, group: pm.Group, name: member})...)
+		}
+		return providers
+	case pm.Key == "":
+		return s.getAllGroupProviders(pm.Group, pm.Type)
+	}
+	return s.getAllProviders(key{t: pm.Type, group: pm.Group, name: pm.Key})
+}
+
+func (pm paramGroupMember) Build(c containerStore) (reflect.Value, error) {
+	defer c.pushResolveFrame(resolveFrame{Key: key{t: pm.Type, group: pm.Group}})()
+	if err := c.checkResolutionDepth(); err != nil {
+		return _noValue, err
+	}
+	c.recordAccess(key{t: pm.Type, group: pm.Group})
+
+	for _, s := range c.storesToRoot() {
+		if _, ok := s.getGroupDecorator(pm.Group, pm.Type); ok {
+			return _noValue, errDecoratedGroupMember{Group: pm.Group, Type: pm.Type}
+		}
+	}
+
+	if pm.Map != nil {
+		return pm.buildMap(c)
+	}
+
+	member := pm.Key
+	if member == "" {
+		var err error
+		if member, err = pm.selectKey(c); err != nil {
+			return _noValue, err
+		}
+	}
+	return pm.buildMember(c, member)
+}
+
+// buildMap builds all values of the group that have a key into a map.
+func (pm paramGroupMember) buildMap(c containerStore) (reflect.Value, error) {
+	m := reflect.MakeMap(pm.Map)
+	for _, member := range c.groupMemberKeys(pm.Group, pm.Type) {
+		v, err := pm.buildMember(c, member)
+		if err != nil {
+			return _noValue, err
+		}
+		m.SetMapIndex(reflect.ValueOf(member).Convert(pm.Map.Key()), v)
+	}
+	return m, nil
+}
+
+// buildMember builds the value of the group with the given key.
+func (pm paramGroupMember) buildMember(c containerStore, member string) (reflect.Value, error) {
+	var providers []provider
+	for _, s := range c.storesToRoot() {
+		if v, ok := s.getGroupMember(pm.Group, member, pm.Type); ok {
+			return v, nil
+		}
+		if providers = s.getGroupMemberProviders(pm.Group, member, pm.Type); len(providers) > 0 {
+			break
+		}
+	}
+
+	k := key{t: pm.Type, group: pm.Group, name: member}
+	if len(providers) == 0 {
+		if pm.Optional {
+			return reflect.Zero(pm.Type), nil
+		}
+		return _noValue, errMissingGroupMember{Key: k, Available: c.groupMemberKeys(pm.Group, pm.Type)}
+	}
+
+	n := providers[0]
+	if err := n.Call(n.OrigScope()); err != nil {
+		return _noValue, errParamSingleFailed{CtorID: n.ID(), Key: k, Reason: err}
+	}
+	for _, s := range c.storesToRoot() {
+		if v, ok := s.getGroupMember(pm.Group, member, pm.Type); ok {
+			return v, nil
+		}
+	}
+
+	// The constructor succeeded, so it's impossible for the value to be
+	// absent from the container.
+	return _noValue, errMissingGroupMember{Key: k}
+}
+
+// selectKey returns the key of the value selected for this parameter by
+// SelectMember or the MemberSelector provided to the container.
+func (pm paramGroupMember) selectKey(c containerStore) (string, error) {
+	if k, ok := c.resolutionPath().selection(pm.Group); ok {
+		return k, nil
+	}
+
+	if len(c.getAllValueProviders("", _memberSelectorType)) > 0 {
+		v, err := paramSingle{Type: _memberSelectorType}.Build(c)
+		if err != nil {
+			return "", err
+		}
+		if sel, _ := v.Interface().(MemberSelector); sel != nil {
+			if k, ok := sel(pm.Group); ok {
+				return k, nil
+			}
+		}
+	}
+	return "", errNoMemberSelected{Group: pm.Group, Type: pm.Type}
+}
+
+func (s *Scope) getGroupMember(group, member string, t reflect.Type) (reflect.Value, bool) {
+	v, ok := s.groupMembers[key{t: t, group: group, name: member}]
+	return v, ok
+}
+
+func (s *Scope) setGroupMember(group, member string, t reflect.Type, v reflect.Value) {
+	s.groupMembers[key{t: t, group: group, name: member}] = v
+}
+
+func (s *Scope) getGroupMemberProviders(group, member string, t reflect.Type) []provider {
+	return s.getProviders(key{t: t, group: group, name: member})
+}
+
+// groupMemberKeys returns the sorted keys of the values of the given type
+// in the given value group that are visible from this Scope.
+func (s *Scope) groupMemberKeys(group string, t reflect.Type) []string {
+	var keys []string
+	for _, s := range s.ancestors() {
+		for k, ps := range s.providers {
+			if k.group == group && k.t == t && k.name != "" && len(ps) > 0 {
+				keys = append(keys, k.name)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// errNoMemberSelected is returned when a single value of a value group is
+// requested without selecting one. See SelectMember.
+type errNoMemberSelected struct {
+	Group string
+	Type  reflect.Type
+}
+
+var _ digError = errNoMemberSelected{}
+
+func (e errNoMemberSelected) Error() string { return fmt.Sprint(e) }
+
+func (e errNoMemberSelected) writeMessage(w io.Writer, _ string) {
+	fmt.Fprintf(w, "no value of type %v selected from value group %q: "+
+		"use dig.SelectMember or provide a dig.MemberSelector", e.Type, e.Group)
+}
+
+func (e errNoMemberSelected) Format(w fmt.State, c rune) {
+	formatError(e, w, c)
+}
+
+// errDecoratedGroupMember is returned when values are requested by key
+// from a decorated value group.
+type errDecoratedGroupMember struct {
+	Group string
+	Type  reflect.Type
+}
+
+var _ digError = errDecoratedGroupMember{}
+
+func (e errDecoratedGroupMember) Error() string { return fmt.Sprint(e) }
+
+func (e errDecoratedGroupMember) writeMessage(w io.Writer, _ string) {
+	fmt.Fprintf(w, "cannot request values of type %v by key from value group %q: "+
+		"the group is decorated", e.Type, e.Group)
+}
+
+func (e errDecoratedGroupMember) Format(w fmt.State, c rune) {
+	formatError(e, w, c)
+}
+
+// errMissingGroupMember is returned when a value group has no value with
+// the requested key.
+type errMissingGroupMember struct {
+	Key       key
+	Available []string
+}
+
+var _ digError = errMissingGroupMember{}
+
+func (e errMissingGroupMember) Error() string { return fmt.Sprint(e) }
+
+func (e errMissingGroupMember) writeMessage(w io.Writer, _ string) {
+	fmt.Fprintf(w, "value group %q has no value of type %v with key %q", e.Key.group, e.Key.t, e.Key.name)
+	if len(e.Available) > 0 {
+		fmt.Fprintf(w, " (available keys: %v)", strings.Join(e.Available, ", "))
+	}
+}
+
+func (e errMissingGroupMember) Format(w fmt.State, c rune) {
+	formatError(e, w, c)
+}
+
+// isGroupMember reports whether a dig.In field of the given type with a
+// group tag requests a single value of the group rather than all of them.
+func isGroupMember(t reflect.Type) bool {
+	_, isSeq := seqElem(t)
+	return t.Kind() != reflect.Slice && !isSeq
+}
@@ -0,0 +1,371 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+type memberCodec interface{ Name() string }
+
+type namedCodec string
+
+func (c namedCodec) Name() string { return string(c) }
+
+func TestGroupMembers(t *testing.T) {
+	t.Parallel()
+
+	type selected struct {
+		dig.In
+
+		Codec memberCodec `group:"codecs"`
+	}
+
+	newContainer := func(t *testing.T, calls map[string]int) *digtest.Container {
+		c := digtest.New(t)
+		for _, name := range []string{"json", "proto"} {
+			name := name
+			c.RequireProvide(func() memberCodec {
+				calls[name]++
+				return namedCodec(name)
+			}, dig.Group("codecs"), dig.GroupKey(name))
+		}
+		c.RequireProvide(func() memberCodec {
+			calls["anonymous"]++
+			return namedCodec("anonymous")
+		}, dig.Group("codecs"))
+		return c
+	}
+
+	t.Run("String", func(t *testing.T) {
+		assert.Equal(t, `GroupKey("json")`, fmt.Sprint(dig.GroupKey("json")))
+		assert.Equal(t, `SelectMember("codecs", "json")`, fmt.Sprint(dig.SelectMember("codecs", "json")))
+	})
+
+	t.Run("SelectMember", func(t *testing.T) {
+		calls := make(map[string]int)
+		c := newContainer(t, calls)
+
+		c.RequireInvoke(func(p selected) {
+			assert.Equal(t, "proto", p.Codec.Name())
+		}, dig.SelectMember("codecs", "proto"))
+		assert.Equal(t, map[string]int{"proto": 1}, calls, "only the selected constructor must be called")
+
+		c.RequireInvoke(func(p selected) {
+			assert.Equal(t, "json", p.Codec.Name())
+		}, dig.SelectMember("codecs", "json"))
+	})
+
+	t.Run("key tag", func(t *testing.T) {
+		calls := make(map[string]int)
+		c := newContainer(t, calls)
+
+		c.RequireInvoke(func(p struct {
+			dig.In
+
+			Codec memberCodec `group:"codecs" key:"json"`
+		}) {
+			assert.Equal(t, "json", p.Codec.Name())
+		})
+	})
+
+	t.Run("whole group", func(t *testing.T) {
+		calls := make(map[string]int)
+		c := newContainer(t, calls)
+
+		c.RequireInvoke(func(p struct {
+			dig.In
+
+			Codecs []memberCodec `group:"codecs"`
+		}) {
+			assert.Len(t, p.Codecs, 3)
+		})
+		c.RequireInvoke(func(p selected) {
+			assert.Equal(t, "json", p.Codec.Name())
+		}, dig.SelectMember("codecs", "json"))
+		assert.Equal(t, 1, calls["json"])
+	})
+
+	t.Run("MemberSelector", func(t *testing.T) {
+		calls := make(map[string]int)
+		c := newContainer(t, calls)
+		c.RequireProvide(func() dig.MemberSelector {
+			return func(group string) (string, bool) {
+				return "proto", group == "codecs"
+			}
+		})
+
+		c.RequireInvoke(func(p selected) {
+			assert.Equal(t, "proto", p.Codec.Name())
+		})
+		c.RequireInvoke(func(p selected) {
+			assert.Equal(t, "json", p.Codec.Name())
+		}, dig.SelectMember("codecs", "json"))
+	})
+
+	t.Run("dig.Out key tag", func(t *testing.T) {
+		type out struct {
+			dig.Out
+
+			JSON memberCodec `group:"codecs" key:"json"`
+		}
+
+		c := digtest.New(t)
+		c.RequireProvide(func() out { return out{JSON: namedCodec("json")} })
+		c.RequireInvoke(func(p selected) {
+			assert.Equal(t, "json", p.Codec.Name())
+		}, dig.SelectMember("codecs", "json"))
+	})
+
+	t.Run("nothing selected", func(t *testing.T) {
+		c := newContainer(t, make(map[string]int))
+
+		err := c.Invoke(func(selected) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `no value of type dig_test.memberCodec selected from value group "codecs"`)
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		c := newContainer(t, make(map[string]int))
+
+		err := c.Invoke(func(selected) {}, dig.SelectMember("codecs", "xml"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(),
+			`value group "codecs" has no value of type dig_test.memberCodec with key "xml" (available keys: json, proto)`)
+	})
+
+	t.Run("optional", func(t *testing.T) {
+		c := newContainer(t, make(map[string]int))
+
+		c.RequireInvoke(func(p struct {
+			dig.In
+
+			Codec memberCodec `group:"codecs" key:"xml" optional:"true"`
+		}) {
+			assert.Nil(t, p.Codec)
+		})
+	})
+
+	t.Run("duplicate key", func(t *testing.T) {
+		c := newContainer(t, make(map[string]int))
+
+		err := c.Provide(func() memberCodec { return namedCodec("json2") },
+			dig.Group("codecs"), dig.GroupKey("json"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "already provided")
+	})
+
+	t.Run("GroupKey requires Group", func(t *testing.T) {
+		c := digtest.New(t)
+
+		err := c.Provide(func() memberCodec { return nil }, dig.GroupKey("json"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `cannot use dig.GroupKey("json") without dig.Group`)
+	})
+
+	t.Run("named members", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() memberCodec { return namedCodec("json") },
+			dig.Group("codecs"), dig.Name("json"))
+
+		type out struct {
+			dig.Out
+
+			Codec memberCodec `group:"codecs" name:"proto"`
+		}
+		c.RequireProvide(func() out { return out{Codec: namedCodec("proto")} })
+
+		c.RequireInvoke(func(p struct {
+			dig.In
+
+			JSON   memberCodec   `group:"codecs" name:"json"`
+			Proto  memberCodec   `group:"codecs" key:"proto"`
+			Codecs []memberCodec `group:"codecs"`
+		}) {
+			assert.Equal(t, namedCodec("json"), p.JSON)
+			assert.Equal(t, namedCodec("proto"), p.Proto)
+			assert.ElementsMatch(t, []memberCodec{namedCodec("json"), namedCodec("proto")}, p.Codecs)
+		})
+
+		err := c.Provide(func() memberCodec { return namedCodec("json2") },
+			dig.Group("codecs"), dig.Name("json"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "already provided")
+	})
+
+	t.Run("decorated group", func(t *testing.T) {
+		calls := make(map[string]int)
+		c := newContainer(t, calls)
+		child := c.Scope("child")
+		require.NoError(t, child.Decorate(func(p struct {
+			dig.In
+
+			Codecs []memberCodec `group:"codecs"`
+		}) struct {
+			dig.Out
+
+			Codecs []memberCodec `group:"codecs"`
+		} {
+			return struct {
+				dig.Out
+
+				Codecs []memberCodec `group:"codecs"`
+			}{Codecs: p.Codecs}
+		}))
+
+		// Undecorated in the parent.
+		c.RequireInvoke(func(selected) {}, dig.SelectMember("codecs", "json"))
+
+		err := child.Invoke(func(selected) {}, dig.SelectMember("codecs", "json"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(),
+			`cannot request values of type dig_test.memberCodec by key from value group "codecs": the group is decorated`)
+
+		err = child.Invoke(func(struct {
+			dig.In
+
+			Codecs map[string]memberCodec `group:"codecs"`
+		}) {
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "the group is decorated")
+	})
+
+	t.Run("map", func(t *testing.T) {
+		calls := make(map[string]int)
+		c := newContainer(t, calls)
+
+		type out struct {
+			dig.Out
+
+			Codec memberCodec `group:"codecs" key:"yaml"`
+		}
+		c.RequireProvide(func() out { return out{Codec: namedCodec("yaml")} })
+
+		c.RequireInvoke(func(p struct {
+			dig.In
+
+			Codecs map[string]memberCodec `group:"codecs"`
+		}) {
+			assert.Equal(t, map[string]memberCodec{
+				"json":  namedCodec("json"),
+				"proto": namedCodec("proto"),
+				"yaml":  namedCodec("yaml"),
+			}, p.Codecs)
+		})
+		assert.Equal(t, map[string]int{"json": 1, "proto": 1}, calls,
+			"values without a key must not be built")
+	})
+
+	t.Run("named map types", func(t *testing.T) {
+		type codecName string
+		type codecs map[codecName]memberCodec
+
+		c := newContainer(t, make(map[string]int))
+		c.RequireInvoke(func(p struct {
+			dig.In
+
+			Codecs codecs `group:"codecs"`
+		}) {
+			assert.Equal(t, codecs{
+				"json":  namedCodec("json"),
+				"proto": namedCodec("proto"),
+			}, p.Codecs)
+		})
+	})
+
+	t.Run("empty map", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireInvoke(func(p struct {
+			dig.In
+
+			Codecs map[string]memberCodec `group:"codecs"`
+		}) {
+			assert.NotNil(t, p.Codecs)
+			assert.Empty(t, p.Codecs)
+		})
+	})
+
+	t.Run("map in child Scope", func(t *testing.T) {
+		c := newContainer(t, make(map[string]int))
+		child := c.Scope("child")
+		require.NoError(t, child.Provide(func() memberCodec { return namedCodec("xml") },
+			dig.Group("codecs"), dig.GroupKey("xml")))
+
+		require.NoError(t, child.Invoke(func(p struct {
+			dig.In
+
+			Codecs map[string]memberCodec `group:"codecs"`
+		}) {
+			assert.Len(t, p.Codecs, 3)
+			assert.Equal(t, namedCodec("xml"), p.Codecs["xml"])
+		}))
+	})
+
+	t.Run("map with non-string keys", func(t *testing.T) {
+		c := digtest.New(t)
+
+		err := c.Invoke(func(struct {
+			dig.In
+
+			Codecs map[int]memberCodec `group:"codecs"`
+		}) {
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "value groups may be consumed as maps only with string keys")
+	})
+
+	t.Run("map with key tag", func(t *testing.T) {
+		c := digtest.New(t)
+
+		err := c.Invoke(func(struct {
+			dig.In
+
+			Codecs map[string]memberCodec `group:"codecs" key:"json"`
+		}) {
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot use key tags with value groups consumed as maps")
+	})
+
+	t.Run("map constructor fails", func(t *testing.T) {
+		c := newContainer(t, make(map[string]int))
+		c.RequireProvide(func() (memberCodec, error) {
+			return nil, errors.New("great sadness")
+		}, dig.Group("codecs"), dig.GroupKey("broken"))
+
+		err := c.Invoke(func(struct {
+			dig.In
+
+			Codecs map[string]memberCodec `group:"codecs"`
+		}) {
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "great sadness")
+	})
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"io"
+
+	"go.uber.org/dig/internal/digerror"
+	"go.uber.org/dig/internal/digreflect"
+	"go.uber.org/dig/internal/graph"
+)
+
+// Merge imports the constructors, decorators, and child Scopes of another
+// Container into this one, as if they had been provided to this Container
+// directly. Values already built by the other Container are not imported.
+// The other Container is left unchanged.
+//
+// This is useful for composing libraries that each build their own
+// Container:
+//
+//	c := dig.New()
+//	if err := c.Merge(storage.NewContainer()); err != nil {
+//	  return err
+//	}
+//
+// Merge is atomic: if any constructor conflicts with one already provided
+// to this Container, or if the merged graph would contain a cycle, Merge
+// fails without changing this Container. Conflicts are reported together,
+// along with the locations of both constructors.
+//
+// If the other Container is changed concurrently, Merge imports its wiring
+// as it was at a single point in time.
+func (c *Container) Merge(other *Container) error {
+	// Work from a copy of other taken while holding its lock, before taking
+	// ours, so that other may keep changing and may itself be merging c.
+	snap := other.Clone()
+
+	defer c.scope.lock()()
+
+	if conflicts := c.scope.mergeConflicts(snap.scope); len(conflicts) > 0 {
+		return errMergeConflict(conflicts)
+	}
+
+	// Merge into a clone first so that failures leave c untouched.
+	trial := c.clone()
+	if err := trial.scope.merge(snap.scope); err != nil {
+		return err
+	}
+
+	if err := c.scope.merge(snap.scope); err != nil {
+		digerror.BugPanicf("merge failed after a successful trial: %v", err)
+	}
+	return nil
+}
+
+// merge replays the wiring of other into this root Scope and verifies
+// that the result is acyclic.
+func (s *Scope) merge(other *Scope) error {
+	if _, err := s.replay(other, other.wiring); err != nil {
+		return err
+	}
+
+	for _, scope := range s.appendSubscopes(nil) {
+		if ok, cycle := graph.IsAcyclic(scope.gh); !ok {
+			return newErrInvalidInput("merge introduces a cycle", scope.cycleDetectedError(cycle))
+		}
+		scope.isVerifiedAcyclic = true
+	}
+	return nil
+}
+
+// mergeConflicts reports the constructors of other that would provide
+// values already provided to this root Scope.
+func (s *Scope) mergeConflicts(other *Scope) []mergeConflict {
+	var conflicts []mergeConflict
+	for _, op := range other.wiring {
+		if op.ctor == nil || op.node.removed || (op.scope != other && !op.provideOpts.Exported) {
+			continue
+		}
+		for _, r := range op.node.ResultList().DotResult() {
+			if r.Group != "" {
+				continue
+			}
+			k := key{t: r.Type, name: r.Name}
+			for _, n := range s.providers[k] {
+				conflicts = append(conflicts, mergeConflict{
+					Key:      k,
+					Existing: n.location,
+					Merged:   op.node.location,
+				})
+			}
+		}
+	}
+	return conflicts
+}
+
+// mergeConflict is a value provided by both Containers passed to Merge.
+type mergeConflict struct {
+	Key      key
+	Existing *digreflect.Func
+	Merged   *digreflect.Func
+}
+
+func (mc mergeConflict) String() string {
+	return fmt.Sprintf("%v provided by %v and %v", mc.Key, mc.Existing, mc.Merged)
+}
+
+// errMergeConflict is returned by Merge when both Containers provide the
+// same values.
+type errMergeConflict []mergeConflict
+
+var _ digError = errMergeConflict(nil)
+
+func (e errMergeConflict) Error() string { return fmt.Sprint(e) }
+
+func (e errMergeConflict) writeMessage(w io.Writer, v string) {
+	multiline := v == "%+v"
+
+	io.WriteString(w, "cannot merge containers, values provided by both:")
+	if !multiline {
+		io.WriteString(w, " ")
+	}
+	for i, mc := range e {
+		if multiline {
+			io.WriteString(w, "\n\t- ")
+		} else if i > 0 {
+			io.WriteString(w, "; ")
+		}
+		io.WriteString(w, mc.String())
+	}
+}
+
+func (e errMergeConflict) Format(w fmt.State, c rune) {
+	formatError(e, w, c)
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+
+	type A struct{}
+	type B struct{ A *A }
+	type C struct{ B *B }
+
+	t.Run("success", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{} })
+
+		other := digtest.New(t)
+		other.RequireProvide(func(a *A) *B { return &B{A: a} })
+		other.RequireProvide(func() string { return "x" }, dig.Group("g"))
+		other.Scope("child").RequireProvide(func(b *B) *C { return &C{B: b} }, dig.Export(true))
+		c.RequireProvide(func() string { return "y" }, dig.Group("g"))
+
+		require.NoError(t, c.Merge(other.Container))
+
+		type params struct {
+			dig.In
+
+			C      *C
+			Values []string `group:"g"`
+		}
+		c.RequireInvoke(func(p params) {
+			assert.NotNil(t, p.C.B.A)
+			assert.ElementsMatch(t, []string{"x", "y"}, p.Values)
+		})
+
+		// The other container is left as-is.
+		assert.Error(t, other.Invoke(func(*B) {}))
+	})
+
+	t.Run("conflicts", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{} })
+		c.RequireProvide(func() *B { return &B{} })
+
+		other := digtest.New(t)
+		other.RequireProvide(func() (*A, *B) { return &A{}, &B{} })
+		other.RequireProvide(func() *C { return &C{} })
+
+		err := c.Merge(other.Container)
+		require.Error(t, err)
+		dig.AssertErrorMatches(t, err,
+			`cannot merge containers, values provided by both:`,
+			`\*dig_test.A provided by "go.uber.org/dig_test".TestMerge\S+ \(\S+\) and "go.uber.org/dig_test".TestMerge\S+ \(\S+\)`,
+			`\*dig_test.B provided by "go.uber.org/dig_test".TestMerge\S+ \(\S+\) and "go.uber.org/dig_test".TestMerge\S+ \(\S+\)`,
+		)
+		assert.NotContains(t, fmt.Sprint(err), "*dig_test.C")
+
+		// Nothing was merged.
+		assert.Error(t, c.Invoke(func(*C) {}))
+	})
+
+	t.Run("cycle rolls back", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func(*B) *A { return &A{} })
+
+		other := digtest.New(t)
+		other.RequireProvide(func() *C { return &C{} })
+		other.RequireProvide(func(*A) *B { return &B{} })
+
+		err := c.Merge(other.Container)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cycle")
+
+		assert.Error(t, c.Invoke(func(*C) {}), "nothing must be merged")
+		require.NoError(t, c.Provide(func() *B { return &B{} }))
+		c.RequireInvoke(func(*A) {})
+	})
+
+	t.Run("removed constructors", func(t *testing.T) {
+		other := digtest.New(t)
+		other.RequireProvide(func() *A { return &A{} })
+		require.NoError(t, other.Remove(reflect.TypeOf(&A{})))
+
+		c := digtest.New(t)
+		require.NoError(t, c.Merge(other.Container))
+		assert.Error(t, c.Invoke(func(*A) {}))
+		assert.Error(t, c.Clone().Invoke(func(*A) {}), "removals must be merged too")
+		c.RequireProvide(func() *A { return &A{} })
+	})
+
+	t.Run("other changes concurrently", func(t *testing.T) {
+		c := digtest.New(t, dig.Concurrent())
+		c.RequireProvide(func() *A { return &A{} })
+		other := digtest.New(t, dig.Concurrent())
+		other.RequireProvide(func(a *A) *B { return &B{A: a} })
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				i := i
+				assert.NoError(t, other.Provide(func() int { return i }, dig.Name(fmt.Sprint(i))))
+			}
+		}()
+		require.NoError(t, c.Merge(other.Container))
+		wg.Wait()
+
+		c.RequireInvoke(func(b *B) {
+			assert.NotNil(t, b.A)
+		})
+	})
+}
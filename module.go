@@ -0,0 +1,351 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+)
+
+// Module bundles constructors, values, and decorators under a name so that
+// they can be added to a container together with Use. Errors caused by
+// them name the Module they came from.
+//
+//	var StorageModule = dig.NewModule("storage").
+//	  Provide(NewDB).
+//	  Provide(NewCache, dig.Name("primary")).
+//	  Supply(DefaultConfig).
+//	  Decorate(WithMetrics)
+//
+//	c := dig.New()
+//	if err := c.Use(StorageModule); err != nil {
+//	  return err
+//	}
+//
+// A Module may be used with any number of containers.
+type Module struct {
+	name    string
+	version string
+	ops     []moduleOp
+}
+
+// moduleOp is a single change made to a container by a Module. Exactly
+// one of ctor or dcor is set.
+type moduleOp struct {
+	ctor         interface{}
+	provideOpts  []ProvideOption
+	dcor         interface{}
+	decorateOpts []DecorateOption
+
+	// Non-nil if the operation was invalid when it was added to the
+	// Module. It's reported by Use.
+	err error
+}
+
+// NewModule builds a new, empty Module with the given name.
+func NewModule(name string) *Module {
+	return &Module{name: name}
+}
+
+// Name returns the name of the Module.
+func (m *Module) Name() string {
+	return m.name
+}
+
+// Provide adds a constructor to the Module. It will be provided to the
+// container with the given options by Use. See Container.Provide.
+func (m *Module) Provide(constructor interface{}, opts ...ProvideOption) *Module {
+	m.ops = append(m.ops, moduleOp{ctor: constructor, provideOpts: opts})
+	return m
+}
+
+// Supply adds values to the Module. Each value will be provided to the
+// container under its own type by Use. As with Container.Supply, the
+// values are not closed by the container.
+func (m *Module) Supply(values ...interface{}) *Module {
+	// Attribute the values to the caller of Supply, since the
+	// constructors returning them are generated.
+	pc, _, _, _ := runtime.Caller(1)
+	for _, v := range values {
+		ctor, err := supplyConstructor(v)
+		m.ops = append(m.ops, moduleOp{
+			ctor:        ctor,
+			provideOpts: []ProvideOption{LocationForPC(pc), SkipClose()},
+			err:         err,
+		})
+	}
+	return m
+}
+
+// Decorate adds a decorator to the Module. It will be applied to the
+// container with the given options by Use. See Container.Decorate.
+func (m *Module) Decorate(decorator interface{}, opts ...DecorateOption) *Module {
+	m.ops = append(m.ops, moduleOp{dcor: decorator, decorateOpts: opts})
+	return m
+}
+
+// Use adds the constructors, values, and decorators of the given Modules
+// to the Container, in order. See Scope.Use.
+func (c *Container) Use(modules ...*Module) error {
+	return c.scope.Use(modules...)
+}
+
+// Use adds the constructors, values, and decorators of the given Modules
+// to this Scope, in order. Versioned Modules are checked against the Scope
+// first; see Module.Version.
+//
+// Use is atomic: if any constructor or decorator fails to be added, Use
+// returns an error naming its Module without changing the Scope.
+func (s *Scope) Use(modules ...*Module) error {
+	defer s.lock()()
+
+	// Apply the options of each operation once so that When predicates
+	// are called once, as documented, even though the operations are
+	// used more than once below.
+	calls := make([][]moduleCall, len(modules))
+	var all []moduleCall
+	for i, m := range modules {
+		calls[i] = m.calls()
+		all = append(all, calls[i]...)
+	}
+
+	for _, m := range modules {
+		if m.version != "" {
+			if err := s.checkModuleSchemas(modules, calls); err != nil {
+				return err
+			}
+			break
+		}
+	}
+
+	// Undo the calls made so far if one of them fails. ProvideInfo,
+	// DecorateInfo, and ProviderHandles are filled only once all calls
+	// have succeeded.
+	root := s.rootScope()
+	cp := s.newWiringCheckpoint()
+	root.checkpoint = cp
+	defer func() { root.checkpoint = nil }()
+
+	fills := make([]func(), 0, len(all))
+	for _, mc := range all {
+		start := len(root.wiring)
+		if err := s.useCall(mc); err != nil {
+			cp.rollback()
+			return errModuleFailed{Module: mc.module, Reason: err}
+		}
+		if len(root.wiring) > start {
+			fills = append(fills, mc.fill(root.wiring[len(root.wiring)-1], root.generation))
+		}
+	}
+	for _, fill := range fills {
+		fill()
+	}
+	return nil
+}
+
+// calls returns the operations of the Module with their options applied.
+func (m *Module) calls() []moduleCall {
+	calls := make([]moduleCall, len(m.ops))
+	for i, op := range m.ops {
+		calls[i] = newModuleCall(m.name, op)
+	}
+	return calls
+}
+
+// moduleCall is an operation of a Module with its options applied.
+type moduleCall struct {
+	module       string
+	op           moduleOp
+	provideOpts  provideOptions
+	decorateOpts decorateOptions
+}
+
+func newModuleCall(module string, op moduleOp) moduleCall {
+	mc := moduleCall{module: module, op: op}
+	if op.err != nil {
+		return mc
+	}
+	if op.dcor != nil {
+		mc.decorateOpts.Module = module
+		for _, o := range op.decorateOpts {
+			o.apply(&mc.decorateOpts)
+		}
+		return mc
+	}
+
+	mc.provideOpts.Module = module
+	for _, o := range op.provideOpts {
+		o.applyProvideOption(&mc.provideOpts)
+	}
+	if len(mc.provideOpts.When) > 0 {
+		holds := mc.provideOpts.conditionsHold()
+		mc.provideOpts.When = []func() bool{func() bool { return holds }}
+	}
+	return mc
+}
+
+// useCalls makes the given calls to this Scope, stopping at the first one
+// that fails. The container must be locked.
+func (s *Scope) useCalls(calls []moduleCall) error {
+	for _, mc := range calls {
+		if err := s.useCall(mc); err != nil {
+			return errModuleFailed{Module: mc.module, Reason: err}
+		}
+	}
+	return nil
+}
+
+// useCall makes the given call to this Scope without filling its
+// ProvideInfo, DecorateInfo, or ProviderHandle; see moduleCall.fill.
+func (s *Scope) useCall(mc moduleCall) error {
+	if mc.op.err != nil {
+		return mc.op.err
+	}
+	if mc.op.dcor != nil {
+		opts := mc.decorateOpts
+		opts.Info = nil
+		return s.decorate(mc.op.dcor, opts)
+	}
+	opts := mc.provideOpts
+	opts.Info = nil
+	opts.Handle = nil
+	return s.provideWithOptions(mc.op.ctor, opts)
+}
+
+// fill returns a function that fills the ProvideInfo, DecorateInfo, or
+// ProviderHandle of the call given the change it made to the wiring and
+// the generation of the container after it.
+func (mc moduleCall) fill(op wiringOp, generation uint64) func() {
+	return func() {
+		if info := mc.decorateOpts.Info; info != nil && op.dnode != nil {
+			*info = newDecorateInfo(op.dnode, generation)
+		}
+		if info := mc.provideOpts.Info; info != nil && op.node != nil {
+			*info = newProvideInfo(op.node)
+		}
+		if h := mc.provideOpts.Handle; h != nil && op.node != nil {
+			h.n = op.node
+		}
+	}
+}
+
+// wiringCheckpoint records the wiring of a container so that changes made
+// to it afterwards can be undone. The slices held by Scopes are only
+// appended to or replaced, so they're saved when the checkpoint is taken.
+// Map entries are saved with saveEntry by the changes that modify them.
+type wiringCheckpoint struct {
+	root   *Scope
+	wiring int
+	scopes []scopeCheckpoint
+
+	// Functions restoring map entries, in the order they were saved.
+	undos []func()
+}
+
+type scopeCheckpoint struct {
+	s                 *Scope
+	nodes             []*constructorNode
+	decoratorNodes    []*decoratorNode
+	graphOrder        int
+	isVerifiedAcyclic bool
+}
+
+// newWiringCheckpoint records the wiring of the container this Scope
+// belongs to, including this Scope if it's a request Scope.
+func (s *Scope) newWiringCheckpoint() *wiringCheckpoint {
+	root := s.rootScope()
+	scopes := root.appendSubscopes(nil)
+	if s.request {
+		scopes = append(scopes, s)
+	}
+
+	cp := &wiringCheckpoint{
+		root:   root,
+		wiring: len(root.wiring),
+		scopes: make([]scopeCheckpoint, len(scopes)),
+	}
+	for i, s := range scopes {
+		cp.scopes[i] = scopeCheckpoint{
+			s:                 s,
+			nodes:             s.nodes,
+			decoratorNodes:    s.decoratorNodes,
+			graphOrder:        s.gh.Order(),
+			isVerifiedAcyclic: s.isVerifiedAcyclic,
+		}
+	}
+	return cp
+}
+
+// saveEntry saves the entry for k of a map held by a Scope of the given
+// container, if a checkpoint is being kept, so that it can be restored.
+// Maps held by the root Scope must be allocated first.
+func saveEntry[V any](s *Scope, m map[key]V, k key) {
+	cp := s.rootScope().checkpoint
+	if cp == nil {
+		return
+	}
+	old, ok := m[k]
+	cp.undos = append(cp.undos, func() {
+		if ok {
+			m[k] = old
+		} else {
+			delete(m, k)
+		}
+	})
+}
+
+// rollback undoes the changes made to the wiring since the checkpoint was
+// taken. The generation of the container is left as is.
+func (cp *wiringCheckpoint) rollback() {
+	for i := len(cp.undos) - 1; i >= 0; i-- {
+		cp.undos[i]()
+	}
+	for _, sc := range cp.scopes {
+		s := sc.s
+		s.nodes = sc.nodes
+		s.decoratorNodes = sc.decoratorNodes
+		s.gh.nodes = s.gh.nodes[:sc.graphOrder]
+		s.isVerifiedAcyclic = sc.isVerifiedAcyclic
+	}
+	cp.root.wiring = cp.root.wiring[:cp.wiring]
+}
+
+// errModuleFailed is returned when a Module could not be added to a
+// container.
+type errModuleFailed struct {
+	Module string
+	Reason error
+}
+
+var _ digError = errModuleFailed{}
+
+func (e errModuleFailed) Error() string { return fmt.Sprint(e) }
+
+func (e errModuleFailed) Unwrap() error { return e.Reason }
+
+func (e errModuleFailed) writeMessage(w io.Writer, _ string) {
+	fmt.Fprintf(w, "cannot use module %q", e.Module)
+}
+
+func (e errModuleFailed) Format(w fmt.State, c rune) {
+	formatError(e, w, c)
+}
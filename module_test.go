@@ -0,0 +1,207 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestModule(t *testing.T) {
+	t.Parallel()
+
+	type config struct{ Addr string }
+	type server struct{ Addr string }
+
+	t.Run("provide, supply, and decorate", func(t *testing.T) {
+		t.Parallel()
+
+		m := dig.NewModule("http").
+			Supply(&config{Addr: ":80"}).
+			Provide(func(cfg *config) *server { return &server{Addr: cfg.Addr} }).
+			Decorate(func(s *server) *server { return &server{Addr: "localhost" + s.Addr} })
+		assert.Equal(t, "http", m.Name())
+
+		c := digtest.New(t)
+		require.NoError(t, c.Use(m))
+		c.RequireInvoke(func(s *server) {
+			assert.Equal(t, "localhost:80", s.Addr)
+		})
+	})
+
+	t.Run("reusable across containers", func(t *testing.T) {
+		t.Parallel()
+
+		m := dig.NewModule("config").Supply(&config{Addr: ":80"})
+		for i := 0; i < 2; i++ {
+			c := digtest.New(t)
+			require.NoError(t, c.Use(m))
+			c.RequireInvoke(func(*config) {})
+		}
+	})
+
+	t.Run("provide error names module", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		err := c.Use(dig.NewModule("broken").Provide("not a function"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `cannot use module "broken"`)
+	})
+
+	t.Run("untyped nil supply", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		err := c.Use(dig.NewModule("nil").Supply(nil))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `cannot use module "nil"`)
+		assert.Contains(t, err.Error(), "cannot supply an untyped nil")
+	})
+
+	t.Run("constructor error names module", func(t *testing.T) {
+		t.Parallel()
+
+		giveErr := errors.New("great sadness")
+		c := digtest.New(t)
+		require.NoError(t, c.Use(dig.NewModule("http").
+			Provide(func() (*server, error) { return nil, giveErr })))
+
+		err := c.Invoke(func(*server) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `in module "http"`)
+		assert.True(t, errors.Is(err, giveErr))
+	})
+
+	t.Run("missing dependency names module", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		require.NoError(t, c.Use(dig.NewModule("http").
+			Provide(func(*config) *server { return nil })))
+
+		err := c.Invoke(func(*server) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `in module "http"`)
+	})
+
+	t.Run("decorator error names module", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		require.NoError(t, c.Use(dig.NewModule("config").
+			Supply(&config{}).
+			Decorate(func(*config) (*config, error) { return nil, errors.New("great sadness") })))
+
+		err := c.Invoke(func(*config) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `in module "config"`)
+	})
+
+	t.Run("failures leave the container untouched", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			info   dig.ProvideInfo
+			handle dig.ProviderHandle
+			calls  int
+		)
+		c := digtest.New(t)
+		err := c.Use(
+			dig.NewModule("config").
+				Supply(&config{}).
+				Provide(func(*config) *server { return &server{} },
+					dig.FillProvideInfo(&info), dig.FillProviderHandle(&handle),
+					dig.When(func() bool { calls++; return true })),
+			dig.NewModule("broken").Provide("not a function"),
+		)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `cannot use module "broken"`)
+		assert.Equal(t, 1, calls, "predicate must be called once")
+		assert.Empty(t, info.Outputs)
+		assert.False(t, handle.Enabled())
+		assert.Empty(t, c.Providers())
+
+		require.NoError(t, c.Use(dig.NewModule("config").Supply(&config{})))
+	})
+
+	t.Run("failures restore overridden constructors", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.AllowOverride())
+		c.RequireProvide(func() *config { return &config{Addr: ":80"} })
+		child := c.Scope("child")
+		gen := c.Generation()
+
+		var info dig.DecorateInfo
+		err := c.Use(
+			dig.NewModule("override").
+				Provide(func() *config { return &config{Addr: ":8080"} }).
+				Provide(func(cfg *config) *server { return &server{Addr: cfg.Addr} }, dig.Export(true)).
+				Decorate(func(s *server) *server { return s }, dig.FillDecorateInfo(&info)),
+			dig.NewModule("broken").Provide(func(*server) *config { return nil }),
+		)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `cannot use module "broken"`)
+		assert.Empty(t, info.Outputs)
+		assert.Len(t, c.Providers(), 1)
+		assert.Empty(t, c.Decorators())
+		assert.Empty(t, c.InspectSnapshot().Overridden)
+		assert.Greater(t, c.Generation(), gen, "generations are never reused")
+
+		c.RequireInvoke(func(cfg *config) {
+			assert.Equal(t, ":80", cfg.Addr)
+		})
+		require.Error(t, child.Invoke(func(*server) {}))
+
+		require.NoError(t, c.Use(dig.NewModule("server").
+			Provide(func(cfg *config) *server { return &server{Addr: cfg.Addr} }).
+			Decorate(func(s *server) *server { return s }, dig.FillDecorateInfo(&info))))
+		assert.Len(t, info.Outputs, 1)
+		assert.Equal(t, c.Generation(), info.Generation)
+		c.RequireInvoke(func(s *server) {
+			assert.Equal(t, ":80", s.Addr)
+		})
+	})
+
+	t.Run("failures leave request scopes untouched", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() *config { return &config{Addr: ":80"} })
+
+		r := c.Request()
+		err := r.Use(
+			dig.NewModule("local").Decorate(func(*config) *config { return &config{Addr: ":8080"} }),
+			dig.NewModule("broken").Provide(func() string { return "" }),
+		)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot provide to a request Scope")
+		require.NoError(t, r.Invoke(func(cfg *config) {
+			assert.Equal(t, ":80", cfg.Addr)
+		}))
+	})
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import "sort"
+
+// AllowOverride is an Option intended for tests that lets a constructor be
+// provided for values that already have one in the same Scope, replacing
+// the earlier constructor as with Replace instead of failing.
+//
+//	c := app.NewContainer(dig.AllowOverride())
+//	c.Provide(newFakeClock) // replaces the real clock
+//
+// The values whose constructors were replaced this way are listed in
+// Snapshot.Overridden.
+func AllowOverride() Option {
+	return allowOverrideOption{}
+}
+
+type allowOverrideOption struct{}
+
+func (allowOverrideOption) String() string {
+	return "AllowOverride()"
+}
+
+func (allowOverrideOption) applyOption(c *Container) {
+	c.scope.allowOverride = true
+}
+
+// overriddenKeys returns the values provided by n that already have a
+// constructor in this Scope that n would conflict with, if the container
+// was built with AllowOverride.
+func (s *Scope) overriddenKeys(n *constructorNode) []key {
+	if !s.rootScope().allowOverride {
+		return nil
+	}
+
+	var keys []key
+	for _, k := range resultKeys(n) {
+		if k.group != "" {
+			continue
+		}
+		for _, p := range s.providers[k] {
+			if p.conflictsWith(n.fallback, n.priority) {
+				keys = append(keys, k)
+				break
+			}
+		}
+	}
+	return keys
+}
+
+// recordOverrides records that the constructors of the given values were
+// replaced through AllowOverride.
+func (s *Scope) recordOverrides(keys []key) {
+	root := s.rootScope()
+	if root.overridden == nil {
+		root.overridden = make(map[key]struct{})
+	}
+	for _, k := range keys {
+		saveEntry(s, root.overridden, k)
+		root.overridden[k] = struct{}{}
+	}
+}
+
+// snapshotOverrides lists the values recorded by recordOverrides.
+func (s *Scope) snapshotOverrides() []*Output {
+	var outputs []*Output
+	for k := range s.rootScope().overridden {
+		outputs = append(outputs, &Output{t: k.t, name: k.name})
+	}
+	sort.Slice(outputs, func(i, j int) bool {
+		return outputs[i].String() < outputs[j].String()
+	})
+	return outputs
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestAllowOverride(t *testing.T) {
+	t.Parallel()
+
+	t.Run("replaces earlier constructor", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.AllowOverride())
+		c.RequireProvide(func() string { return "real" })
+		c.RequireProvide(func() int { return 1 })
+		c.RequireProvide(func() string { return "fake" })
+		c.RequireProvide(func() string { return "fake" }, dig.Name("n"))
+
+		c.RequireInvoke(func(s string, i int) {
+			assert.Equal(t, "fake", s)
+			assert.Equal(t, 1, i)
+		})
+
+		overridden := c.InspectSnapshot().Overridden
+		require.Len(t, overridden, 1)
+		assert.Equal(t, "string", overridden[0].String())
+
+		clone := c.Clone()
+		assert.Len(t, clone.InspectSnapshot().Overridden, 1)
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() string { return "real" })
+		err := c.Provide(func() string { return "fake" })
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "already provided by")
+		assert.Empty(t, c.InspectSnapshot().Overridden)
+	})
+
+	t.Run("failed override", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.AllowOverride())
+		c.RequireProvide(func() string { return "real" })
+		c.RequireInvoke(func(string) {})
+
+		err := c.Provide(func() string { return "fake" })
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "it has already been built")
+		assert.Empty(t, c.InspectSnapshot().Overridden)
+	})
+
+	t.Run("String", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "AllowOverride()", fmt.Sprint(dig.AllowOverride()))
+	})
+}
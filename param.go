@@ -163,6 +163,10 @@ type paramSingle struct {
 	Name     string
 	Optional bool
 	Type     reflect.Type
+
+	// Value used instead of the zero value if this optional parameter is
+	// absent, as specified in the `default:".."` tag.
+	Default reflect.Value
 }
 
 func (ps paramSingle) DotParam() []*dot.Param {
@@ -233,6 +237,17 @@ func (ps paramSingle) buildWithDecorators(c containerStore) (v reflect.Value, fo
 	if !found || d == nil {
 		return _noValue, false, nil
 	}
+	if ps.isUnshared(c, decoratingScope) {
+		receiver, err := d.Produce(decoratingScope)
+		if err != nil {
+			return _noValue, found, errParamSingleFailed{
+				CtorID: 1,
+				Key:    key{t: ps.Type, name: ps.Name},
+				Reason: err,
+			}
+		}
+		return receiver.values[key{t: ps.Type, name: ps.Name}], found, nil
+	}
 	if err = d.Call(decoratingScope); err != nil {
 		v, err = _noValue, errParamSingleFailed{
 			CtorID: 1,
@@ -245,7 +260,48 @@ func (ps paramSingle) buildWithDecorators(c containerStore) (v reflect.Value, fo
 	return
 }
 
+// isUnshared reports whether the value of this parameter is built anew for
+// each consumer, as seen from the Scope decorating it: by a transient
+// constructor, or by a request-scoped one if the decorating Scope isn't a
+// request Scope. Decorated values of such parameters are not memoized.
+func (ps paramSingle) isUnshared(c, decoratingScope containerStore) bool {
+	for _, s := range c.storesToRoot() {
+		providers := s.getValueProviders(ps.Name, ps.Type)
+		if len(providers) == 0 {
+			continue
+		}
+		n := providers[0]
+		return n.Transient() || (n.RequestScoped() && decoratingScope.requestScope() == nil)
+	}
+	return false
+}
+
+// isDisabled reports whether all providers of this parameter were disabled
+// with ProviderHandle.Disable.
+func (ps paramSingle) isDisabled(c containerStore) bool {
+	return len(c.getAllValueProviders(ps.Name, ps.Type)) == 0 &&
+		len(findDisabledProviders(c, key{name: ps.Name, t: ps.Type})) > 0
+}
+
 func (ps paramSingle) Build(c containerStore) (reflect.Value, error) {
+	defer c.pushResolveFrame(resolveFrame{Key: key{t: ps.Type, name: ps.Name}})()
+	if err := c.checkResolutionDepth(); err != nil {
+		return _noValue, err
+	}
+	if err := c.checkLazyCycle(); err != nil {
+		return _noValue, err
+	}
+	c.recordAccess(key{t: ps.Type, name: ps.Name})
+
+	// Disabled providers behave as if they were never provided.
+	if ps.isDisabled(c) {
+		if ps.Optional {
+			ps.recordAbsent(c, nil)
+			return ps.absentValue(), nil
+		}
+		return _noValue, newErrMissingTypes(c, key{name: ps.Name, t: ps.Type})
+	}
+
 	v, found, err := ps.buildWithDecorators(c)
 	if found {
 		return v, err
@@ -265,25 +321,64 @@ func (ps paramSingle) Build(c containerStore) (reflect.Value, error) {
 	var providers []provider
 	var providingContainer containerStore
 	for _, container := range c.storesToRoot() {
-		// first check if the scope already has cached a value for the type.
-		if v, ok := container.getValue(ps.Name, ps.Type); ok {
-			return v, nil
-		}
 		providers = container.getValueProviders(ps.Name, ps.Type)
-		if len(providers) > 0 {
-			providingContainer = container
-			break
+		if len(providers) == 0 {
+			continue
+		}
+
+		// Check if the scope already has cached a value for the type.
+		if v, ok := container.getValue(ps.Name, ps.Type); ok {
+			return ps.await(container, providers[0], v)
 		}
+		providingContainer = container
+		break
 	}
 
 	if len(providers) == 0 {
+		if v, ok := ps.implicitContext(c); ok {
+			return v, nil
+		}
+		if v, ok := ps.callInfo(c); ok {
+			return v, nil
+		}
+		if v, ok := ps.lazy(c); ok {
+			return v, nil
+		}
+		if k, ok := c.inferredKey(ps.Name, ps.Type); ok {
+			recordDegradation(c, Degradation{
+				Kind:       DegradedSubstitute,
+				Type:       ps.Type,
+				Name:       ps.Name,
+				Substitute: k.String(),
+			})
+			return ps.buildInferred(c, k)
+		}
+		if k, ok := c.equivalentKey(ps.Name, ps.Type); ok {
+			return ps.buildEquivalent(c, k)
+		}
 		if ps.Optional {
-			return reflect.Zero(ps.Type), nil
+			ps.recordAbsent(c, nil)
+			return ps.absentValue(), nil
+		}
+		if c.isExtern(ps.Name, ps.Type) {
+			return _noValue, errExternNotSupplied{Key: key{name: ps.Name, t: ps.Type}}
+		}
+		if v, ok := c.stub(ps.Name, ps.Type); ok {
+			recordDegradation(c, Degradation{
+				Kind: DegradedStub,
+				Type: ps.Type,
+				Name: ps.Name,
+			})
+			return v, nil
 		}
 		return _noValue, newErrMissingTypes(c, key{name: ps.Name, t: ps.Type})
 	}
 
 	for _, n := range providers {
+		if n.Transient() || n.RequestScoped() {
+			return ps.produce(c, n)
+		}
+
 		err := n.Call(n.OrigScope())
 		if err == nil {
 			continue
@@ -292,7 +387,8 @@ func (ps paramSingle) Build(c containerStore) (reflect.Value, error) {
 		// If we're missing dependencies but the parameter itself is optional,
 		// we can just move on.
 		if _, ok := err.(errMissingDependencies); ok && ps.Optional {
-			return reflect.Zero(ps.Type), nil
+			ps.recordAbsent(c, err)
+			return ps.absentValue(), nil
 		}
 
 		return _noValue, errParamSingleFailed{
@@ -305,9 +401,128 @@ func (ps paramSingle) Build(c containerStore) (reflect.Value, error) {
 	// If we get here, it's impossible for the value to be absent from the
 	// container.
 	v, _ = providingContainer.getValue(ps.Name, ps.Type)
+	return ps.await(providingContainer, providers[0], v)
+}
+
+// await waits for the given value built for this parameter by the given
+// provider if it's a Future. See Future.
+func (ps paramSingle) await(store containerWriter, n provider, v reflect.Value) (reflect.Value, error) {
+	k := key{t: ps.Type, name: ps.Name}
+	v, err := awaitValue(store, k, v)
+	if err != nil {
+		return _noValue, errParamSingleFailed{
+			CtorID: n.ID(),
+			Key:    k,
+			Reason: errConstructorFailed{Func: n.Location(), Reason: err},
+		}
+	}
 	return v, nil
 }
 
+// implicitContext returns the context of the innermost InvokeContext being
+// resolved, or else of the Scope that this parameter is built in, if this
+// is an unnamed context.Context parameter without a provider. See
+// InvokeContext and ScopeContext.
+func (ps paramSingle) implicitContext(c containerStore) (reflect.Value, bool) {
+	if ps.Name != "" || ps.Type != _contextType {
+		return _noValue, false
+	}
+	ctx := c.resolutionPath().context()
+	if ctx == nil {
+		var ok bool
+		if ctx, ok = c.scopeContext(); !ok {
+			return _noValue, false
+		}
+	}
+	return reflect.ValueOf(&ctx).Elem(), true
+}
+
+// absentValue returns the value of this optional parameter if it's absent:
+// its default value, if any, or else the zero value.
+func (ps paramSingle) absentValue() reflect.Value {
+	if ps.Default.IsValid() {
+		return ps.Default
+	}
+	return reflect.Zero(ps.Type)
+}
+
+// recordAbsent records that this optional parameter was absent for the
+// given reason, if any. See FillDegradationReport.
+func (ps paramSingle) recordAbsent(c containerStore, reason error) {
+	recordDegradation(c, Degradation{
+		Kind:   DegradedOptional,
+		Type:   ps.Type,
+		Name:   ps.Name,
+		Reason: reason,
+	})
+}
+
+// buildInferred builds this interface parameter from the value with the
+// given key, the only one implementing it. See InferInterfaces.
+func (ps paramSingle) buildInferred(c containerStore, k key) (reflect.Value, error) {
+	// Inferred dependencies are not part of the graph, so cycles through
+	// them must be caught while resolving.
+	for _, f := range c.resolutionPath() {
+		if f.Key == k {
+			return _noValue, errResolveCycle(fmt.Sprintf(
+				"%v inferred from %v, which is already being built", ps.Type, k))
+		}
+	}
+
+	v, err := paramSingle{Name: k.name, Type: k.t}.Build(c)
+	if err != nil {
+		return _noValue, err
+	}
+
+	iv := reflect.New(ps.Type).Elem()
+	iv.Set(v)
+	return iv, nil
+}
+
+// produce builds this parameter with a provider whose values are not
+// memoized in the Scope it was provided to: either a transient provider,
+// or a request-scoped one whose values are memoized in the nearest request
+// Scope instead.
+func (ps paramSingle) produce(c containerStore, n provider) (reflect.Value, error) {
+	k := key{t: ps.Type, name: ps.Name}
+
+	s := n.OrigScope()
+	if n.RequestScoped() {
+		s = c.requestScope()
+		if s == nil {
+			return _noValue, errParamSingleFailed{
+				CtorID: n.ID(),
+				Key:    k,
+				Reason: errRequestScopeRequired{Key: k, Func: n.Location()},
+			}
+		}
+		if v, ok := s.getValue(ps.Name, ps.Type); ok {
+			return ps.await(s, n, v)
+		}
+	}
+
+	receiver, err := n.Produce(s)
+	if err != nil {
+		// If we're missing dependencies but the parameter itself is optional,
+		// we can just move on.
+		if _, ok := err.(errMissingDependencies); ok && ps.Optional {
+			ps.recordAbsent(c, err)
+			return ps.absentValue(), nil
+		}
+		return _noValue, errParamSingleFailed{
+			CtorID: n.ID(),
+			Key:    k,
+			Reason: err,
+		}
+	}
+
+	if n.RequestScoped() {
+		receiver.Commit(s)
+		return ps.await(s, n, receiver.values[k])
+	}
+	return ps.await(receiver, n, receiver.values[k])
+}
+
 // paramObject is a dig.In struct where each field is another param.
 //
 // This object is not expected in the graph as-is.
@@ -346,6 +561,10 @@ func getParamOrder(gh *graphHolder, param param) []int {
 		// value group parameters have nodes of their own.
 		// We can directly return that here.
 		orders = append(orders, p.orders[gh.s])
+	case paramGroupMember:
+		for _, provider := range p.providers(gh.s) {
+			orders = append(orders, provider.Order(gh.s))
+		}
 	case paramObject:
 		for _, pf := range p.Fields {
 			orders = append(orders, getParamOrder(gh, pf.Param)...)
@@ -449,6 +668,13 @@ func newParamObjectField(idx int, f reflect.StructField, c containerStore) (para
 		return pof, newErrInvalidInput(
 			fmt.Sprintf("unexported fields not allowed in dig.In, did you mean to export %q (%v)?", f.Name, f.Type), nil)
 
+	case f.Tag.Get(_groupTag) != "" && isGroupMember(f.Type):
+		var err error
+		p, err = newParamGroupMember(f)
+		if err != nil {
+			return pof, err
+		}
+
 	case f.Tag.Get(_groupTag) != "":
 		var err error
 		p, err = newParamGroupedSlice(f, c)
@@ -472,6 +698,10 @@ func newParamObjectField(idx int, f reflect.StructField, c containerStore) (para
 		if err != nil {
 			return pof, err
 		}
+		ps.Default, err = fieldDefault(f, ps.Optional)
+		if err != nil {
+			return pof, err
+		}
 
 		p = ps
 	}
@@ -497,16 +727,27 @@ type paramGroupedSlice struct {
 	// Type of the slice.
 	Type reflect.Type
 
+	// Type of the iter.Seq that the group is consumed as, if it isn't
+	// consumed as a slice.
+	Seq reflect.Type
+
 	// Soft is used to denote a soft dependency between this param and its
 	// constructors, if it's true its constructors are only called if they
 	// provide another value requested in the graph
 	Soft bool
 
+	// Labels that values must have to be included, as specified in the
+	// `labels:".."` tag. See GroupLabels.
+	Labels []string
+
 	orders map[*Scope]int
 }
 
 func (pt paramGroupedSlice) String() string {
 	// io.Reader[group="foo"] refers to a group of io.Readers called 'foo'
+	if len(pt.Labels) > 0 {
+		return fmt.Sprintf("%v[group=%q, labels=%q]", pt.Type.Elem(), pt.Group, strings.Join(pt.Labels, ","))
+	}
 	return fmt.Sprintf("%v[group=%q]", pt.Type.Elem(), pt.Group)
 }
 
@@ -536,11 +777,18 @@ func newParamGroupedSlice(f reflect.StructField, c containerStore) (paramGrouped
 		orders: make(map[*Scope]int),
 		Soft:   g.Soft,
 	}
+	if elem, ok := seqElem(f.Type); ok {
+		pg.Type = reflect.SliceOf(elem)
+		pg.Seq = f.Type
+	}
+	if pg.Labels, err = parseLabels(f.Tag.Get(_labelsTag)); err != nil {
+		return pg, err
+	}
 
 	name := f.Tag.Get(_nameTag)
 	optional, _ := isFieldOptional(f)
 	switch {
-	case f.Type.Kind() != reflect.Slice:
+	case pg.Type.Kind() != reflect.Slice:
 		return pg, newErrInvalidInput(
 			fmt.Sprintf("value groups may be consumed as slices only: field %q (%v) is not a slice", f.Name, f.Type), nil)
 	case g.Flatten:
@@ -563,7 +811,7 @@ func newParamGroupedSlice(f reflect.StructField, c containerStore) (paramGrouped
 func (pt paramGroupedSlice) getDecoratedValues(c containerStore) (reflect.Value, bool) {
 	for _, c := range c.storesToRoot() {
 		if items, ok := c.getDecoratedValueGroup(pt.Group, pt.Type); ok {
-			return items, true
+			return copySlice(items), true
 		}
 	}
 	return _noValue, false
@@ -619,6 +867,27 @@ func (pt paramGroupedSlice) callGroupProviders(c containerStore) (int, error) {
 }
 
 func (pt paramGroupedSlice) Build(c containerStore) (reflect.Value, error) {
+	v, err := pt.build(c)
+	if err != nil || pt.Seq == nil {
+		return v, err
+	}
+	if list := c.liveGroup(pt.Group, pt.Type); list != nil && len(pt.Labels) == 0 {
+		return makeLiveSeq(pt.Seq, list), nil
+	}
+	return makeSeq(pt.Seq, v), nil
+}
+
+func (pt paramGroupedSlice) build(c containerStore) (reflect.Value, error) {
+	defer c.pushResolveFrame(resolveFrame{Key: key{t: pt.Type.Elem(), group: pt.Group}})()
+	if err := c.checkResolutionDepth(); err != nil {
+		return _noValue, err
+	}
+	c.recordAccess(key{t: pt.Type.Elem(), group: pt.Group})
+
+	if len(pt.Labels) > 0 {
+		return pt.buildLabeled(c)
+	}
+
 	// do not call this if we are already inside a decorator since
 	// it will result in an infinite recursion. (i.e. decorate -> params.BuildList() -> Decorate -> params.BuildList...)
 	// this is safe since a value can be decorated at most once in a given scope.
@@ -647,6 +916,9 @@ func (pt paramGroupedSlice) Build(c containerStore) (reflect.Value, error) {
 	for _, c := range stores {
 		result = reflect.Append(result, c.getValueGroup(pt.Group, pt.Type.Elem())...)
 	}
+	if result.Len() == 0 && !pt.Soft && c.isStrict() {
+		return _noValue, errEmptyGroup{Key: key{group: pt.Group, t: pt.Type.Elem()}}
+	}
 	return result, nil
 }
 
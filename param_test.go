@@ -186,14 +186,24 @@ func TestParamGroupSliceErrors(t *testing.T) {
 		wantErr string
 	}{
 		{
-			desc: "non-slice type are disallowed",
+			desc: "no flatten for single values",
 			shape: struct {
 				In
 
-				Foo string `group:"foo"`
+				Foo string `group:"foo,flatten"`
 			}{},
-			wantErr: "value groups may be consumed as slices only: " +
-				`field "Foo" (string) is not a slice`,
+			wantErr: "cannot use flatten or soft when requesting a single value of a value group: " +
+				`field "Foo" (string)`,
+		},
+		{
+			desc: "cannot use different name and key for a single value",
+			shape: struct {
+				In
+
+				Foo string `group:"foo" name:"bar" key:"baz"`
+			}{},
+			wantErr: "cannot use different names and keys for values of value groups: " +
+				`name:"bar" and key:"baz" requested with group "foo"`,
 		},
 		{
 			desc: "cannot provide name for a group",
@@ -0,0 +1,128 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// A ValueStore saves values built by constructors provided with Persist so
+// that they can be loaded instead of being built again, for example by
+// the next run of the same program. How values are encoded is up to the
+// ValueStore.
+type ValueStore interface {
+	// Load decodes the value saved under the given key into the value
+	// pointed to by ptr. It reports false if no value was saved under the
+	// key.
+	Load(key string, ptr interface{}) (ok bool, err error)
+
+	// Save saves the given value under the given key.
+	Save(key string, value interface{}) error
+}
+
+// Persist is a ProvideOption that saves the values built by a constructor
+// to the given ValueStore, and loads them from it instead of calling the
+// constructor when they were saved before. It's meant for expensive
+// values that only depend on their inputs, such as parsed schemas or
+// compiled templates.
+//
+//	c.Provide(ParseSchema, dig.Persist(store, "schema/v3"))
+//
+// Each value is saved under the given id followed by "/" and its key,
+// such as "schema/v3/*schema.Schema". Change the id to discard values
+// saved by an incompatible version of the constructor.
+//
+// When the values are loaded, neither the constructor nor its
+// dependencies are called, and a cleanup function that the constructor
+// would have returned is not run. Values that implement io.Closer are
+// still closed with the container unless SkipClose is used.
+//
+// Persist cannot be used with value groups, interface types, or with
+// Transient or RequestScoped constructors.
+func Persist(store ValueStore, id string) ProvideOption {
+	return persistOption{store: store, id: id}
+}
+
+type persistOption struct {
+	store ValueStore
+	id    string
+}
+
+func (o persistOption) String() string {
+	return fmt.Sprintf("Persist(%v, %q)", o.store, o.id)
+}
+
+func (o persistOption) applyProvideOption(opts *provideOptions) {
+	opts.Persist = &o
+}
+
+// checkPersist returns an error if the values of this node can't be
+// persisted.
+func (n *constructorNode) checkPersist() error {
+	for _, k := range resultKeys(n) {
+		if k.group != "" {
+			return newErrInvalidInput(
+				fmt.Sprintf("cannot use dig.Persist with value groups: %v provides %v", n.ctype, k), nil)
+		}
+		if k.t.Kind() == reflect.Interface {
+			return newErrInvalidInput(
+				fmt.Sprintf("cannot use dig.Persist with interface types: %v provides %v", n.ctype, k), nil)
+		}
+	}
+	return nil
+}
+
+func (o *persistOption) key(k key) string {
+	return o.id + "/" + k.String()
+}
+
+// restore loads the values of this node from its ValueStore. It reports
+// false if any of them wasn't saved.
+func (n *constructorNode) restore() (*stagingContainerWriter, bool, error) {
+	receiver := newStagingContainerWriter()
+	for _, k := range resultKeys(n) {
+		ptr := reflect.New(k.t)
+		ok, err := n.persist.store.Load(n.persist.key(k), ptr.Interface())
+		if err != nil {
+			return nil, false, fmt.Errorf("load %v: %w", k, err)
+		}
+		if !ok {
+			return nil, false, nil
+		}
+		receiver.setValue(k.name, k.t, ptr.Elem())
+	}
+	return receiver, true, nil
+}
+
+// save saves the values produced by this node to its ValueStore.
+func (n *constructorNode) save(receiver *stagingContainerWriter) error {
+	for _, k := range resultKeys(n) {
+		v, ok := receiver.values[k]
+		if !ok {
+			continue
+		}
+		if err := n.persist.store.Save(n.persist.key(k), v.Interface()); err != nil {
+			return fmt.Errorf("save %v: %w", k, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+// jsonStore is a ValueStore that keeps values encoded as JSON in memory.
+type jsonStore struct {
+	data map[string][]byte
+	err  error
+}
+
+func newJSONStore() *jsonStore {
+	return &jsonStore{data: make(map[string][]byte)}
+}
+
+func (s *jsonStore) Load(key string, ptr interface{}) (bool, error) {
+	b, ok := s.data[key]
+	if !ok {
+		return false, nil
+	}
+	return true, json.Unmarshal(b, ptr)
+}
+
+func (s *jsonStore) Save(key string, value interface{}) error {
+	if s.err != nil {
+		return s.err
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	s.data[key] = b
+	return nil
+}
+
+func TestPersist(t *testing.T) {
+	t.Parallel()
+
+	type Config struct{ Path string }
+	type Schema struct{ Fields []string }
+
+	t.Run("String", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, `Persist(store, "schema/v1")`,
+			fmt.Sprint(dig.Persist(namedStore{}, "schema/v1")))
+	})
+
+	t.Run("values are loaded on the next run", func(t *testing.T) {
+		t.Parallel()
+
+		store := newJSONStore()
+		var configs, parsed int
+		newContainer := func() *digtest.Container {
+			c := digtest.New(t)
+			c.RequireProvide(func() *Config {
+				configs++
+				return &Config{Path: "schema.json"}
+			})
+			c.RequireProvide(func(cfg *Config) *Schema {
+				parsed++
+				return &Schema{Fields: []string{"a", "b"}}
+			}, dig.Persist(store, "schema/v1"))
+			return c
+		}
+
+		newContainer().RequireInvoke(func(s *Schema) {
+			assert.Equal(t, []string{"a", "b"}, s.Fields)
+		})
+		assert.Equal(t, 1, parsed)
+		assert.Contains(t, store.data, "schema/v1/*dig_test.Schema")
+
+		newContainer().RequireInvoke(func(s *Schema) {
+			assert.Equal(t, []string{"a", "b"}, s.Fields)
+		})
+		assert.Equal(t, 1, parsed, "constructor must not be called again")
+		assert.Equal(t, 1, configs, "dependencies must not be built again")
+	})
+
+	t.Run("named values", func(t *testing.T) {
+		t.Parallel()
+
+		store := newJSONStore()
+		c := digtest.New(t)
+		c.RequireProvide(func() string { return "hello" }, dig.Name("greeting"), dig.Persist(store, "v1"))
+		type params struct {
+			dig.In
+
+			Greeting string `name:"greeting"`
+		}
+		c.RequireInvoke(func(params) {})
+		assert.Equal(t, `"hello"`, string(store.data[`v1/string[name="greeting"]`]))
+	})
+
+	t.Run("save failure", func(t *testing.T) {
+		t.Parallel()
+
+		store := newJSONStore()
+		store.err = errors.New("disk full")
+		c := digtest.New(t)
+		c.RequireProvide(func() *Schema { return &Schema{} }, dig.Persist(store, "v1"))
+
+		err := c.Invoke(func(*Schema) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "save *dig_test.Schema: disk full")
+	})
+
+	t.Run("load failure", func(t *testing.T) {
+		t.Parallel()
+
+		store := newJSONStore()
+		store.data["v1/*dig_test.Schema"] = []byte("{")
+		c := digtest.New(t)
+		c.RequireProvide(func() *Schema { return &Schema{} }, dig.Persist(store, "v1"))
+
+		err := c.Invoke(func(*Schema) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "load *dig_test.Schema")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		store := newJSONStore()
+		type out struct {
+			dig.Out
+
+			Fields []string `group:"fields"`
+		}
+		tests := []struct {
+			desc string
+			ctor interface{}
+			opts []dig.ProvideOption
+			err  string
+		}{
+			{
+				desc: "group option",
+				ctor: func() string { return "" },
+				opts: []dig.ProvideOption{dig.Group("g")},
+				err:  `cannot use dig.Persist with value groups: group:"g"`,
+			},
+			{
+				desc: "group result",
+				ctor: func() out { return out{} },
+				err:  "cannot use dig.Persist with value groups",
+			},
+			{
+				desc: "interface result",
+				ctor: func() fmt.Stringer { return nil },
+				err:  "cannot use dig.Persist with interface types",
+			},
+			{
+				desc: "transient",
+				ctor: func() string { return "" },
+				opts: []dig.ProvideOption{dig.Transient()},
+				err:  "cannot use dig.Persist with dig.Transient",
+			},
+		}
+		for _, tt := range tests {
+			tt := tt
+			t.Run(tt.desc, func(t *testing.T) {
+				c := digtest.New(t)
+				opts := append(tt.opts, dig.Persist(store, "v1"))
+				err := c.Provide(tt.ctor, opts...)
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.err)
+			})
+		}
+	})
+}
+
+type namedStore struct{ dig.ValueStore }
+
+func (namedStore) String() string { return "store" }
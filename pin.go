@@ -0,0 +1,125 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+// Pin is a ProvideOption that marks the values produced by a constructor
+// as process-wide singletons that must survive hot-swaps of the container,
+// such as signal handlers and metrics registries.
+//
+//	c.Provide(NewMetricsRegistry, dig.Pin())
+//
+// When a Container is swapped out with Swap, values of pinned constructors
+// that it has already built are carried over to the Container that
+// replaces it instead of being torn down, provided that the new Container
+// has a pinned constructor for exactly the same values that it hasn't
+// called yet. This is the case for Containers created with Live.Fork
+// unless the constructor was replaced. The carried over values are torn
+// down along with the new Container.
+//
+// Only constructors provided to the Container itself are carried over;
+// Pin has no effect on constructors provided to child Scopes. Pin cannot
+// be used with value groups.
+func Pin() ProvideOption {
+	return pinOption{}
+}
+
+type pinOption struct{}
+
+func (pinOption) String() string {
+	return "Pin()"
+}
+
+func (pinOption) applyProvideOption(opts *provideOptions) {
+	opts.Pin = true
+}
+
+// adoptPinned carries over the values built by pinned constructors of
+// the root Scope from to the matching pinned constructors of this root
+// Scope, along with their teardowns.
+func (s *Scope) adoptPinned(from *Scope) {
+	defer s.lock()()
+	defer from.lock()()
+
+	for _, n := range from.nodes {
+		if !n.pinned || !n.called {
+			continue
+		}
+		target := s.pinnedMatch(n)
+		if target == nil {
+			continue
+		}
+
+		for _, k := range resultKeys(n) {
+			s.values[k] = from.values[k]
+		}
+		target.called = true
+
+		var adopted []teardown
+		kept := from.teardowns[:0]
+		for _, td := range from.teardowns {
+			if td.Node == n {
+				adopted = append(adopted, td)
+			} else {
+				kept = append(kept, td)
+			}
+		}
+		from.teardowns = kept
+		// Pinned values outlive everything built so far in this Scope,
+		// so they're torn down last.
+		s.teardowns = append(adopted, s.teardowns...)
+	}
+}
+
+// pinnedMatch returns the pinned constructor of this Scope that provides
+// exactly the same values as n, if it hasn't been called yet.
+func (s *Scope) pinnedMatch(n *constructorNode) *constructorNode {
+	keys := resultKeys(n)
+	if len(keys) == 0 {
+		return nil
+	}
+	for _, k := range keys {
+		if k.group != "" {
+			return nil
+		}
+	}
+
+	var match *constructorNode
+	for _, m := range s.providers[keys[0]] {
+		if m.pinned && !m.called && !m.disabled {
+			match = m
+			break
+		}
+	}
+	if match == nil {
+		return nil
+	}
+
+	mkeys := resultKeys(match)
+	if len(mkeys) != len(keys) {
+		return nil
+	}
+	for i := range keys {
+		if keys[i] != mkeys[i] {
+			return nil
+		}
+	}
+	return match
+}
@@ -0,0 +1,187 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestPin(t *testing.T) {
+	t.Parallel()
+
+	type Registry struct{ gen int }
+	type Config struct{ gen int }
+	type Server struct {
+		Registry *Registry
+		Config   *Config
+	}
+
+	t.Run("carried over by Swap", func(t *testing.T) {
+		t.Parallel()
+
+		var calls []string
+		c := digtest.New(t)
+		c.RequireProvide(func() (*Registry, func()) {
+			return &Registry{gen: 1}, func() { calls = append(calls, "registry") }
+		}, dig.Pin(), dig.ReturnsCleanup())
+		c.RequireProvide(func() *Config { return &Config{gen: 1} })
+		c.RequireProvide(func(r *Registry, cfg *Config) (*Server, func()) {
+			return &Server{Registry: r, Config: cfg}, func() {
+				calls = append(calls, fmt.Sprintf("server %d", cfg.gen))
+			}
+		}, dig.ReturnsCleanup())
+
+		live := dig.NewLive(c.Container)
+		var first *Registry
+		require.NoError(t, live.Invoke(func(s *Server) { first = s.Registry }))
+
+		next := live.Fork()
+		require.NoError(t, next.Replace(func() *Config { return &Config{gen: 2} }))
+		require.NoError(t, dig.Swap(live, next))
+		assert.Equal(t, []string{"server 1"}, calls)
+
+		require.NoError(t, live.Invoke(func(s *Server) {
+			assert.Same(t, first, s.Registry)
+			assert.Equal(t, 2, s.Config.gen)
+		}))
+
+		calls = nil
+		next.Cleanup()
+		assert.Equal(t, []string{"server 2", "registry"}, calls)
+	})
+
+	t.Run("not carried over if replaced", func(t *testing.T) {
+		t.Parallel()
+
+		var closed bool
+		c := digtest.New(t)
+		c.RequireProvide(func() (*Registry, func()) {
+			return &Registry{gen: 1}, func() { closed = true }
+		}, dig.Pin(), dig.ReturnsCleanup())
+		live := dig.NewLive(c.Container)
+		require.NoError(t, live.Invoke(func(*Registry) {}))
+
+		next := live.Fork()
+		require.NoError(t, next.Replace(func() *Registry { return &Registry{gen: 2} }))
+		require.NoError(t, dig.Swap(live, next))
+		assert.True(t, closed)
+
+		require.NoError(t, live.Invoke(func(r *Registry) {
+			assert.Equal(t, 2, r.gen)
+		}))
+	})
+
+	t.Run("not carried over if already built", func(t *testing.T) {
+		t.Parallel()
+
+		var closed int
+		c := digtest.New(t)
+		gen := 0
+		c.RequireProvide(func() (*Registry, func()) {
+			gen++
+			return &Registry{gen: gen}, func() { closed++ }
+		}, dig.Pin(), dig.ReturnsCleanup())
+		live := dig.NewLive(c.Container)
+		require.NoError(t, live.Invoke(func(*Registry) {}))
+
+		next := live.Fork()
+		require.NoError(t, next.Invoke(func(*Registry) {}))
+		require.NoError(t, dig.Swap(live, next))
+		assert.Equal(t, 1, closed)
+	})
+
+	t.Run("constructors sharing a location", func(t *testing.T) {
+		t.Parallel()
+
+		var closed []string
+		newRegistry := func(name string) func() (*Registry, func()) {
+			return func() (*Registry, func()) {
+				return &Registry{}, func() { closed = append(closed, name) }
+			}
+		}
+		pc, _, _, _ := runtime.Caller(0)
+		loc := dig.LocationForPC(pc)
+		c := digtest.New(t)
+		c.RequireProvide(newRegistry("a"), dig.Name("a"), dig.Pin(), dig.ReturnsCleanup(), loc)
+		c.RequireProvide(newRegistry("b"), dig.Name("b"), dig.Pin(), dig.ReturnsCleanup(), loc)
+
+		type params struct {
+			dig.In
+
+			A *Registry `name:"a"`
+			B *Registry `name:"b"`
+		}
+		live := dig.NewLive(c.Container)
+		require.NoError(t, live.Invoke(func(params) {}))
+
+		next := live.Fork()
+		require.NoError(t, next.Replace(func() *Registry { return &Registry{gen: 2} }, dig.Name("b")))
+		require.NoError(t, dig.Swap(live, next))
+		assert.Equal(t, []string{"b"}, closed, "only the adopted value must be kept")
+
+		next.Cleanup()
+		assert.Equal(t, []string{"b", "a"}, closed)
+	})
+
+	t.Run("swap while the next container is in use", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() *Registry { return &Registry{gen: 1} }, dig.Pin())
+		live := dig.NewLive(c.Container)
+		require.NoError(t, live.Invoke(func(*Registry) {}))
+
+		next := live.Fork()
+		require.NoError(t, next.Provide(func() *Config { return &Config{gen: 2} }))
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for i := 0; i < 50; i++ {
+				assert.NoError(t, next.Invoke(func(*Config) {}))
+			}
+		}()
+		require.NoError(t, dig.Swap(live, next))
+		<-done
+		require.NoError(t, live.Invoke(func(*Registry, *Config) {}))
+	})
+
+	t.Run("value groups", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		err := c.Provide(func() *Registry { return nil }, dig.Group("g"), dig.Pin())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot use dig.Pin with value groups")
+	})
+
+	t.Run("String", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "Pin()", fmt.Sprint(dig.Pin()))
+	})
+}
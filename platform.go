@@ -0,0 +1,173 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+// Platforms is a ProvideOption that restricts a constructor to the given
+// platforms. Each platform is either an operating system, like "linux", or
+// an operating system and architecture, like "linux/arm64", as reported by
+// runtime.GOOS and runtime.GOARCH.
+//
+// This allows platform-specific implementations to be provided under the
+// same key, leaving it to the container to pick the one for the current
+// platform.
+//
+//	c.Provide(newInotifyWatcher, dig.Platforms("linux"))
+//	c.Provide(newKqueueWatcher, dig.Platforms("darwin", "freebsd"))
+//
+// Constructors for other platforms are ignored, except that requesting a
+// value that has no implementation for the current platform fails with an
+// error naming the platforms it is implemented for.
+//
+// FillProvideInfo and FillProviderHandle are left untouched for
+// constructors that don't apply to the current platform.
+func Platforms(platforms ...string) ProvideOption {
+	return providePlatformsOption(platforms)
+}
+
+type providePlatformsOption []string
+
+func (o providePlatformsOption) String() string {
+	quoted := make([]string, len(o))
+	for i, p := range o {
+		quoted[i] = fmt.Sprintf("%q", p)
+	}
+	return fmt.Sprintf("Platforms(%v)", strings.Join(quoted, ", "))
+}
+
+func (o providePlatformsOption) applyProvideOption(opts *provideOptions) {
+	opts.Platforms = append(opts.Platforms, o...)
+}
+
+// validatePlatform checks that the given platform given to Platforms is
+// well-formed.
+func validatePlatform(p string) error {
+	goos, goarch, hasArch := strings.Cut(p, "/")
+	if goos == "" || (hasArch && (goarch == "" || strings.Contains(goarch, "/"))) {
+		return newErrInvalidInput(fmt.Sprintf(
+			`invalid dig.Platforms(%q): platforms must be "os" or "os/arch"`, p), nil)
+	}
+	return nil
+}
+
+// platform returns the platform of the container, as "os/arch".
+func (s *Scope) platform() string {
+	if p := s.rootScope().platformOverride; p != "" {
+		return p
+	}
+	return runtime.GOOS + "/" + runtime.GOARCH
+}
+
+// matchesPlatform reports whether any of the given platforms given to
+// Platforms includes the platform of the container. No platforms match
+// all of them.
+func (s *Scope) matchesPlatform(platforms []string) bool {
+	if len(platforms) == 0 {
+		return true
+	}
+	current := s.platform()
+	goos, _, _ := strings.Cut(current, "/")
+	for _, p := range platforms {
+		if p == current || p == goos {
+			return true
+		}
+	}
+	return false
+}
+
+// skipPlatform records the keys that would be provided by a constructor
+// restricted to other platforms, so that errors for them name those
+// platforms.
+func (s *Scope) skipPlatform(ctor interface{}, opts provideOptions) error {
+	ctype := reflect.TypeOf(ctor)
+	rl, err := newResultList(ctype, resultOptions{
+		Name:    opts.Name,
+		Group:   opts.Group,
+		Key:     opts.GroupKey,
+		As:      opts.As,
+		Futures: true,
+		Cleanup: opts.Cleanup,
+	})
+	if err != nil {
+		return err
+	}
+	results := rl.DotResult()
+	if len(results) == 0 {
+		return newErrInvalidInput(
+			fmt.Sprintf("%v must provide at least one non-error type", ctype), nil)
+	}
+
+	root := s.rootScope()
+	if root.platformOnly == nil {
+		root.platformOnly = make(map[key][]string)
+	}
+	for _, r := range results {
+		k := key{t: r.Type, name: r.Name, group: r.Group}
+		saveEntry(s, root.platformOnly, k)
+		root.platformOnly[k] = append(root.platformOnly[k], opts.Platforms...)
+	}
+	return nil
+}
+
+// platformsFor returns the platforms for which the given key is provided
+// by constructors restricted with Platforms, sorted and without
+// duplicates.
+func (s *Scope) platformsFor(k key) []string {
+	ps := s.rootScope().platformOnly[k]
+	if len(ps) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]struct{}, len(ps))
+	var platforms []string
+	for _, p := range ps {
+		if _, ok := seen[p]; !ok {
+			seen[p] = struct{}{}
+			platforms = append(platforms, p)
+		}
+	}
+	sort.Strings(platforms)
+	return platforms
+}
+
+// Changes the platform of the container, as "os/arch".
+//
+// This allows constructors for other platforms to be tested.
+func setPlatform(p string) Option {
+	return setPlatformOption(p)
+}
+
+type setPlatformOption string
+
+func (o setPlatformOption) String() string {
+	return fmt.Sprintf("setPlatform(%q)", string(o))
+}
+
+func (o setPlatformOption) applyOption(c *Container) {
+	c.scope.platformOverride = string(o)
+}
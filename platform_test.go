@@ -0,0 +1,112 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestPlatforms(t *testing.T) {
+	t.Parallel()
+
+	type Watcher interface{ Name() string }
+
+	provideWatchers := func(c *digtest.Container) {
+		c.RequireProvide(func() Watcher { return namedWatcher("inotify") }, dig.Platforms("linux"))
+		c.RequireProvide(func() Watcher { return namedWatcher("kqueue") }, dig.Platforms("darwin", "freebsd/amd64"))
+	}
+
+	t.Run("String", func(t *testing.T) {
+		assert.Equal(t, `Platforms("linux", "darwin/arm64")`, fmt.Sprint(dig.Platforms("linux", "darwin/arm64")))
+	})
+
+	tests := []struct {
+		platform string
+		want     string
+	}{
+		{"linux/amd64", "inotify"},
+		{"darwin/arm64", "kqueue"},
+		{"freebsd/amd64", "kqueue"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.platform, func(t *testing.T) {
+			c := digtest.New(t, dig.SetPlatform(tt.platform))
+			provideWatchers(c)
+			c.RequireInvoke(func(w Watcher) {
+				assert.Equal(t, tt.want, w.Name())
+			})
+		})
+	}
+
+	t.Run("no implementation", func(t *testing.T) {
+		c := digtest.New(t, dig.SetPlatform("freebsd/arm64"))
+		provideWatchers(c)
+
+		err := c.Invoke(func(Watcher) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(),
+			"missing type: dig_test.Watcher (provided only for darwin, freebsd/amd64, linux; not for freebsd/arm64)")
+
+		clone := c.Clone()
+		err = clone.Invoke(func(Watcher) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "provided only for darwin, freebsd/amd64, linux")
+	})
+
+	t.Run("optional", func(t *testing.T) {
+		c := digtest.New(t, dig.SetPlatform("windows/amd64"))
+		provideWatchers(c)
+
+		c.RequireInvoke(func(p struct {
+			dig.In
+
+			W Watcher `optional:"true"`
+		}) {
+			assert.Nil(t, p.W)
+		})
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		c := digtest.New(t)
+		for _, p := range []string{"", "/amd64", "linux/", "linux/amd64/v2"} {
+			err := c.Provide(func() Watcher { return nil }, dig.Platforms(p))
+			require.Error(t, err, p)
+			assert.Contains(t, err.Error(), "invalid dig.Platforms", p)
+		}
+	})
+
+	t.Run("invalid constructor for other platform", func(t *testing.T) {
+		c := digtest.New(t, dig.SetPlatform("linux/amd64"))
+		err := c.Provide(func() error { return nil }, dig.Platforms("windows"))
+		require.Error(t, err)
+	})
+}
+
+type namedWatcher string
+
+func (w namedWatcher) Name() string { return string(w) }
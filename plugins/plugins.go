@@ -0,0 +1,208 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package plugins loads dig Modules from Go plugins and from packages that
+// register them at init time, and applies them to a host container.
+//
+// A plugin built with -buildmode=plugin exports its Module under the
+// well-known symbol named by Symbol, either as a variable or as a function
+// returning it.
+//
+//	// In the plugin's main package.
+//	var DigModule = dig.NewModule("metrics").
+//	  Provide(NewReporter, dig.Export(true))
+//
+// Packages linked into the binary may instead register their Module from
+// an init function.
+//
+//	func init() {
+//	  plugins.Register(dig.NewModule("audit").Provide(NewAuditLog))
+//	}
+//
+// The host loads plugins with Open and applies them together with the
+// registered Modules.
+//
+//	m, err := plugins.Open("/usr/lib/myapp/metrics.so")
+//	if err != nil {
+//	  return err
+//	}
+//	scopes, err := plugins.Apply(c, append(plugins.Registered(), m)...)
+//
+// Each Module is applied to its own child Scope of the container, named
+// after the Module. Its constructors see all values of the host, but the
+// host and other plugins only see the values it provides with dig.Export.
+// A Module that can't be applied is skipped without affecting the
+// container, and reported along with all other failures.
+package plugins
+
+import (
+	"fmt"
+	"plugin"
+	"strings"
+	"sync"
+
+	"go.uber.org/dig"
+)
+
+// Symbol is the name of the symbol that a plugin exports its Module
+// under. It must be a *dig.Module variable or a func() *dig.Module.
+const Symbol = "DigModule"
+
+var (
+	_registeredMu sync.Mutex
+	_registered   []*dig.Module
+)
+
+// Register registers a Module to be applied with the Modules loaded from
+// plugins. It's intended to be called from init functions.
+func Register(m *dig.Module) {
+	if m == nil {
+		panic("plugins: cannot register a nil Module")
+	}
+
+	_registeredMu.Lock()
+	defer _registeredMu.Unlock()
+	_registered = append(_registered, m)
+}
+
+// Registered returns the Modules registered with Register, in the order
+// they were registered.
+func Registered() []*dig.Module {
+	_registeredMu.Lock()
+	defer _registeredMu.Unlock()
+	return append([]*dig.Module(nil), _registered...)
+}
+
+// Open loads the Go plugin at the given path and returns the Module it
+// exports under Symbol.
+func Open(path string) (_ *dig.Module, err error) {
+	p, err := plugin.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open plugin %q: %w", path, err)
+	}
+	sym, err := p.Lookup(Symbol)
+	if err != nil {
+		return nil, fmt.Errorf("open plugin %q: %w", path, err)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("open plugin %q: %v panicked: %v", path, Symbol, r)
+		}
+	}()
+
+	var m *dig.Module
+	switch sym := sym.(type) {
+	case **dig.Module:
+		m = *sym
+	case func() *dig.Module:
+		m = sym()
+	default:
+		return nil, fmt.Errorf(
+			"open plugin %q: %v must be a *dig.Module or a func() *dig.Module, got %T", path, Symbol, sym)
+	}
+	if m == nil {
+		return nil, fmt.Errorf("open plugin %q: %v is nil", path, Symbol)
+	}
+	return m, nil
+}
+
+// Apply applies each of the given Modules to its own child Scope of the
+// container, and returns those Scopes by Module name.
+//
+// Modules that fail to apply are skipped, and the others are applied
+// regardless. If any Module failed, Apply returns an *Error describing
+// each failure along with the Scopes of the Modules that succeeded.
+func Apply(c *dig.Container, modules ...*dig.Module) (map[string]*dig.Scope, error) {
+	scopes := make(map[string]*dig.Scope, len(modules))
+	var failures []Failure
+	for _, m := range modules {
+		if m == nil {
+			failures = append(failures, Failure{Err: fmt.Errorf("cannot apply a nil Module")})
+			continue
+		}
+		if err := validate(m, scopes); err != nil {
+			failures = append(failures, Failure{Module: m.Name(), Err: err})
+			continue
+		}
+
+		// Try the Module against a copy of the container first, so that
+		// a failure doesn't leave part of it applied.
+		if err := use(c.Clone().Scope(m.Name()), m); err != nil {
+			failures = append(failures, Failure{Module: m.Name(), Err: err})
+			continue
+		}
+
+		s := c.Scope(m.Name())
+		if err := use(s, m); err != nil {
+			failures = append(failures, Failure{Module: m.Name(), Err: err})
+			continue
+		}
+		scopes[m.Name()] = s
+	}
+
+	if len(failures) > 0 {
+		return scopes, &Error{Failures: failures}
+	}
+	return scopes, nil
+}
+
+func validate(m *dig.Module, applied map[string]*dig.Scope) error {
+	if m.Name() == "" {
+		return fmt.Errorf("cannot apply a Module without a name")
+	}
+	if _, ok := applied[m.Name()]; ok {
+		return fmt.Errorf("a Module named %q was already applied", m.Name())
+	}
+	return nil
+}
+
+// use applies the Module to the Scope, turning panics into errors.
+func use(s *dig.Scope, m *dig.Module) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic while applying Module %q: %v", m.Name(), r)
+		}
+	}()
+	return s.Use(m)
+}
+
+// Failure describes a Module that could not be applied.
+type Failure struct {
+	// Module is the name of the Module.
+	Module string
+
+	// Err is the reason it could not be applied.
+	Err error
+}
+
+// Error is returned by Apply when some of the Modules could not be
+// applied.
+type Error struct {
+	Failures []Failure
+}
+
+func (e *Error) Error() string {
+	msgs := make([]string, len(e.Failures))
+	for i, f := range e.Failures {
+		msgs[i] = fmt.Sprintf("%q: %v", f.Module, f.Err)
+	}
+	return fmt.Sprintf("could not apply %d plugin(s): %v", len(e.Failures), strings.Join(msgs, "; "))
+}
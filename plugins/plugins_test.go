@@ -0,0 +1,103 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package plugins_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/plugins"
+)
+
+type config struct{ name string }
+
+type reporter struct{ cfg *config }
+
+type auditLog struct{}
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+
+	t.Run("namespaced scopes", func(t *testing.T) {
+		c := dig.New()
+		require.NoError(t, c.Provide(func() *config { return &config{name: "host"} }))
+
+		metrics := dig.NewModule("metrics").
+			Provide(func(cfg *config) *reporter { return &reporter{cfg: cfg} }, dig.Export(true))
+		audit := dig.NewModule("audit").
+			Provide(func() *auditLog { return &auditLog{} })
+
+		scopes, err := plugins.Apply(c, metrics, audit)
+		require.NoError(t, err)
+		require.Len(t, scopes, 2)
+
+		require.NoError(t, c.Invoke(func(r *reporter) {
+			assert.Equal(t, "host", r.cfg.name)
+		}), "exported values must be visible to the host")
+		assert.Error(t, c.Invoke(func(*auditLog) {}), "unexported values must stay in the plugin's Scope")
+		assert.NoError(t, scopes["audit"].Invoke(func(*auditLog) {}))
+	})
+
+	t.Run("failures are isolated", func(t *testing.T) {
+		c := dig.New()
+
+		broken := dig.NewModule("broken").
+			Provide(func() *reporter { return &reporter{} }, dig.Export(true)).
+			Provide("not a function")
+		audit := dig.NewModule("audit").
+			Provide(func() *auditLog { return &auditLog{} })
+
+		scopes, err := plugins.Apply(c, broken, nil, audit, dig.NewModule(""), dig.NewModule("audit"))
+		require.Error(t, err)
+		assert.Contains(t, scopes, "audit")
+		assert.NotContains(t, scopes, "broken")
+
+		var perr *plugins.Error
+		require.True(t, errors.As(err, &perr))
+		require.Len(t, perr.Failures, 4)
+		assert.Equal(t, "broken", perr.Failures[0].Module)
+		assert.Contains(t, perr.Failures[1].Err.Error(), "nil Module")
+		assert.Contains(t, perr.Failures[2].Err.Error(), "without a name")
+		assert.Contains(t, perr.Failures[3].Err.Error(), `a Module named "audit" was already applied`)
+		assert.Contains(t, err.Error(), "could not apply 4 plugin(s)")
+
+		assert.Error(t, c.Invoke(func(*reporter) {}), "failed Module must not be partially applied")
+	})
+}
+
+func TestRegister(t *testing.T) {
+	m := dig.NewModule("registered")
+	plugins.Register(m)
+	assert.Contains(t, plugins.Registered(), m)
+
+	assert.Panics(t, func() { plugins.Register(nil) })
+}
+
+func TestOpen(t *testing.T) {
+	t.Parallel()
+
+	_, err := plugins.Open("does-not-exist.so")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `open plugin "does-not-exist.so"`)
+}
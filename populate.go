@@ -0,0 +1,93 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+
+	"go.uber.org/dig/internal/digreflect"
+)
+
+// Populate resolves values from the Container into the given targets.
+// See Scope.Populate.
+func (c *Container) Populate(targets ...interface{}) error {
+	pc, _, _, _ := runtime.Caller(1)
+	return c.scope.populate(pc, targets)
+}
+
+// Populate resolves values from the Scope into the given targets, which
+// must be non-nil pointers. Each target receives the value of the type
+// it points to, and dig.In structs are filled as for Invoke. This is a
+// shorthand for an Invoke that copies its arguments into variables.
+//
+//	var (
+//	  db     *sql.DB
+//	  params struct {
+//	    dig.In
+//	    Handlers []Handler `group:"handlers"`
+//	  }
+//	)
+//	if err := c.Populate(&db, &params); err != nil {
+//	  return err
+//	}
+//
+// None of the targets are written to if any of the values could not be
+// resolved.
+func (s *Scope) Populate(targets ...interface{}) error {
+	pc, _, _, _ := runtime.Caller(1)
+	return s.populate(pc, targets)
+}
+
+// populate fills the given targets, attributing the request to the code
+// at the given program counter.
+func (s *Scope) populate(pc uintptr, targets []interface{}) error {
+	types := make([]reflect.Type, len(targets))
+	values := make([]reflect.Value, len(targets))
+	for i, t := range targets {
+		v := reflect.ValueOf(t)
+		if t == nil || v.Kind() != reflect.Ptr {
+			return newErrInvalidInput(
+				fmt.Sprintf("cannot populate non-pointer target %v (type %T)", t, t), nil)
+		}
+		if v.IsNil() {
+			return newErrInvalidInput(fmt.Sprintf("cannot populate nil %T", t), nil)
+		}
+		types[i] = v.Type().Elem()
+		values[i] = v.Elem()
+	}
+
+	fn := reflect.MakeFunc(
+		reflect.FuncOf(types, nil, false),
+		func(args []reflect.Value) []reflect.Value {
+			for i, arg := range args {
+				values[i].Set(arg)
+			}
+			return nil
+		},
+	)
+
+	_, err := s.invoke(fn.Interface(), invokeOptions{
+		Location: digreflect.InspectFuncPC(pc),
+	})
+	return err
+}
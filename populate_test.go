@@ -0,0 +1,130 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestPopulate(t *testing.T) {
+	t.Parallel()
+
+	type A struct{ n int }
+	type B struct{ s string }
+
+	t.Run("values", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{n: 1} })
+		c.RequireProvide(func() *B { return &B{s: "b"} })
+
+		var (
+			a *A
+			b *B
+		)
+		require.NoError(t, c.Populate(&a, &b))
+		assert.Equal(t, 1, a.n)
+		assert.Equal(t, "b", b.s)
+	})
+
+	t.Run("param object", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{n: 1} })
+		c.RequireProvide(func() int { return 1 }, dig.Group("nums"))
+		c.RequireProvide(func() int { return 2 }, dig.Group("nums"))
+
+		var p struct {
+			dig.In
+
+			A    *A
+			B    *B    `optional:"true"`
+			Nums []int `group:"nums"`
+		}
+		require.NoError(t, c.Populate(&p))
+		assert.Equal(t, 1, p.A.n)
+		assert.Nil(t, p.B)
+		assert.ElementsMatch(t, []int{1, 2}, p.Nums)
+	})
+
+	t.Run("scope", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		child := c.Scope("child")
+		require.NoError(t, child.Provide(func() *A { return &A{n: 2} }))
+
+		var a *A
+		require.NoError(t, child.Populate(&a))
+		assert.Equal(t, 2, a.n)
+	})
+
+	t.Run("missing value leaves targets untouched", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{n: 1} })
+
+		var (
+			a *A
+			b *B
+		)
+		err := c.Populate(&a, &b)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "populate_test.go")
+		assert.Contains(t, err.Error(), "missing type: *dig_test.B")
+		assert.Nil(t, a)
+	})
+
+	t.Run("non-pointer", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		err := c.Populate(A{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot populate non-pointer target")
+	})
+
+	t.Run("untyped nil", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		err := c.Populate(nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot populate non-pointer target")
+	})
+
+	t.Run("nil pointer", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		err := c.Populate((*A)(nil))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot populate nil *dig_test.A")
+	})
+}
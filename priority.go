@@ -0,0 +1,101 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import "fmt"
+
+// Priority is a ProvideOption that allows several constructors to provide
+// the same values, and picks the one with the highest priority among them.
+//
+//	c.Provide(NewDefaultRetryPolicy, dig.Priority(0))
+//	c.Provide(NewTunedRetryPolicy, dig.Priority(10)) // used
+//
+// Only constructors provided to the same Scope with Priority may provide
+// the same values, and they must have different priorities. Providing a
+// value already provided without Priority, or with the same priority,
+// fails as with Provide. The constructors that are not used for a value
+// are listed with their outranked outputs by InspectSnapshot. If such a
+// constructor is called for its other values, the values it's outranked
+// for are set aside until it's used for them, such as when the
+// constructors outranking it are disabled.
+func Priority(n int) ProvideOption {
+	return priorityOption(n)
+}
+
+type priorityOption int
+
+func (o priorityOption) String() string {
+	return fmt.Sprintf("Priority(%d)", int(o))
+}
+
+func (o priorityOption) applyProvideOption(opts *provideOptions) {
+	n := int(o)
+	opts.Priority = &n
+}
+
+// conflictsWith reports whether the given constructor may not provide a
+// value that n already provides.
+func (n *constructorNode) conflictsWith(fallback bool, priority *int) bool {
+	if n.fallback != fallback {
+		return false
+	}
+	if n.priority == nil || priority == nil {
+		return true
+	}
+	return *n.priority == *priority
+}
+
+// overriddenBy reports whether the given constructor would be used instead
+// of n for the values they both provide.
+func (n *constructorNode) overriddenBy(fallback bool, priority *int) bool {
+	if n.fallback != fallback {
+		return n.fallback
+	}
+	return n.priority != nil && priority != nil && *priority > *n.priority
+}
+
+// isOutranked reports whether n is not used for the given key because an
+// enabled constructor with a higher Priority in this Scope provides it.
+func (s *Scope) isOutranked(n *constructorNode, k key) bool {
+	if n.priority == nil {
+		return false
+	}
+	for _, o := range s.providers[k] {
+		if o.priority != nil && o.fallback == n.fallback && !o.disabled && *o.priority > *n.priority {
+			return true
+		}
+	}
+	return false
+}
+
+// outrankedOutputs lists the outputs of n for which it is outranked.
+func (s *Scope) outrankedOutputs(n *constructorNode) []*Output {
+	if n.priority == nil {
+		return nil
+	}
+	var outputs []*Output
+	for _, k := range resultKeys(n) {
+		if s.isOutranked(n, k) {
+			outputs = append(outputs, &Output{t: k.t, name: k.name})
+		}
+	}
+	return outputs
+}
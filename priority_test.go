@@ -0,0 +1,140 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestPriority(t *testing.T) {
+	t.Parallel()
+
+	t.Run("highest priority wins", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() string { return "default" }, dig.Priority(0))
+		c.RequireProvide(func() string { return "tuned" }, dig.Priority(10))
+		c.RequireProvide(func() string { return "legacy" }, dig.Priority(-1))
+
+		c.RequireInvoke(func(s string) {
+			assert.Equal(t, "tuned", s)
+		})
+	})
+
+	t.Run("losers in snapshot", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() (string, int) { return "default", 1 }, dig.Priority(0))
+		c.RequireProvide(func() string { return "tuned" }, dig.Priority(10))
+
+		snap := c.InspectSnapshot()
+		require.Len(t, snap.Providers, 2)
+		require.Len(t, snap.Providers[0].Outranked, 1)
+		assert.Equal(t, "string", snap.Providers[0].Outranked[0].String())
+		assert.Empty(t, snap.Providers[1].Outranked)
+
+		c.RequireInvoke(func(s string, i int) {
+			assert.Equal(t, "tuned", s)
+			assert.Equal(t, 1, i)
+		})
+	})
+
+	t.Run("multiple results", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() (string, int) { return "default", 42 }, dig.Priority(1))
+		c.RequireProvide(func() string { return "tuned" }, dig.Priority(2))
+
+		c.RequireInvoke(func(i int) {
+			assert.Equal(t, 42, i)
+		})
+		c.RequireInvoke(func(s string) {
+			assert.Equal(t, "tuned", s, "outranked string must not be used")
+		})
+	})
+
+	t.Run("disabled winner", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		var h dig.ProviderHandle
+		c.RequireProvide(func() string { return "default" }, dig.Priority(0))
+		c.RequireProvide(func() string { return "tuned" }, dig.Priority(10), dig.FillProviderHandle(&h))
+		h.Disable()
+
+		c.RequireInvoke(func(s string) {
+			assert.Equal(t, "default", s)
+		})
+	})
+
+	t.Run("conflicts", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() string { return "a" }, dig.Priority(1))
+
+		err := c.Provide(func() string { return "b" }, dig.Priority(1))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "already provided by")
+
+		err = c.Provide(func() string { return "c" })
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "already provided by")
+	})
+
+	t.Run("already built", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() string { return "a" }, dig.Priority(1))
+		c.RequireInvoke(func(string) {})
+
+		err := c.Provide(func() string { return "b" }, dig.Priority(2))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "has already been built")
+
+		c.RequireProvide(func() string { return "c" }, dig.Priority(0))
+	})
+
+	t.Run("value groups", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		err := c.Provide(func() string { return "a" }, dig.Group("g"), dig.Priority(1))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot use dig.Priority with value groups")
+	})
+
+	t.Run("String", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "Priority(-3)", fmt.Sprint(dig.Priority(-3)))
+	})
+}
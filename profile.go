@@ -0,0 +1,93 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Profile is a ProvideOption that restricts a constructor to the given
+// profiles, such as "prod", "dev", or "test". The active profile of a
+// Container is selected with the WithProfile Option.
+//
+//	c := dig.New(dig.WithProfile(cfg.Env))
+//	c.Provide(NewPostgresStore, dig.Profile("prod"))
+//	c.Provide(NewSQLiteStore, dig.Profile("dev", "test"))
+//	c.Provide(NewServer) // used with every profile
+//
+// Constructors for other profiles are checked to be valid but otherwise
+// ignored, as if they had not been provided. If the Container has no
+// active profile, all constructors restricted with Profile are ignored.
+//
+// FillProvideInfo and FillProviderHandle are left untouched for
+// constructors that don't apply to the active profile.
+func Profile(profiles ...string) ProvideOption {
+	return provideProfileOption(profiles)
+}
+
+type provideProfileOption []string
+
+func (o provideProfileOption) String() string {
+	quoted := make([]string, len(o))
+	for i, p := range o {
+		quoted[i] = fmt.Sprintf("%q", p)
+	}
+	return fmt.Sprintf("Profile(%v)", strings.Join(quoted, ", "))
+}
+
+func (o provideProfileOption) applyProvideOption(opts *provideOptions) {
+	opts.Profiles = append(opts.Profiles, o...)
+}
+
+// WithProfile is an Option that selects the active profile of a
+// Container. See Profile.
+func WithProfile(profile string) Option {
+	return withProfileOption(profile)
+}
+
+type withProfileOption string
+
+func (o withProfileOption) String() string {
+	return fmt.Sprintf("WithProfile(%q)", string(o))
+}
+
+func (o withProfileOption) applyOption(c *Container) {
+	c.scope.profile = string(o)
+}
+
+// matchesProfile reports whether a constructor restricted to the given
+// profiles applies to the active profile of the Container.
+func (s *Scope) matchesProfile(profiles []string) bool {
+	if len(profiles) == 0 {
+		return true
+	}
+	active := s.rootScope().profile
+	if active == "" {
+		return false
+	}
+	for _, p := range profiles {
+		if p == active {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestProfile(t *testing.T) {
+	t.Parallel()
+
+	provide := func(c *digtest.Container) {
+		c.RequireProvide(func() string { return "postgres" }, dig.Profile("prod"))
+		c.RequireProvide(func() string { return "sqlite" }, dig.Profile("dev", "test"))
+		c.RequireProvide(func(s string) int { return len(s) })
+	}
+
+	t.Run("selects active profile", func(t *testing.T) {
+		t.Parallel()
+
+		for profile, want := range map[string]string{
+			"prod": "postgres",
+			"dev":  "sqlite",
+			"test": "sqlite",
+		} {
+			c := digtest.New(t, dig.WithProfile(profile))
+			provide(c)
+			c.RequireInvoke(func(s string, n int) {
+				assert.Equal(t, want, s, profile)
+				assert.Equal(t, len(want), n, profile)
+			})
+		}
+	})
+
+	t.Run("no active profile", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		provide(c)
+		err := c.Invoke(func(string) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing type: string")
+	})
+
+	t.Run("child scopes and clones", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.WithProfile("test"))
+		child := c.Scope("child")
+		require.NoError(t, child.Provide(func() string { return "sqlite" }, dig.Profile("test")))
+		require.NoError(t, child.Provide(func() int { return 0 }, dig.Profile("prod")))
+
+		clone := c.Clone()
+		require.NoError(t, clone.Provide(func() int { return 1 }, dig.Profile("test")))
+		require.NoError(t, clone.Invoke(func(n int) {
+			assert.Equal(t, 1, n)
+		}))
+
+		require.NoError(t, child.Invoke(func(s string) {
+			assert.Equal(t, "sqlite", s)
+		}))
+		assert.Error(t, child.Invoke(func(int) {}))
+	})
+
+	t.Run("empty profile", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		err := c.Provide(func() string { return "" }, dig.Profile(""))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "profile names cannot be empty")
+	})
+
+	t.Run("String", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, `Profile("dev", "test")`, fmt.Sprint(dig.Profile("dev", "test")))
+		assert.Equal(t, `WithProfile("prod")`, fmt.Sprint(dig.WithProfile("prod")))
+	})
+}
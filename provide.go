@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"go.uber.org/dig/internal/digreflect"
 	"go.uber.org/dig/internal/dot"
@@ -39,17 +40,63 @@ type ProvideOption interface {
 type provideOptions struct {
 	Name     string
 	Group    string
+	GroupKey string
 	Info     *ProvideInfo
-	As       []interface{}
-	Location *digreflect.Func
-	Exported bool
+
+	// Labels of the values added to Group. See GroupLabels.
+	GroupLabels []string
+
+	As        []interface{}
+	Location  *digreflect.Func
+	Exported  bool
+	SkipClose bool
+	Cleanup   bool
+	Eager     bool
+	Transient bool
+	Request   bool
+	Handle    *ProviderHandle
+	Module    string
+	Replace   bool
+	Fallback  bool
+	Claims    []string
+	Platforms []string
+	When      []func() bool
+	Profiles  []string
+	Priority  *int
+	Pin       bool
+	Persist   *persistOption
+	ParamTags []paramTag
+	CallSite  *digreflect.Func
+
+	ShutdownTimeout time.Duration
 }
 
 func (o *provideOptions) Validate() error {
-	if len(o.Group) > 0 {
-		if len(o.Name) > 0 {
+	if len(o.Group) > 0 && len(o.Name) > 0 && len(o.GroupKey) > 0 && o.Name != o.GroupKey {
+		return newErrInvalidInput(
+			fmt.Sprintf("cannot use dig.Name(%q) and dig.GroupKey(%q) together", o.Name, o.GroupKey), nil)
+	}
+
+	if o.Transient {
+		if len(o.Group) > 0 {
+			return newErrInvalidInput(
+				fmt.Sprintf("cannot use transient constructors with value groups: group:%q", o.Group), nil)
+		}
+		if o.Eager {
+			return newErrInvalidInput("cannot use dig.Eager with dig.Transient", nil)
+		}
+		if o.Request {
+			return newErrInvalidInput("cannot use dig.RequestScoped with dig.Transient", nil)
+		}
+	}
+
+	if o.Request {
+		if len(o.Group) > 0 {
 			return newErrInvalidInput(
-				fmt.Sprintf("cannot use named values with value groups: name:%q provided with group:%q", o.Name, o.Group), nil)
+				fmt.Sprintf("cannot use request-scoped constructors with value groups: group:%q", o.Group), nil)
+		}
+		if o.Eager {
+			return newErrInvalidInput("cannot use dig.Eager with dig.RequestScoped", nil)
 		}
 	}
 
@@ -65,6 +112,66 @@ func (o *provideOptions) Validate() error {
 		return newErrInvalidInput(
 			fmt.Sprintf("invalid dig.Group(%q): group names cannot contain backquotes", o.Group), nil)
 	}
+	for _, r := range o.Claims {
+		if r == "" {
+			return newErrInvalidInput("invalid dig.Claims: resource names cannot be empty", nil)
+		}
+	}
+	if o.Persist != nil {
+		if len(o.Group) > 0 {
+			return newErrInvalidInput(
+				fmt.Sprintf("cannot use dig.Persist with value groups: group:%q", o.Group), nil)
+		}
+		if o.Transient {
+			return newErrInvalidInput("cannot use dig.Persist with dig.Transient", nil)
+		}
+		if o.Request {
+			return newErrInvalidInput("cannot use dig.Persist with dig.RequestScoped", nil)
+		}
+	}
+	if o.Pin && len(o.Group) > 0 {
+		return newErrInvalidInput(
+			fmt.Sprintf("cannot use dig.Pin with value groups: group:%q", o.Group), nil)
+	}
+	if o.Priority != nil && len(o.Group) > 0 {
+		return newErrInvalidInput(
+			fmt.Sprintf("cannot use dig.Priority with value groups: group:%q", o.Group), nil)
+	}
+	if o.Fallback && len(o.Group) > 0 {
+		return newErrInvalidInput(
+			fmt.Sprintf("cannot use dig.Fallback with value groups: group:%q", o.Group), nil)
+	}
+	if len(o.GroupKey) > 0 && len(o.Group) == 0 {
+		return newErrInvalidInput(
+			fmt.Sprintf("cannot use dig.GroupKey(%q) without dig.Group", o.GroupKey), nil)
+	}
+	if len(o.GroupLabels) > 0 && len(o.Group) == 0 {
+		return newErrInvalidInput(
+			fmt.Sprintf("cannot use dig.GroupLabels(%q) without dig.Group", o.GroupLabels), nil)
+	}
+	for _, l := range o.GroupLabels {
+		if l == "" {
+			return newErrInvalidInput("invalid dig.GroupLabels: labels cannot be empty", nil)
+		}
+	}
+
+	for _, p := range o.Profiles {
+		if p == "" {
+			return newErrInvalidInput("invalid dig.Profile: profile names cannot be empty", nil)
+		}
+	}
+
+	for _, p := range o.When {
+		if p == nil {
+			return newErrInvalidInput("invalid dig.When(nil): predicate cannot be nil", nil)
+		}
+	}
+
+	for _, p := range o.Platforms {
+		if err := validatePlatform(p); err != nil {
+			return err
+		}
+	}
 
 	for _, i := range o.As {
 		t := reflect.TypeOf(i)
@@ -102,6 +209,9 @@ func (o *provideOptions) Validate() error {
 //	c.Provide(NewReadOnlyConnection, dig.Name("ro"))
 //	c.Provide(NewReadWriteConnection, dig.Name("rw"))
 //
+// When used with Group, the name identifies the values within the group
+// like GroupKey does, so they may be requested from the group by name.
+//
 // This option cannot be provided for constructors which produce result
 // objects.
 func Name(name string) ProvideOption {
@@ -122,6 +232,13 @@ func (o provideNameOption) applyProvideOption(opt *provideOptions) {
 // constructor should be added to the specified group. See also the package
 // documentation about Value Groups.
 //
+// Like the group tag of dig.Out fields, the group may carry the flatten
+// modifier so that each element of a slice returned by the constructor is
+// added to the group individually. An iter.Seq may be flattened too; its
+// items are collected when the constructor is called.
+//
+//	c.Provide(func() []Handler { ... }, dig.Group("server,flatten"))
+//
 // This option cannot be provided for constructors which produce result
 // objects.
 func Group(group string) ProvideOption {
@@ -149,6 +266,10 @@ type ProvideInfo struct {
 	ID      ID
 	Inputs  []*Input
 	Outputs []*Output
+
+	// Generation of the container once the constructor was provided. See
+	// Container.Generation.
+	Generation uint64
 }
 
 // Input contains information on an input parameter of a function.
@@ -199,6 +320,77 @@ func (o *Output) String() string {
 	return fmt.Sprintf("%v[%v]", t, strings.Join(toks, ", "))
 }
 
+func newInput(p *dot.Param) *Input {
+	return &Input{
+		t:        p.Type,
+		optional: p.Optional,
+		name:     p.Name,
+		group:    p.Group,
+	}
+}
+
+func newInputs(params []*dot.Param) []*Input {
+	inputs := make([]*Input, len(params))
+	for i, p := range params {
+		inputs[i] = newInput(p)
+	}
+	return inputs
+}
+
+func newOutputs(results []*dot.Result) []*Output {
+	outputs := make([]*Output, len(results))
+	for i, r := range results {
+		outputs[i] = &Output{
+			t:     r.Type,
+			name:  r.Name,
+			group: r.Group,
+		}
+	}
+	return outputs
+}
+
+// InvokeInfo provides information about the inputs of a function given
+// to Invoke, and the constructors that satisfy them.
+type InvokeInfo struct {
+	Inputs []*Input
+
+	// Providers lists the IDs of the constructors that satisfy each of the
+	// Inputs, in the same order. It's empty for inputs that have no
+	// constructor, such as optional values that are absent.
+	Providers [][]ID
+}
+
+// fill records the inputs of the given parameters, as resolved in the
+// given Scope.
+func (info *InvokeInfo) fill(s *Scope, pl paramList) {
+	params := pl.DotParam()
+	info.Inputs = newInputs(params)
+	info.Providers = make([][]ID, len(params))
+	for i, p := range params {
+		for _, pr := range s.inputProviders(p) {
+			info.Providers[i] = append(info.Providers[i], ID(pr.ID()))
+		}
+	}
+}
+
+// FillInvokeInfo is an InvokeOption that writes info on the inputs of the
+// invoked function, and the constructors that satisfy them, into the
+// provided InvokeInfo. It's filled before the inputs are resolved, so
+// it's available even if the Invoke fails.
+func FillInvokeInfo(info *InvokeInfo) InvokeOption {
+	return fillInvokeInfoOption{info: info}
+}
+
+type fillInvokeInfoOption struct{ info *InvokeInfo }
+
+func (o fillInvokeInfoOption) String() string {
+	return fmt.Sprintf("FillInvokeInfo(%p)", o.info)
+}
+
+func (o fillInvokeInfoOption) applyInvokeOption(opts *invokeOptions) {
+	opts.Info = o.info
+}
+
 // FillProvideInfo is a ProvideOption that writes info on what Dig was able to get
 // out of the provided constructor into the provided ProvideInfo.
 func FillProvideInfo(info *ProvideInfo) ProvideOption {
@@ -215,6 +407,38 @@ func (o fillProvideInfoOption) applyProvideOption(opts *provideOptions) {
 	opts.Info = o.info
 }
 
+// Providers reports the ProvideInfo of every constructor provided to the
+// Container and its Scopes, in the order in which they were provided,
+// Scope by Scope. This is the same information that FillProvideInfo
+// reports when the constructor is provided, so it may be used to inspect
+// containers assembled by other code.
+func (c *Container) Providers() []ProvideInfo {
+	return c.scope.Providers()
+}
+
+// Providers reports the ProvideInfo of every constructor provided to this
+// Scope and its descendants. See Container.Providers.
+func (s *Scope) Providers() []ProvideInfo {
+	defer s.lock()()
+
+	var (
+		infos []ProvideInfo
+		seen  = make(map[*constructorNode]struct{})
+	)
+	for _, scope := range s.appendSubscopes(nil) {
+		for _, n := range scope.nodes {
+			// Exported constructors are listed by the Scopes they were
+			// provided to as well as by the root.
+			if _, ok := seen[n]; ok {
+				continue
+			}
+			seen[n] = struct{}{}
+			infos = append(infos, newProvideInfo(n))
+		}
+	}
+	return infos
+}
+
 // As is a ProvideOption that specifies that the value produced by the
 // constructor implements one or more other interfaces and is provided
 // to the container as those interfaces.
@@ -332,6 +556,151 @@ func (o provideExportOption) applyProvideOption(opts *provideOptions) {
 	opts.Exported = o.exported
 }
 
+// SkipClose is a ProvideOption that prevents Container.Close from closing
+// the values produced by this constructor, even if they implement io.Closer.
+// Use this for values whose lifetime is managed elsewhere.
+//
+//	c.Provide(func() *os.File { return os.Stdout }, dig.SkipClose())
+func SkipClose() ProvideOption {
+	return provideSkipCloseOption{}
+}
+
+type provideSkipCloseOption struct{}
+
+func (provideSkipCloseOption) String() string {
+	return "SkipClose()"
+}
+
+func (provideSkipCloseOption) applyProvideOption(opts *provideOptions) {
+	opts.SkipClose = true
+}
+
+// ReturnsCleanup is a ProvideOption that treats a func() returned by the
+// constructor after its other results, and optionally before an error, as
+// a cleanup function run by Container.Cleanup instead of providing it to
+// the container as a value. See Container.Cleanup.
+//
+//	c.Provide(NewFile, dig.ReturnsCleanup())
+//
+// Provide fails if the constructor doesn't return such a function.
+func ReturnsCleanup() ProvideOption {
+	return provideReturnsCleanupOption{}
+}
+
+type provideReturnsCleanupOption struct{}
+
+func (provideReturnsCleanupOption) String() string {
+	return "ReturnsCleanup()"
+}
+
+func (provideReturnsCleanupOption) applyProvideOption(opts *provideOptions) {
+	opts.Cleanup = true
+}
+
+// ShutdownTimeout is a ProvideOption that limits how long Container.Shutdown
+// waits for the values produced by this constructor to be torn down. This
+// includes the cleanup function returned by the constructor and closing any
+// values that implement io.Closer.
+//
+//	c.Provide(NewKafkaConsumer, dig.ShutdownTimeout(5*time.Second))
+//
+// Values that take longer than this are reported as timed out. The
+// context passed to Shutdown applies regardless of this option.
+func ShutdownTimeout(d time.Duration) ProvideOption {
+	return provideShutdownTimeoutOption(d)
+}
+
+type provideShutdownTimeoutOption time.Duration
+
+func (o provideShutdownTimeoutOption) String() string {
+	return fmt.Sprintf("ShutdownTimeout(%v)", time.Duration(o))
+}
+
+func (o provideShutdownTimeoutOption) applyProvideOption(opts *provideOptions) {
+	opts.ShutdownTimeout = time.Duration(o)
+}
+
+// Eager is a ProvideOption that marks a constructor to be called by
+// Container.Build, rather than only when one of its values is first
+// requested by Invoke.
+//
+//	c.Provide(NewDatabase, dig.Eager())
+//	if err := c.Build(); err != nil {
+//	  log.Fatal(err) // the database could not be set up
+//	}
+//
+// Eager constructors are still called AT MOST ONCE.
+func Eager() ProvideOption {
+	return provideEagerOption{}
+}
+
+type provideEagerOption struct{}
+
+func (provideEagerOption) String() string {
+	return "Eager()"
+}
+
+func (provideEagerOption) applyProvideOption(opts *provideOptions) {
+	opts.Eager = true
+}
+
+// Transient is a ProvideOption that causes a constructor to be called
+// every time one of its values is requested, instead of AT MOST ONCE.
+// Each consumer receives a fresh value.
+//
+//	c.Provide(NewRequestBuffer, dig.Transient())
+//
+// Values produced by transient constructors are never cached. Decorators
+// for those values are called for each fresh value, and the values they
+// return are not cached either.
+//
+// Transient constructors cannot produce values for value groups, and
+// cannot be combined with Eager.
+func Transient() ProvideOption {
+	return provideTransientOption{}
+}
+
+type provideTransientOption struct{}
+
+func (provideTransientOption) String() string {
+	return "Transient()"
+}
+
+func (provideTransientOption) applyProvideOption(opts *provideOptions) {
+	opts.Transient = true
+}
+
+// RequestScoped is a ProvideOption that causes values produced by a
+// constructor to be memoized separately for each request Scope, instead of
+// once for the Scope the constructor was provided to. See Scope.Request.
+//
+//	c.Provide(NewRequestLogger, dig.RequestScoped())
+//	// ...
+//	req := c.Request()
+//	defer req.Close()
+//	err := req.Invoke(handle)
+//
+// Values produced by request-scoped constructors may only be requested
+// through a request Scope. They cannot be used by regular constructors,
+// since those outlive any single request. Likewise, decorators for them
+// must be registered on the request Scope.
+//
+// Request-scoped constructors cannot produce values for value groups, and
+// cannot be combined with Eager or Transient.
+func RequestScoped() ProvideOption {
+	return provideRequestScopedOption{}
+}
+
+type provideRequestScopedOption struct{}
+
+func (provideRequestScopedOption) String() string {
+	return "RequestScoped()"
+}
+
+func (provideRequestScopedOption) applyProvideOption(opts *provideOptions) {
+	opts.Request = true
+}
+
 // provider encapsulates a user-provided constructor.
 type provider interface {
 	// ID is a unique numerical identifier for this provider.
@@ -359,6 +728,20 @@ type provider interface {
 	// containerStore.
 	Call(containerStore) error
 
+	// Transient reports whether this provider is called every time its
+	// values are requested. Transient providers must be called with
+	// Produce instead of Call.
+	Transient() bool
+
+	// RequestScoped reports whether the values of this provider are
+	// memoized in the nearest request Scope. Request-scoped providers must
+	// be called with Produce instead of Call.
+	RequestScoped() bool
+
+	// Calls the underlying constructor like Call, but returns the values
+	// it produced instead of submitting them into a containerStore.
+	Produce(containerStore) (*stagingContainerWriter, error)
+
 	CType() reflect.Type
 
 	OrigScope() *Scope
@@ -369,8 +752,10 @@ type provider interface {
 //
 // The first argument of Provide is a function that accepts zero or more
 // parameters and returns one or more results. The function may optionally
-// return an error to indicate that it failed to build the value. This
-// function will be treated as the constructor for all the types it returns.
+// return an error to indicate that it failed to build the value, and a
+// func() cleanup function before that error if the ReturnsCleanup option is
+// used. This function will be treated as the constructor for all the
+// types it returns.
 // This function will be called AT MOST ONCE when a type produced by it, or a
 // type that consumes this function's output, is requested via Invoke. If the
 // same types are requested multiple times, the previously produced value will
@@ -387,8 +772,10 @@ func (c *Container) Provide(constructor interface{}, opts ...ProvideOption) erro
 //
 // The first argument of Provide is a function that accepts zero or more
 // parameters and returns one or more results. The function may optionally
-// return an error to indicate that it failed to build the value. This
-// function will be treated as the constructor for all the types it returns.
+// return an error to indicate that it failed to build the value, and a
+// func() cleanup function before that error if the ReturnsCleanup option is
+// used. This function will be treated as the constructor for all the
+// types it returns.
 // This function will be called AT MOST ONCE when a type produced by it, or a
 // type that consumes this function's output, is requested via Invoke. If the
 // same types are requested multiple times, the previously produced value will
@@ -402,6 +789,18 @@ func (c *Container) Provide(constructor interface{}, opts ...ProvideOption) erro
 // To provide a constructor to all the Scopes available, provide it to
 // Container, which is the root Scope.
 func (s *Scope) Provide(constructor interface{}, opts ...ProvideOption) error {
+	defer s.lock()()
+
+	var options provideOptions
+	for _, o := range opts {
+		o.applyProvideOption(&options)
+	}
+	return s.provideWithOptions(constructor, options)
+}
+
+// provideWithOptions implements Provide once its options are applied. The
+// container must be locked.
+func (s *Scope) provideWithOptions(constructor interface{}, options provideOptions) error {
 	ctype := reflect.TypeOf(constructor)
 	if ctype == nil {
 		return newErrInvalidInput("can't provide an untyped nil", nil)
@@ -411,15 +810,26 @@ func (s *Scope) Provide(constructor interface{}, opts ...ProvideOption) error {
 			fmt.Sprintf("must provide constructor function, got %v (type %v)", constructor, ctype), nil)
 	}
 
-	var options provideOptions
-	for _, o := range opts {
-		o.applyProvideOption(&options)
+	if s.request {
+		return newErrInvalidInput("cannot provide to a request Scope", nil)
 	}
+
 	if err := options.Validate(); err != nil {
 		return err
 	}
+	if len(options.Group) > 0 && len(options.Name) > 0 {
+		// Named values of value groups are their keyed members.
+		options.GroupKey, options.Name = options.Name, ""
+	}
+	options.CallSite = inspectCallSite()
 
-	if err := s.provide(constructor, options); err != nil {
+	provide := s.provide
+	if !s.matchesPlatform(options.Platforms) {
+		provide = s.skipPlatform
+	} else if !s.matchesProfile(options.Profiles) || !options.conditionsHold() {
+		provide = s.skipCondition
+	}
+	if err := provide(constructor, options); err != nil {
 		var errFunc *digreflect.Func
 		if options.Location == nil {
 			errFunc = digreflect.InspectFunc(constructor)
@@ -463,17 +873,62 @@ func (s *Scope) provide(ctor interface{}, opts provideOptions) (err error) {
 		s,
 		origScope,
 		constructorOptions{
-			ResultName:  opts.Name,
-			ResultGroup: opts.Group,
-			ResultAs:    opts.As,
-			Location:    opts.Location,
+			ResultName:   opts.Name,
+			ResultGroup:  opts.Group,
+			ResultKey:    opts.GroupKey,
+			ResultLabels: opts.GroupLabels,
+			ResultAs:     opts.As,
+			Location:     opts.Location,
+			SkipClose:    opts.SkipClose,
+			Cleanup:      opts.Cleanup,
+			Eager:        opts.Eager,
+			Transient:    opts.Transient,
+			Request:      opts.Request,
+			Module:       opts.Module,
+			Claims:       opts.Claims,
+			Fallback:     opts.Fallback,
+			Priority:     opts.Priority,
+			Pin:          opts.Pin,
+			Persist:      opts.Persist,
+			ParamTags:    opts.ParamTags,
+			CallSite:     opts.CallSite,
+
+			ShutdownTimeout: opts.ShutdownTimeout,
 		},
 	)
 	if err != nil {
 		return err
 	}
+	if opts.Persist != nil {
+		if err := n.checkPersist(); err != nil {
+			return err
+		}
+	}
+
+	if !opts.Replace {
+		if keys := s.overriddenKeys(n); len(keys) > 0 {
+			opts.Replace = true
+			defer func() {
+				if err == nil {
+					s.recordOverrides(keys)
+				}
+			}()
+		}
+	}
+
+	if opts.Replace {
+		undo, rerr := s.replaceProviders(n)
+		if rerr != nil {
+			return rerr
+		}
+		defer func() {
+			if err != nil {
+				undo()
+			}
+		}()
+	}
 
-	keys, err := s.findAndValidateResults(n.ResultList())
+	keys, err := s.findAndValidateResults(n)
 	if err != nil {
 		return err
 	}
@@ -484,10 +939,28 @@ func (s *Scope) provide(ctor interface{}, opts provideOptions) (err error) {
 			fmt.Sprintf("%v must provide at least one non-error type", ctype), nil)
 	}
 
+	if err := s.checkStrictProvide(ctype, keys); err != nil {
+		return err
+	}
+
+	if n.transient || n.request {
+		kind := "transient"
+		if n.request {
+			kind = "request-scoped"
+		}
+		for k := range keys {
+			if k.group != "" {
+				return newErrInvalidInput(
+					fmt.Sprintf("cannot use %v constructors with value groups: %v provides %v", kind, ctype, k), nil)
+			}
+		}
+	}
+
 	oldProviders := make(map[key][]*constructorNode)
 	for k := range keys {
 		// Cache old providers before running cycle detection.
 		oldProviders[k] = s.providers[k]
+		saveEntry(s, s.providers, k)
 		s.providers[k] = append(s.providers[k], n)
 	}
 
@@ -497,6 +970,10 @@ func (s *Scope) provide(ctor interface{}, opts provideOptions) (err error) {
 			continue
 		}
 		if ok, cycle := graph.IsAcyclic(s.gh); !ok {
+			// Build the error while the new node is still registered
+			// so that the keys linking it into the cycle can be found.
+			cerr := s.cycleDetectedError(cycle)
+
 			// When a cycle is detected, recover the old providers to reset
 			// the providers map back to what it was before this node was
 			// introduced.
@@ -504,50 +981,46 @@ func (s *Scope) provide(ctor interface{}, opts provideOptions) (err error) {
 				s.providers[k] = ops
 			}
 
-			return newErrInvalidInput("this function introduces a cycle", s.cycleDetectedError(cycle))
+			return newErrInvalidInput("this function introduces a cycle", cerr)
 		}
 		s.isVerifiedAcyclic = true
 	}
 
 	s.nodes = append(s.nodes, n)
+	origScope.recordWiring(wiringOp{scope: origScope, ctor: ctor, provideOpts: opts, node: n})
+
+	if h := opts.Handle; h != nil {
+		h.n = n
+	}
+
+	n.generation = s.rootScope().generation
 
 	// Record introspection info for caller if Info option is specified
 	if info := opts.Info; info != nil {
-		params := n.ParamList().DotParam()
-		results := n.ResultList().DotResult()
-
-		info.ID = (ID)(n.id)
-		info.Inputs = make([]*Input, len(params))
-		info.Outputs = make([]*Output, len(results))
-
-		for i, param := range params {
-			info.Inputs[i] = &Input{
-				t:        param.Type,
-				optional: param.Optional,
-				name:     param.Name,
-				group:    param.Group,
-			}
-		}
-
-		for i, res := range results {
-			info.Outputs[i] = &Output{
-				t:     res.Type,
-				name:  res.Name,
-				group: res.Group,
-			}
-		}
+		*info = newProvideInfo(n)
 	}
 	return nil
 }
 
+func newProvideInfo(n *constructorNode) ProvideInfo {
+	return ProvideInfo{
+		ID:         (ID)(n.id),
+		Inputs:     newInputs(n.ParamList().DotParam()),
+		Outputs:    newOutputs(n.ResultList().DotResult()),
+		Generation: n.generation,
+	}
+}
+
 // Builds a collection of all result types produced by this constructor.
-func (s *Scope) findAndValidateResults(rl resultList) (map[key]struct{}, error) {
+func (s *Scope) findAndValidateResults(n *constructorNode) (map[key]struct{}, error) {
 	var err error
 	keyPaths := make(map[key]string)
-	walkResult(rl, connectionVisitor{
+	walkResult(n.ResultList(), connectionVisitor{
 		s:        s,
 		err:      &err,
 		keyPaths: keyPaths,
+		fallback: n.fallback,
+		priority: n.priority,
 	})
 
 	if err != nil {
@@ -579,6 +1052,14 @@ type connectionVisitor struct {
 	// constructor.
 	keyPaths map[key]string
 
+	// Whether the results are provided with Fallback. Fallback
+	// constructors only conflict with other fallback constructors.
+	fallback bool
+
+	// Priority the results are provided with, if any. Constructors with
+	// different priorities don't conflict.
+	priority *int
+
 	// We track the path to the current result here. For example, this will
 	// be, ["[1]", "Foo", "Bar"] when we're visiting Bar in,
 	//
@@ -639,6 +1120,17 @@ func (cv connectionVisitor) Visit(res result) resultVisitor {
 			k := key{group: r.Group, t: asType}
 			cv.keyPaths[k] = path
 		}
+
+		// Keys must be unique within a group, however.
+		if r.Key == "" {
+			break
+		}
+		for _, t := range append([]reflect.Type{r.Type}, r.As...) {
+			if err := cv.checkKey(key{group: r.Group, t: t, name: r.Key}, path); err != nil {
+				*cv.err = err
+				return nil
+			}
+		}
 	}
 
 	return cv
@@ -650,14 +1142,21 @@ func (cv connectionVisitor) checkKey(k key, path string) error {
 		return newErrInvalidInput(fmt.Sprintf("cannot provide %v from %v", k, path),
 			newErrInvalidInput(fmt.Sprintf("already provided by %v", conflict), nil))
 	}
-	if ps := cv.s.providers[k]; len(ps) > 0 {
-		cons := make([]string, len(ps))
-		for i, p := range ps {
-			cons[i] = fmt.Sprint(p.Location())
+	var cons []ProvideSite
+	for _, p := range cv.s.providers[k] {
+		if p.conflictsWith(cv.fallback, cv.priority) {
+			cons = append(cons, p.provideSite())
+		} else if _, built := cv.s.values[k]; built && p.overriddenBy(cv.fallback, cv.priority) {
+			return newErrInvalidInput(fmt.Sprintf("cannot provide %v from %v", k, path),
+				newErrInvalidInput(fmt.Sprintf("%v has already been built", p.Location()), nil))
 		}
-
+	}
+	if len(cons) > 0 {
+		return newErrDuplicateProvide(k, path, cons)
+	}
+	if ek, ps := cv.s.equivalentProviders(k); len(ps) > 0 {
 		return newErrInvalidInput(fmt.Sprintf("cannot provide %v from %v", k, path),
-			newErrInvalidInput(fmt.Sprintf("already provided by %v", strings.Join(cons, "; ")), nil))
+			newErrInvalidInput(fmt.Sprintf("equivalent %v already provided by %v", ek, ps[0].Location()), nil))
 	}
 	return nil
 }
@@ -52,6 +52,16 @@ func TestProvideOptionStrings(t *testing.T) {
 			give: As(new(io.Reader), new(io.Writer)),
 			want: `As(io.Reader, io.Writer)`,
 		},
+		{
+			desc: "Transient",
+			give: Transient(),
+			want: `Transient()`,
+		},
+		{
+			desc: "RequestScoped",
+			give: RequestScoped(),
+			want: `RequestScoped()`,
+		},
 	}
 
 	for _, tt := range tests {
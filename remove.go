@@ -0,0 +1,205 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// A RemoveOption modifies the default behavior of Remove.
+type RemoveOption interface {
+	applyRemoveOption(*removeOptions)
+}
+
+type removeOptions struct {
+	Name  string
+	Group string
+	Force bool
+}
+
+// RemoveName is a RemoveOption that removes the constructors of the value
+// with the given name instead of the unnamed value.
+func RemoveName(name string) RemoveOption {
+	return removeNameOption(name)
+}
+
+type removeNameOption string
+
+func (o removeNameOption) String() string {
+	return fmt.Sprintf("RemoveName(%q)", string(o))
+}
+
+func (o removeNameOption) applyRemoveOption(opts *removeOptions) {
+	opts.Name = string(o)
+}
+
+// RemoveGroup is a RemoveOption that removes all constructors that
+// contribute to the given value group instead of the constructors of an
+// individual value.
+func RemoveGroup(group string) RemoveOption {
+	return removeGroupOption(group)
+}
+
+type removeGroupOption string
+
+func (o removeGroupOption) String() string {
+	return fmt.Sprintf("RemoveGroup(%q)", string(o))
+}
+
+func (o removeGroupOption) applyRemoveOption(opts *removeOptions) {
+	opts.Group = string(o)
+}
+
+// ForceRemove is a RemoveOption that removes constructors even if other
+// constructors depend on the values they provide.
+func ForceRemove() RemoveOption {
+	return forceRemoveOption{}
+}
+
+type forceRemoveOption struct{}
+
+func (forceRemoveOption) String() string {
+	return "ForceRemove()"
+}
+
+func (forceRemoveOption) applyRemoveOption(opts *removeOptions) {
+	opts.Force = true
+}
+
+// Remove removes the constructors of values of the given type from the
+// Container. See Scope.Remove.
+func (c *Container) Remove(t reflect.Type, opts ...RemoveOption) error {
+	return c.scope.Remove(t, opts...)
+}
+
+// Remove removes the constructors of values of the given type from the
+// Scope, along with their nodes in the dependency graph, as if they had
+// never been provided.
+//
+//	c.Remove(reflect.TypeOf(&Tracer{}))
+//	c.Remove(reflect.TypeOf(""), dig.RemoveName("dsn"))
+//	c.Remove(reflect.TypeOf((*Handler)(nil)).Elem(), dig.RemoveGroup("routes"))
+//
+// Each constructor is removed entirely, including the other values it
+// provides. Remove fails if any of the constructors has already been
+// called, or if another constructor requires a value that would no longer
+// be provided unless ForceRemove is used.
+//
+// Only constructors provided to this Scope are removed. Constructors
+// provided with Export live in the Container and must be removed from it.
+func (s *Scope) Remove(t reflect.Type, opts ...RemoveOption) error {
+	if t == nil {
+		return newErrInvalidInput("can't remove an untyped nil", nil)
+	}
+	defer s.lock()()
+
+	var options removeOptions
+	for _, o := range opts {
+		o.applyRemoveOption(&options)
+	}
+	if options.Name != "" && options.Group != "" {
+		return newErrInvalidInput(fmt.Sprintf(
+			"cannot use named values with value groups: name:%q removed with group:%q",
+			options.Name, options.Group), nil)
+	}
+
+	if err := s.remove(key{t: t, name: options.Name, group: options.Group}, options.Force); err != nil {
+		return err
+	}
+	s.recordWiring(wiringOp{scope: s, remove: &options, removeType: t})
+	return nil
+}
+
+func (s *Scope) remove(k key, force bool) error {
+	nodes := s.providers[k]
+	if len(nodes) == 0 {
+		return newErrInvalidInput(
+			fmt.Sprintf("cannot remove %v: no constructors provide it in this Scope", k), nil)
+	}
+	for _, n := range nodes {
+		if n.called {
+			return newErrInvalidInput(
+				fmt.Sprintf("cannot remove %v: %v has already been called", k, n.Location()), nil)
+		}
+	}
+
+	scopes := s.appendSubscopes(nil)
+	satisfied := make(map[*constructorNode]bool)
+	if !force {
+		for _, scope := range scopes {
+			for _, n := range scope.nodes {
+				satisfied[n] = len(findMissingDependencies(scope, n.paramList.Params...)) == 0
+			}
+		}
+	}
+
+	removed := make(map[*constructorNode]struct{}, len(nodes))
+	oldProviders := make(map[key][]*constructorNode)
+	for _, n := range nodes {
+		removed[n] = struct{}{}
+		for _, rk := range resultKeys(n) {
+			if _, ok := oldProviders[rk]; !ok {
+				oldProviders[rk] = s.providers[rk]
+			}
+			s.providers[rk] = removeNode(s.providers[rk], n)
+		}
+	}
+
+	if !force {
+		var orphaned []string
+		for _, scope := range scopes {
+			for _, n := range scope.nodes {
+				if _, ok := removed[n]; ok || !satisfied[n] {
+					continue
+				}
+				if len(findMissingDependencies(scope, n.paramList.Params...)) > 0 {
+					orphaned = append(orphaned, fmt.Sprint(n.Location()))
+				}
+			}
+		}
+		if len(orphaned) > 0 {
+			for rk, ps := range oldProviders {
+				s.providers[rk] = ps
+			}
+			return newErrInvalidInput(fmt.Sprintf("cannot remove %v", k),
+				newErrInvalidInput(fmt.Sprintf("required by %v", strings.Join(orphaned, "; ")), nil))
+		}
+	}
+
+	for rk := range oldProviders {
+		if len(s.providers[rk]) == 0 {
+			delete(s.providers, rk)
+		}
+	}
+	for _, n := range nodes {
+		s.nodes = removeNode(s.nodes, n)
+		n.removed = true
+
+		// Graph nodes are referred to by their position, so they're
+		// emptied instead of being deleted.
+		for scope, order := range n.orders {
+			scope.gh.nodes[order].Wrapped = nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,219 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestRemove(t *testing.T) {
+	t.Parallel()
+
+	stringType := reflect.TypeOf("")
+	intType := reflect.TypeOf(0)
+
+	t.Run("removes constructor", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() string { return "hello" })
+		require.NoError(t, c.Remove(stringType))
+
+		err := c.Invoke(func(string) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing type:")
+
+		// The value may be provided again.
+		c.RequireProvide(func() string { return "world" })
+		c.RequireInvoke(func(s string) {
+			assert.Equal(t, "world", s)
+		})
+	})
+
+	t.Run("named value", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() string { return "a" }, dig.Name("a"))
+		c.RequireProvide(func() string { return "b" })
+		require.NoError(t, c.Remove(stringType, dig.RemoveName("a")))
+
+		type params struct {
+			dig.In
+
+			A string `name:"a" optional:"true"`
+			B string
+		}
+		c.RequireInvoke(func(p params) {
+			assert.Empty(t, p.A)
+			assert.Equal(t, "b", p.B)
+		})
+	})
+
+	t.Run("group", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() int { return 1 }, dig.Group("g"))
+		c.RequireProvide(func() int { return 2 }, dig.Group("g"))
+		require.NoError(t, c.Remove(intType, dig.RemoveGroup("g")))
+
+		type params struct {
+			dig.In
+
+			Values []int `group:"g"`
+		}
+		c.RequireInvoke(func(p params) {
+			assert.Empty(t, p.Values)
+		})
+	})
+
+	t.Run("removes other values of constructor", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() (string, int) { return "", 0 })
+		require.NoError(t, c.Remove(stringType))
+
+		err := c.Invoke(func(int) {})
+		require.Error(t, err)
+	})
+
+	t.Run("not provided", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		err := c.Remove(stringType)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot remove string: no constructors provide it in this Scope")
+	})
+
+	t.Run("name and group", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		err := c.Remove(stringType, dig.RemoveName("a"), dig.RemoveGroup("b"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot use named values with value groups")
+	})
+
+	t.Run("already called", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() string { return "hello" })
+		c.RequireInvoke(func(string) {})
+
+		err := c.Remove(stringType)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "has already been called")
+	})
+
+	t.Run("orphans dependents", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() string { return "hello" })
+		c.RequireProvide(func(s string) int { return len(s) })
+
+		err := c.Remove(stringType)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot remove string")
+		assert.Contains(t, err.Error(), "required by")
+
+		// Nothing was removed.
+		c.RequireInvoke(func(i int) {
+			assert.Equal(t, 5, i)
+		})
+	})
+
+	t.Run("orphans dependents in child scope", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() string { return "hello" })
+		child := c.Scope("child")
+		require.NoError(t, child.Provide(func(s string) int { return len(s) }))
+
+		err := c.Remove(stringType)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "required by")
+	})
+
+	t.Run("force", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() string { return "hello" })
+		c.RequireProvide(func(s string) int { return len(s) })
+		require.NoError(t, c.Remove(stringType, dig.ForceRemove()))
+
+		err := c.Invoke(func(int) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing dependencies")
+	})
+
+	t.Run("optional dependents are not orphaned", func(t *testing.T) {
+		t.Parallel()
+
+		type params struct {
+			dig.In
+
+			S string `optional:"true"`
+		}
+
+		c := digtest.New(t)
+		c.RequireProvide(func() string { return "hello" })
+		c.RequireProvide(func(p params) int { return len(p.S) })
+		require.NoError(t, c.Remove(stringType))
+
+		c.RequireInvoke(func(i int) {
+			assert.Equal(t, 0, i)
+		})
+	})
+
+	t.Run("clone", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() string { return "hello" })
+		require.NoError(t, c.Remove(stringType))
+
+		clone := c.Clone()
+		err := clone.Invoke(func(string) {})
+		require.Error(t, err)
+	})
+
+	t.Run("options", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, `RemoveName("a")`, fmt.Sprint(dig.RemoveName("a")))
+		assert.Equal(t, `RemoveGroup("b")`, fmt.Sprint(dig.RemoveGroup("b")))
+		assert.Equal(t, "ForceRemove()", fmt.Sprint(dig.ForceRemove()))
+	})
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import "fmt"
+
+// Replace provides a constructor to the Container in place of the
+// constructors already provided for the values it produces. See
+// Scope.Replace.
+func (c *Container) Replace(constructor interface{}, opts ...ProvideOption) error {
+	return c.scope.Replace(constructor, opts...)
+}
+
+// Replace provides a constructor to the Scope in place of the
+// constructors already provided to it for the values it produces, instead
+// of failing because they're already provided. Use this to layer test or
+// environment-specific implementations over a base wiring.
+//
+//	c.Provide(NewS3Storage) // returns Storage
+//	c.Replace(NewInMemoryStorage) // also returns Storage
+//
+// It accepts the same options as Provide, and behaves like it if the
+// values have no constructors yet. Replaced constructors are removed from
+// the Scope entirely, along with any values they contribute to value
+// groups, so each of them must provide only values that the new
+// constructor also provides. Values that have already been built can't be
+// replaced.
+//
+// Only constructors provided to this Scope are replaced; constructors
+// provided to its ancestors are shadowed as with Provide.
+func (s *Scope) Replace(constructor interface{}, opts ...ProvideOption) error {
+	return s.Provide(constructor, append([]ProvideOption{replaceOption{}}, opts...)...)
+}
+
+type replaceOption struct{}
+
+func (replaceOption) String() string {
+	return "replace()"
+}
+
+func (replaceOption) applyProvideOption(opts *provideOptions) {
+	opts.Replace = true
+}
+
+// replaceProviders removes the constructors in this Scope that provide
+// the values that n provides, so that n may be provided in their place.
+// The returned function puts them back.
+func (s *Scope) replaceProviders(n *constructorNode) (undo func(), err error) {
+	keys := resultKeys(n)
+	provided := make(map[key]struct{}, len(keys))
+	for _, k := range keys {
+		provided[k] = struct{}{}
+	}
+
+	var replaced []*constructorNode
+	seen := make(map[*constructorNode]struct{})
+	for _, k := range keys {
+		if k.group != "" {
+			continue
+		}
+		if _, ok := s.values[k]; ok {
+			return nil, newErrInvalidInput(
+				fmt.Sprintf("cannot replace %v: it has already been built", k), nil)
+		}
+		for _, p := range s.providers[k] {
+			if _, ok := seen[p]; ok {
+				continue
+			}
+			seen[p] = struct{}{}
+			replaced = append(replaced, p)
+		}
+	}
+
+	oldKeys := make(map[*constructorNode][]key, len(replaced))
+	for _, p := range replaced {
+		pkeys := resultKeys(p)
+		for _, k := range pkeys {
+			if _, ok := provided[k]; !ok && k.group == "" {
+				return nil, newErrInvalidInput(fmt.Sprintf(
+					"cannot replace %v: it also provides %v", p.Location(), k), nil)
+			}
+		}
+		oldKeys[p] = pkeys
+	}
+
+	oldProviders := make(map[key][]*constructorNode)
+	for _, p := range replaced {
+		for _, k := range oldKeys[p] {
+			if _, ok := oldProviders[k]; !ok {
+				oldProviders[k] = s.providers[k]
+			}
+			saveEntry(s, s.providers, k)
+			s.providers[k] = removeNode(s.providers[k], p)
+		}
+	}
+	oldNodes := s.nodes
+	for _, p := range replaced {
+		s.nodes = removeNode(s.nodes, p)
+	}
+
+	return func() {
+		for k, ps := range oldProviders {
+			s.providers[k] = ps
+		}
+		s.nodes = oldNodes
+	}, nil
+}
+
+// resultKeys returns the keys of all values produced by the given
+// constructor, including values it contributes to groups.
+func resultKeys(n *constructorNode) []key {
+	var keys []key
+	for _, r := range n.ResultList().DotResult() {
+		keys = append(keys, key{t: r.Type, name: r.Name, group: r.Group})
+	}
+	return keys
+}
+
+// removeNode returns a copy of nodes without n.
+func removeNode(nodes []*constructorNode, n *constructorNode) []*constructorNode {
+	out := make([]*constructorNode, 0, len(nodes))
+	for _, node := range nodes {
+		if node != n {
+			out = append(out, node)
+		}
+	}
+	return out
+}
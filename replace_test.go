@@ -0,0 +1,179 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestReplace(t *testing.T) {
+	t.Parallel()
+
+	type storage interface{ Name() string }
+
+	t.Run("replaces existing constructor", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() string { return "s3" })
+		require.NoError(t, c.Replace(func() string { return "memory" }))
+
+		c.RequireInvoke(func(s string) {
+			assert.Equal(t, "memory", s)
+		})
+	})
+
+	t.Run("behaves like provide without existing constructor", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		require.NoError(t, c.Replace(func() string { return "memory" }))
+		c.RequireInvoke(func(s string) {
+			assert.Equal(t, "memory", s)
+		})
+	})
+
+	t.Run("named values", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() string { return "s3" }, dig.Name("primary"))
+		c.RequireProvide(func() string { return "disk" })
+		require.NoError(t, c.Replace(func() string { return "memory" }, dig.Name("primary")))
+
+		type params struct {
+			dig.In
+
+			Primary string `name:"primary"`
+			Default string
+		}
+		c.RequireInvoke(func(p params) {
+			assert.Equal(t, "memory", p.Primary)
+			assert.Equal(t, "disk", p.Default)
+		})
+	})
+
+	t.Run("drops group values of replaced constructor", func(t *testing.T) {
+		t.Parallel()
+
+		type out struct {
+			dig.Out
+
+			Value string
+			Item  int `group:"items"`
+		}
+		type params struct {
+			dig.In
+
+			Items []int `group:"items"`
+		}
+
+		c := digtest.New(t)
+		c.RequireProvide(func() out { return out{Value: "old", Item: 1} })
+		c.RequireProvide(func() int { return 2 }, dig.Group("items"))
+		require.NoError(t, c.Replace(func() string { return "new" }))
+
+		c.RequireInvoke(func(s string, p params) {
+			assert.Equal(t, "new", s)
+			assert.Equal(t, []int{2}, p.Items)
+		})
+	})
+
+	t.Run("replaced constructor provides other values", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() (string, int) { return "old", 1 })
+
+		err := c.Replace(func() string { return "new" })
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "also provides int")
+
+		// The original constructor is still in place.
+		c.RequireInvoke(func(s string, i int) {
+			assert.Equal(t, "old", s)
+			assert.Equal(t, 1, i)
+		})
+	})
+
+	t.Run("value already built", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() string { return "old" })
+		c.RequireInvoke(func(string) {})
+
+		err := c.Replace(func() string { return "new" })
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot replace string: it has already been built")
+	})
+
+	t.Run("cycle restores replaced constructor", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func(int) string { return "old" })
+		c.RequireProvide(func() int { return 1 })
+
+		err := c.Replace(func(string) int { return 2 })
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cycle")
+
+		c.RequireInvoke(func(s string, i int) {
+			assert.Equal(t, "old", s)
+			assert.Equal(t, 1, i)
+		})
+	})
+
+	t.Run("in scope", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		child := c.Scope("child")
+		require.NoError(t, child.Provide(func() string { return "old" }))
+		require.NoError(t, child.Replace(func() string { return "new" }))
+
+		require.NoError(t, child.Invoke(func(s string) {
+			assert.Equal(t, "new", s)
+		}))
+	})
+
+	t.Run("interfaces with As", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() *fakeStorage { return &fakeStorage{"s3"} }, dig.As(new(storage)))
+		require.NoError(t, c.Replace(func() *fakeStorage { return &fakeStorage{"memory"} }, dig.As(new(storage))))
+
+		c.RequireInvoke(func(s storage) {
+			assert.Equal(t, "memory", s.Name())
+		})
+	})
+}
+
+type fakeStorage struct{ name string }
+
+func (s *fakeStorage) Name() string { return s.name }
@@ -0,0 +1,107 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+// Request creates a lightweight Scope for a single request, such as an
+// incoming HTTP or gRPC call. See Scope.Request for details.
+func (c *Container) Request(opts ...ScopeOption) *Scope {
+	return c.scope.Request(opts...)
+}
+
+// Request creates a lightweight Scope for a single request, such as an
+// incoming HTTP or gRPC call.
+//
+// A request Scope sees all constructors known to this Scope and shares the
+// values already built by them. Values produced by constructors provided
+// with the RequestScoped option are memoized in the request Scope instead,
+// so each request gets its own.
+//
+//	func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+//	  req := s.container.Request()
+//	  defer req.Close()
+//	  req.Invoke(func(h *RequestHandler) { h.ServeHTTP(w, r) })
+//	}
+//
+// Unlike Scopes created with Scope, request Scopes are not tracked by
+// their parent and are discarded when no longer referenced. Use Close to
+// tear down the request-scoped values they hold.
+//
+// Constructors cannot be provided to a request Scope, but decorators may
+// be used to modify values for a single request.
+//
+// Use the ScopeContext option to pass the request's context to the
+// constructors of request-scoped values.
+//
+// Requests are usually served concurrently, so the first call to Request
+// makes the container behave as if it was built with Concurrent: Invokes
+// through request Scopes, and through the container itself, may be made
+// from multiple goroutines at once. As with Concurrent, constructors and
+// decorators must not wait on other goroutines that use the container
+// from then on.
+func (s *Scope) Request(opts ...ScopeOption) *Scope {
+	s.setConcurrent()
+
+	r := newScope()
+	r.parentScope = s
+	r.request = true
+	r.invokerFn = s.invokerFn
+	r.recoverFromPanics = s.recoverFromPanics
+
+	var options scopeOptions
+	for _, opt := range opts {
+		opt.applyScopeOption(&options)
+	}
+	r.ctx = options.Context
+	return r
+}
+
+// Close tears down the request-scoped values held by a request Scope,
+// running cleanup functions returned by their constructors and closing
+// values that implement io.Closer, in the reverse order in which their
+// constructors were called. It returns an error aggregating any failures.
+//
+// Close is a no-op for Scopes not created with Request; use the methods
+// on Container to tear down other values.
+func (s *Scope) Close() error {
+	if !s.request {
+		return nil
+	}
+	return s.closeTeardowns(func(teardown) bool { return true })
+}
+
+func (s *Scope) requestScope() *Scope {
+	for s := s; s != nil; s = s.parentScope {
+		if s.request {
+			return s
+		}
+	}
+	return nil
+}
+
+// graphScope returns the Scope whose graph holds the constructors visible
+// to this Scope. Request Scopes share the graph of the nearest Scope that
+// isn't one.
+func (s *Scope) graphScope() *Scope {
+	for s.request {
+		s = s.parentScope
+	}
+	return s
+}
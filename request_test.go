@@ -0,0 +1,227 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestRequestScope(t *testing.T) {
+	t.Parallel()
+
+	type Config struct{}
+	type Session struct {
+		ID     int
+		Config *Config
+	}
+	type Handler struct{ Session *Session }
+
+	newContainer := func(t *testing.T) (*digtest.Container, *int) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *Config { return &Config{} })
+
+		var sessions int
+		c.RequireProvide(func(cfg *Config) *Session {
+			sessions++
+			return &Session{ID: sessions, Config: cfg}
+		}, dig.RequestScoped())
+		c.RequireProvide(func(s *Session) *Handler {
+			return &Handler{Session: s}
+		}, dig.RequestScoped())
+		return c, &sessions
+	}
+
+	t.Run("values are memoized per request", func(t *testing.T) {
+		c, sessions := newContainer(t)
+
+		var cfg *Config
+		c.RequireInvoke(func(c *Config) { cfg = c })
+
+		req1 := c.Request()
+		require.NoError(t, req1.Invoke(func(h *Handler, s *Session) {
+			assert.Equal(t, 1, s.ID)
+			assert.Same(t, s, h.Session)
+			assert.Same(t, cfg, s.Config)
+		}))
+		require.NoError(t, req1.Invoke(func(s *Session) {
+			assert.Equal(t, 1, s.ID)
+		}))
+
+		req2 := c.Request()
+		require.NoError(t, req2.Invoke(func(s *Session) {
+			assert.Equal(t, 2, s.ID)
+			assert.Same(t, cfg, s.Config)
+		}))
+		assert.Equal(t, 2, *sessions)
+	})
+
+	t.Run("outside of a request", func(t *testing.T) {
+		c, _ := newContainer(t)
+
+		err := c.Invoke(func(*Session) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(),
+			"*dig_test.Session provided by")
+		assert.Contains(t, err.Error(),
+			"is request-scoped and can only be used from a request Scope")
+	})
+
+	t.Run("singletons cannot depend on request-scoped values", func(t *testing.T) {
+		c, _ := newContainer(t)
+		type Cache struct{}
+		c.RequireProvide(func(*Session) *Cache { return &Cache{} })
+
+		err := c.Request().Invoke(func(*Cache) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(),
+			"is request-scoped and can only be used from a request Scope")
+	})
+
+	t.Run("cannot provide to a request", func(t *testing.T) {
+		c, _ := newContainer(t)
+		err := c.Request().Provide(func() int { return 42 })
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot provide to a request Scope")
+	})
+
+	t.Run("decorate request", func(t *testing.T) {
+		c, _ := newContainer(t)
+
+		req := c.Request()
+		require.NoError(t, req.Decorate(func(s *Session) *Session {
+			return &Session{ID: s.ID + 100}
+		}))
+		require.NoError(t, req.Invoke(func(s *Session) {
+			assert.Equal(t, 101, s.ID)
+		}))
+		require.NoError(t, c.Request().Invoke(func(s *Session) {
+			assert.Equal(t, 2, s.ID)
+		}))
+	})
+
+	t.Run("nested request", func(t *testing.T) {
+		c, _ := newContainer(t)
+
+		req := c.Request()
+		var outer *Session
+		require.NoError(t, req.Invoke(func(s *Session) { outer = s }))
+
+		nested := req.Scope("nested")
+		require.NoError(t, nested.Invoke(func(s *Session) {
+			assert.NotSame(t, outer, s)
+		}))
+	})
+
+	t.Run("Close", func(t *testing.T) {
+		c := digtest.New(t)
+
+		var calls []string
+		c.RequireProvide(func() (*testCloser, func()) {
+			return &testCloser{name: "shared", closed: &calls}, func() {}
+		}, dig.ReturnsCleanup())
+		c.RequireProvide(func(*testCloser) (*Session, func()) {
+			return &Session{}, func() { calls = append(calls, "session") }
+		}, dig.RequestScoped(), dig.ReturnsCleanup())
+		type Conn struct{ *testCloser }
+		c.RequireProvide(func() Conn {
+			return Conn{&testCloser{name: "conn", closed: &calls, err: errors.New("great sadness")}}
+		}, dig.RequestScoped())
+
+		req := c.Request()
+		require.NoError(t, req.Invoke(func(*Session, Conn) {}))
+
+		err := req.Close()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "great sadness")
+		assert.Equal(t, []string{"conn", "session"}, calls)
+
+		// The shared value is left to the container.
+		require.NoError(t, req.Close())
+		require.NoError(t, c.Close())
+		assert.Equal(t, []string{"conn", "session", "shared"}, calls)
+	})
+
+	t.Run("Close on other scopes", func(t *testing.T) {
+		c := digtest.New(t)
+		assert.NoError(t, c.Scope("child").Close())
+	})
+
+	t.Run("concurrent requests", func(t *testing.T) {
+		type ReqLog struct{ Config *Config }
+
+		// The container isn't built with Concurrent.
+		c := digtest.New(t)
+		var configs int
+		c.RequireProvide(func() *Config {
+			configs++
+			return &Config{}
+		})
+		c.RequireProvide(func(cfg *Config) *ReqLog {
+			return &ReqLog{Config: cfg}
+		}, dig.RequestScoped())
+
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				req := c.Request()
+				defer req.Close()
+				assert.NoError(t, req.Invoke(func(l *ReqLog) {
+					assert.NotNil(t, l.Config)
+				}))
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, 1, configs)
+	})
+
+	t.Run("constructors may use the container after Request", func(t *testing.T) {
+		type Handler struct{ Config *Config }
+		type Server struct{ Handler *Handler }
+
+		c := digtest.New(t)
+		c.RequireProvide(func() *Config { return &Config{} })
+		c.RequireProvide(func(cfg dig.Lazy[*Config]) (*Handler, error) {
+			v, err := cfg.Get()
+			return &Handler{Config: v}, err
+		})
+		c.RequireProvide(func() (*Server, error) {
+			var s Server
+			err := c.Invoke(func(h *Handler) { s.Handler = h })
+			return &s, err
+		})
+
+		req := c.Request()
+		defer req.Close()
+		require.NoError(t, req.Invoke(func(s *Server) {
+			require.NotNil(t, s.Handler)
+			assert.NotNil(t, s.Handler.Config)
+		}))
+	})
+}
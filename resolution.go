@@ -0,0 +1,255 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"go.uber.org/dig/internal/digreflect"
+)
+
+// resolveFrame is a single step in the chain of dependencies that the
+// container is currently resolving.
+type resolveFrame struct {
+	// Key of the value being built. Unset for Invoke frames.
+	Key key
+
+	// Function passed to Invoke. Set only for Invoke frames.
+	Invoke *digreflect.Func
+
+	// Trace ID that the Invoke was made with, if any. Set only for Invoke
+	// frames.
+	TraceID string
+
+	// Context given to InvokeContext, if any. Set only for Invoke frames.
+	Context context.Context
+
+	// Report given with FillDegradationReport, if any. Set only for Invoke
+	// frames.
+	Report *DegradationReport
+
+	// Ticket taken by the Invoke if the container was built with
+	// Concurrent. Set only for Invoke frames.
+	Ticket uint64
+
+	// Keys of the values selected from value groups with SelectMember, if
+	// any. Set only for Invoke frames.
+	Selections map[string]string
+
+	// Whether the Invoke resolves a Lazy dependency. Set only for Invoke
+	// frames.
+	Lazy bool
+}
+
+// resolutionPath is a stack of the values being resolved by the
+// container, starting at the outermost Invoke.
+type resolutionPath []resolveFrame
+
+// String describes the path starting from the innermost value. For
+// example,
+//
+//	while building *A for *B for Invoke at "path/to/package".F (file.go:42)
+func (p resolutionPath) String() string {
+	var b bytes.Buffer
+	for i := len(p) - 1; i >= 0; i-- {
+		f := p[i]
+		if i == len(p)-1 {
+			b.WriteString("while building ")
+		} else {
+			b.WriteString(" for ")
+		}
+		if f.Invoke != nil {
+			fmt.Fprintf(&b, "Invoke at %v", f.Invoke)
+		} else {
+			fmt.Fprint(&b, f.Key)
+		}
+	}
+	return b.String()
+}
+
+// traceID returns the trace ID of the innermost Invoke in the path that
+// has one, or an empty string.
+func (p resolutionPath) traceID() string {
+	for i := len(p) - 1; i >= 0; i-- {
+		if id := p[i].TraceID; id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// invoke returns the function of the innermost Invoke in the path, or nil.
+func (p resolutionPath) invoke() *digreflect.Func {
+	for i := len(p) - 1; i >= 0; i-- {
+		if f := p[i].Invoke; f != nil {
+			return f
+		}
+	}
+	return nil
+}
+
+// context returns the context of the innermost Invoke in the path that has
+// one, or nil.
+func (p resolutionPath) context() context.Context {
+	for i := len(p) - 1; i >= 0; i-- {
+		if ctx := p[i].Context; ctx != nil {
+			return ctx
+		}
+	}
+	return nil
+}
+
+// resolution is the state of an outermost Invoke while it resolves values,
+// shared with the Invokes nested in it.
+//
+// Each outermost Invoke starts its own resolution, which the root Scope
+// holds only while the Invoke resolves values. Concurrent containers hold
+// their lock for that time, so Invokes from other goroutines never see it.
+type resolution struct {
+	// Values being resolved, starting at the outermost Invoke.
+	path resolutionPath
+
+	// Functions whose parameters are being built, innermost last. See
+	// CallInfo.
+	callers []caller
+
+	// Contexts of the spans being traced, innermost last.
+	spans []context.Context
+
+	// Constructor or decorator called most recently.
+	lastCalled *digreflect.Func
+
+	// Number of Invoke frames in path that resolve a Lazy dependency.
+	lazy int
+}
+
+// resolution returns the resolution in progress, or nil.
+func (s *Scope) resolution() *resolution {
+	return s.rootScope().active
+}
+
+// enterResolution returns the resolution in progress, starting one if
+// there is none. The returned function must be called once the caller no
+// longer resolves values, and ends the resolution if it was started here.
+func (s *Scope) enterResolution() (r *resolution, leave func()) {
+	root := s.rootScope()
+	if r := root.active; r != nil {
+		return r, func() {}
+	}
+	r = new(resolution)
+	root.active = r
+	return r, func() { root.active = nil }
+}
+
+// setLastCalled records that the given constructor or decorator was just
+// called on behalf of the resolution in progress.
+func (s *Scope) setLastCalled(f *digreflect.Func) {
+	if r := s.resolution(); r != nil {
+		r.lastCalled = f
+	}
+}
+
+// pushResolveFrame records that the given frame is being resolved and
+// returns a function that must be called once it's done.
+func (s *Scope) pushResolveFrame(f resolveFrame) (pop func()) {
+	r, leave := s.enterResolution()
+	r.path = append(r.path, f)
+	if f.Lazy {
+		r.lazy++
+	}
+	n := len(r.path)
+	return func() {
+		r.path = r.path[:n-1]
+		if f.Lazy {
+			r.lazy--
+		}
+		leave()
+	}
+}
+
+// resolutionPath returns a copy of the path currently being resolved.
+func (s *Scope) resolutionPath() resolutionPath {
+	r := s.resolution()
+	if r == nil {
+		return nil
+	}
+	return append(resolutionPath(nil), r.path...)
+}
+
+// DefaultMaxResolutionDepth is the default limit on the length of the
+// chain of values that a container resolves at once. See
+// MaxResolutionDepth.
+const DefaultMaxResolutionDepth = 10000
+
+// MaxResolutionDepth is an Option that limits the length of the chain of
+// values that the container resolves at once, counting the Invoke they're
+// resolved for. Resolving a value deeper in the graph fails with an error
+// that describes the full chain.
+//
+// The limit protects against pathologically deep graphs, such as those
+// registered programmatically by mistake. It defaults to
+// DefaultMaxResolutionDepth. Use zero to remove the limit.
+func MaxResolutionDepth(depth int) Option {
+	return maxResolutionDepthOption(depth)
+}
+
+type maxResolutionDepthOption int
+
+func (o maxResolutionDepthOption) String() string {
+	return fmt.Sprintf("MaxResolutionDepth(%d)", int(o))
+}
+
+func (o maxResolutionDepthOption) applyOption(c *Container) {
+	if o < 0 {
+		o = 0
+	}
+	c.scope.maxDepth = int(o)
+}
+
+func (s *Scope) checkResolutionDepth() error {
+	root := s.rootScope()
+	if root.maxDepth > 0 && len(root.active.path) > root.maxDepth {
+		return errResolutionTooDeep{Limit: root.maxDepth, Path: s.resolutionPath()}
+	}
+	return nil
+}
+
+// errResolutionTooDeep is returned when the container resolves a chain of
+// values longer than allowed by MaxResolutionDepth.
+type errResolutionTooDeep struct {
+	Limit int
+	Path  resolutionPath
+}
+
+var _ digError = errResolutionTooDeep{}
+
+func (e errResolutionTooDeep) Error() string { return fmt.Sprint(e) }
+
+func (e errResolutionTooDeep) writeMessage(w io.Writer, _ string) {
+	fmt.Fprintf(w, "exceeded the maximum resolution depth of %d %v", e.Limit, e.Path)
+}
+
+func (e errResolutionTooDeep) Format(w fmt.State, c rune) {
+	formatError(e, w, c)
+}
@@ -63,6 +63,19 @@ type resultOptions struct {
 	Name  string
 	Group string
 	As    []interface{}
+
+	// Key of the values added to Group, if any. See GroupKey.
+	Key string
+
+	// Labels of the values added to Group, if any. See GroupLabels.
+	Labels []string
+
+	// If set, results of type *Future[T] provide T.
+	Futures bool
+
+	// If set, a trailing func() result is treated as a cleanup function
+	// instead of being provided as a value.
+	Cleanup bool
 }
 
 // newResult builds a result from the given type.
@@ -81,13 +94,19 @@ func newResult(t reflect.Type, opts resultOptions) (result, error) {
 	case t.Kind() == reflect.Ptr && IsOut(t.Elem()):
 		return nil, newErrInvalidInput(fmt.Sprintf(
 			"cannot return a pointer to a result object, use a value instead: %v is a pointer to a struct that embeds dig.Out", t), nil)
+	case opts.Futures && isFuture(t):
+		if len(opts.Group) > 0 {
+			return nil, newErrInvalidInput(fmt.Sprintf(
+				"cannot provide %v to value group %q: futures cannot be grouped", t, opts.Group), nil)
+		}
+		return newResultSingle(futureValueType(t), opts)
 	case len(opts.Group) > 0:
 		g, err := parseGroupString(opts.Group)
 		if err != nil {
 			return nil, newErrInvalidInput(
 				fmt.Sprintf("cannot parse group %q", opts.Group), err)
 		}
-		rg := resultGrouped{Type: t, Group: g.Name, Flatten: g.Flatten}
+		rg := resultGrouped{Type: t, Group: g.Name, Flatten: g.Flatten, Key: opts.Key, Labels: opts.Labels}
 		if len(opts.As) > 0 {
 			var asTypes []reflect.Type
 			for _, as := range opts.As {
@@ -110,7 +129,16 @@ func newResult(t reflect.Type, opts resultOptions) (result, error) {
 			return nil, newErrInvalidInput(fmt.Sprintf(
 				"cannot use soft with result value groups: soft was used with group:%q", g.Name), nil)
 		}
+		if g.Flatten && rg.Key != "" {
+			return nil, newErrInvalidInput(fmt.Sprintf(
+				"cannot use keys with flattened value groups: key %q was used with group:%q", rg.Key, g.Name), nil)
+		}
 		if g.Flatten {
+			if elem, ok := seqElem(t); ok {
+				rg.Type = elem
+				rg.Seq = true
+				return rg, nil
+			}
 			if t.Kind() != reflect.Slice {
 				return nil, newErrInvalidInput(fmt.Sprintf(
 					"flatten can be applied to slices only: %v is not a slice", t), nil)
@@ -199,8 +227,13 @@ type resultList struct {
 
 	// For each item at index i returned by the constructor, resultIndexes[i]
 	// is the index in .Results for the corresponding result object.
-	// resultIndexes[i] is -1 for errors returned by constructors.
+	// resultIndexes[i] is -1 for errors and cleanup functions returned by
+	// constructors.
 	resultIndexes []int
+
+	// Index of the cleanup function returned by the constructor, or -1 if
+	// the constructor does not return one.
+	cleanupIndex int
 }
 
 func (rl resultList) DotResult() []*dot.Result {
@@ -217,6 +250,7 @@ func newResultList(ctype reflect.Type, opts resultOptions) (resultList, error) {
 		ctype:         ctype,
 		Results:       make([]result, 0, numOut),
 		resultIndexes: make([]int, numOut),
+		cleanupIndex:  -1,
 	}
 
 	resultIdx := 0
@@ -227,6 +261,12 @@ func newResultList(ctype reflect.Type, opts resultOptions) (resultList, error) {
 			continue
 		}
 
+		if resultIdx > 0 && opts.Cleanup && isCleanup(ctype, i) {
+			rl.resultIndexes[i] = -1
+			rl.cleanupIndex = i
+			continue
+		}
+
 		r, err := newResult(t, opts)
 		if err != nil {
 			return rl, newErrInvalidInput(fmt.Sprintf("bad result %d", i+1), err)
@@ -240,6 +280,31 @@ func newResultList(ctype reflect.Type, opts resultOptions) (resultList, error) {
 	return rl, nil
 }
 
+// isCleanup reports whether the i-th result of the given function type is a
+// cleanup function. Similar to google/wire, a cleanup function is a func()
+// returned after all other values, optionally followed only by an error.
+func isCleanup(ctype reflect.Type, i int) bool {
+	if ctype.Out(i) != _cleanupType {
+		return false
+	}
+	for j := i + 1; j < ctype.NumOut(); j++ {
+		if !isError(ctype.Out(j)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Cleanup returns the cleanup function held in the given values returned by
+// the constructor, or nil if the constructor did not return one.
+func (rl resultList) Cleanup(values []reflect.Value) func() {
+	if rl.cleanupIndex < 0 {
+		return nil
+	}
+	cleanup, _ := values[rl.cleanupIndex].Interface().(func())
+	return cleanup
+}
+
 func (resultList) Extract(containerWriter, bool, reflect.Value) {
 	digerror.BugPanicf("resultList.Extract() must never be called")
 }
@@ -251,6 +316,10 @@ func (rl resultList) ExtractList(cw containerWriter, decorated bool, values []re
 			continue
 		}
 
+		if i == rl.cleanupIndex {
+			continue
+		}
+
 		if err, _ := v.Interface().(error); err != nil {
 			return err
 		}
@@ -460,9 +529,19 @@ type resultGrouped struct {
 	// the type of individual elements rather than the group.
 	Flatten bool
 
+	// Indicates that the flattened value is an iter.Seq rather than a
+	// slice.
+	Seq bool
+
 	// If specified, this is a list of types which the value will be made
 	// available as, in addition to its own type.
 	As []reflect.Type
+
+	// Key of the value within the group, if any. See GroupKey.
+	Key string
+
+	// Labels of the value within the group, if any. See GroupLabels.
+	Labels []string
 }
 
 func (rt resultGrouped) DotResult() []*dot.Result {
@@ -492,24 +571,43 @@ func newResultGrouped(f reflect.StructField) (resultGrouped, error) {
 		Group:   g.Name,
 		Flatten: g.Flatten,
 		Type:    f.Type,
+		Key:     f.Tag.Get(_keyTag),
 	}
+	if rg.Labels, err = parseLabels(f.Tag.Get(_labelsTag)); err != nil {
+		return rg, err
+	}
+	// Named values of value groups are their keyed members.
 	name := f.Tag.Get(_nameTag)
+	if name != "" && rg.Key != "" && name != rg.Key {
+		return rg, newErrInvalidInput(fmt.Sprintf(
+			"cannot use different names and keys for values of value groups: name:%q and key:%q provided with group %q",
+			name, rg.Key, rg.Group), nil)
+	}
+	if rg.Key == "" {
+		rg.Key = name
+	}
 	optional, _ := isFieldOptional(f)
+	elem, isSeq := seqElem(f.Type)
 	switch {
-	case g.Flatten && f.Type.Kind() != reflect.Slice:
+	case g.Flatten && f.Type.Kind() != reflect.Slice && !isSeq:
 		return rg, newErrInvalidInput(fmt.Sprintf(
 			"flatten can be applied to slices only: field %q (%v) is not a slice", f.Name, f.Type), nil)
 	case g.Soft:
 		return rg, newErrInvalidInput(fmt.Sprintf(
 			"cannot use soft with result value groups: soft was used with group %q", rg.Group), nil)
-	case name != "":
-		return rg, newErrInvalidInput(fmt.Sprintf(
-			"cannot use named values with value groups: name:%q provided with group:%q", name, rg.Group), nil)
 	case optional:
 		return rg, newErrInvalidInput("value groups cannot be optional", nil)
+	case g.Flatten && rg.Key != "":
+		return rg, newErrInvalidInput(fmt.Sprintf(
+			"cannot use keys with flattened value groups: key %q was used with group %q", rg.Key, rg.Group), nil)
 	}
 	if g.Flatten {
-		rg.Type = f.Type.Elem()
+		if isSeq {
+			rg.Type = elem
+			rg.Seq = true
+		} else {
+			rg.Type = f.Type.Elem()
+		}
 	}
 
 	return rg, nil
@@ -518,9 +616,15 @@ func newResultGrouped(f reflect.StructField) (resultGrouped, error) {
 func (rt resultGrouped) Extract(cw containerWriter, decorated bool, v reflect.Value) {
 	// Decorated values are always flattened.
 	if !decorated && !rt.Flatten {
-		cw.submitGroupedValue(rt.Group, rt.Type, v)
+		rt.submit(cw, rt.Type, v)
 		for _, asType := range rt.As {
-			cw.submitGroupedValue(rt.Group, asType, v)
+			rt.submit(cw, asType, v)
+		}
+		if rt.Key != "" {
+			cw.setGroupMember(rt.Group, rt.Key, rt.Type, v)
+			for _, asType := range rt.As {
+				cw.setGroupMember(rt.Group, rt.Key, asType, v)
+			}
 		}
 		return
 	}
@@ -529,7 +633,22 @@ func (rt resultGrouped) Extract(cw containerWriter, decorated bool, v reflect.Va
 		cw.submitDecoratedGroupedValue(rt.Group, rt.Type, v)
 		return
 	}
+	if rt.Seq {
+		rangeSeq(v, func(item reflect.Value) {
+			rt.submit(cw, rt.Type, item)
+		})
+		return
+	}
 	for i := 0; i < v.Len(); i++ {
-		cw.submitGroupedValue(rt.Group, rt.Type, v.Index(i))
+		rt.submit(cw, rt.Type, v.Index(i))
+	}
+}
+
+// submit adds a value of the given type to the group along with its
+// labels, if any.
+func (rt resultGrouped) submit(cw containerWriter, t reflect.Type, v reflect.Value) {
+	cw.submitGroupedValue(rt.Group, t, v)
+	if len(rt.Labels) > 0 {
+		cw.submitLabeledValue(rt.Group, t, rt.Labels, v)
 	}
 }
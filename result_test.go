@@ -230,14 +230,14 @@ func TestNewResultObjectErrors(t *testing.T) {
 			err: `bad field "Nested"`,
 		},
 		{
-			desc: "group with name should fail",
+			desc: "group with different name and key should fail",
 			give: struct {
 				Out
 
-				Foo string `group:"foo" name:"bar"`
+				Foo string `group:"foo" name:"bar" key:"baz"`
 			}{},
-			err: "cannot use named values with value groups: " +
-				`name:"bar" provided with group:"foo"`,
+			err: "cannot use different names and keys for values of value groups: " +
+				`name:"bar" and key:"baz" provided with group "foo"`,
 		},
 		{
 			desc: "group marked as optional",
@@ -0,0 +1,260 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"go.uber.org/dig/internal/dot"
+)
+
+// Schema describes the values that a Module requires from the container
+// and the values that it provides to it. Schemas may be encoded as JSON
+// and published alongside a library so that services using it can check
+// that they're compatible with a version of it ahead of time.
+//
+// Use Module.Schema to build the Schema of a Module, and CheckSchema to
+// check it against a container.
+type Schema struct {
+	// Name and version of the Module.
+	Module  string `json:"module"`
+	Version string `json:"version,omitempty"`
+
+	// Values that the Module's constructors and decorators depend on
+	// but that the Module doesn't provide itself. Optional dependencies
+	// and value groups are not included.
+	Requires []SchemaKey `json:"requires,omitempty"`
+
+	// Values that the Module provides.
+	Provides []SchemaKey `json:"provides,omitempty"`
+}
+
+// SchemaKey identifies a value in a Schema.
+type SchemaKey struct {
+	// Type of the value, as formatted by reflect.Type.String.
+	Type string `json:"type"`
+
+	// Name or value group of the value, if any.
+	Name  string `json:"name,omitempty"`
+	Group string `json:"group,omitempty"`
+}
+
+func newSchemaKey(k key) SchemaKey {
+	return SchemaKey{Type: k.t.String(), Name: k.name, Group: k.group}
+}
+
+func (k SchemaKey) String() string {
+	switch {
+	case k.Name != "":
+		return fmt.Sprintf("%v[name=%q]", k.Type, k.Name)
+	case k.Group != "":
+		return fmt.Sprintf("%v[group=%q]", k.Type, k.Group)
+	}
+	return k.Type
+}
+
+// Version sets the version of the Module reported in its Schema.
+//
+// Versioned Modules are checked against the container by Use before any
+// changes are made to it: all values they require must be provided by
+// the container, declared with Extern, or provided by a Module passed to
+// the same call to Use, and none of the values they provide may already
+// be provided. A SchemaError is returned otherwise.
+func (m *Module) Version(v string) *Module {
+	m.version = v
+	return m
+}
+
+// Schema returns the Schema of the Module.
+func (m *Module) Schema() (Schema, error) {
+	return m.schema(m.calls())
+}
+
+// schema returns the Schema of the Module given its operations.
+func (m *Module) schema(calls []moduleCall) (Schema, error) {
+	// Apply the Module to a scratch Scope and inspect the result.
+	s := newScope()
+	s.deferAcyclicVerification = true
+	if err := s.useCalls(calls); err != nil {
+		return Schema{}, err
+	}
+
+	provided := make(map[SchemaKey]struct{})
+	for k := range s.providers {
+		provided[newSchemaKey(k)] = struct{}{}
+	}
+
+	required := make(map[SchemaKey]struct{})
+	addRequired := func(params []*dot.Param) {
+		for _, p := range params {
+			if p.Optional || p.Group != "" {
+				continue
+			}
+			sk := newSchemaKey(key{t: p.Type, name: p.Name})
+			if _, ok := provided[sk]; !ok {
+				required[sk] = struct{}{}
+			}
+		}
+	}
+	for _, n := range s.nodes {
+		addRequired(n.paramList.DotParam())
+	}
+	for _, d := range s.decorators {
+		addRequired(d.params.DotParam())
+	}
+
+	return Schema{
+		Module:   m.name,
+		Version:  m.version,
+		Requires: sortedSchemaKeys(required),
+		Provides: sortedSchemaKeys(provided),
+	}, nil
+}
+
+func sortedSchemaKeys(keys map[SchemaKey]struct{}) []SchemaKey {
+	if len(keys) == 0 {
+		return nil
+	}
+	out := make([]SchemaKey, 0, len(keys))
+	for k := range keys {
+		out = append(out, k)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].String() < out[j].String()
+	})
+	return out
+}
+
+// CheckSchema checks whether a Module with the given Schema can be used
+// with the Container. See Scope.CheckSchema.
+func (c *Container) CheckSchema(schema Schema) error {
+	return c.scope.CheckSchema(schema)
+}
+
+// CheckSchema checks whether a Module with the given Schema can be used
+// with this Scope: all values it requires must be available to the Scope
+// or declared with Extern, and none of the values it provides may already
+// be provided to the Scope. It returns a SchemaError listing the problems
+// if not.
+func (s *Scope) CheckSchema(schema Schema) error {
+	defer s.lock()()
+	return s.checkSchema(schema, nil)
+}
+
+// checkSchema is CheckSchema, treating the given values as available in
+// addition to the ones provided to this Scope.
+func (s *Scope) checkSchema(schema Schema, also map[SchemaKey]struct{}) error {
+	available := make(map[SchemaKey]struct{})
+	for _, scope := range s.ancestors() {
+		for k := range scope.providers {
+			if k.group == "" && len(scope.getProviders(k)) > 0 {
+				available[newSchemaKey(k)] = struct{}{}
+			}
+		}
+	}
+	for k := range s.rootScope().externs {
+		available[newSchemaKey(k)] = struct{}{}
+	}
+
+	e := SchemaError{Schema: schema}
+	for _, k := range schema.Requires {
+		_, ok := available[k]
+		if _, alsoOK := also[k]; !ok && !alsoOK {
+			e.Missing = append(e.Missing, k)
+		}
+	}
+	for _, k := range schema.Provides {
+		if k.Group != "" {
+			continue
+		}
+		for sk := range s.providers {
+			if sk.group == "" && newSchemaKey(sk) == k {
+				e.Conflicts = append(e.Conflicts, k)
+				break
+			}
+		}
+	}
+
+	if len(e.Missing) > 0 || len(e.Conflicts) > 0 {
+		return e
+	}
+	return nil
+}
+
+// checkModuleSchemas checks the Schemas of the versioned Modules among
+// the given ones against this Scope before they're used. Values provided
+// by any of the Modules are considered available. calls holds the
+// operations of each Module.
+func (s *Scope) checkModuleSchemas(modules []*Module, calls [][]moduleCall) error {
+	schemas := make([]Schema, len(modules))
+	also := make(map[SchemaKey]struct{})
+	for i, m := range modules {
+		schema, err := m.schema(calls[i])
+		if err != nil {
+			return err
+		}
+		schemas[i] = schema
+		for _, k := range schema.Provides {
+			also[k] = struct{}{}
+		}
+	}
+
+	for i, m := range modules {
+		if m.version == "" {
+			continue
+		}
+		if err := s.checkSchema(schemas[i], also); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SchemaError is returned when a Module's Schema is incompatible with a
+// container.
+type SchemaError struct {
+	// Schema that was checked.
+	Schema Schema
+
+	// Values that the Module requires but that are not available.
+	Missing []SchemaKey
+
+	// Values that the Module provides but that are already provided.
+	Conflicts []SchemaKey
+}
+
+func (e SchemaError) Error() string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "module %q", e.Schema.Module)
+	if e.Schema.Version != "" {
+		fmt.Fprintf(&b, " version %q", e.Schema.Version)
+	}
+	b.WriteString(" is incompatible with the container:")
+	for _, k := range e.Missing {
+		fmt.Fprintf(&b, "\n\t- requires %v, which is not provided", k)
+	}
+	for _, k := range e.Conflicts {
+		fmt.Fprintf(&b, "\n\t- provides %v, which is already provided", k)
+	}
+	return b.String()
+}
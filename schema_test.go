@@ -0,0 +1,177 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestSchema(t *testing.T) {
+	t.Parallel()
+
+	type (
+		Config struct{}
+		Logger struct{}
+		DB     struct{}
+		Cache  struct{}
+	)
+
+	type dbParams struct {
+		dig.In
+
+		Config *Config
+		Logger *Logger  `optional:"true"`
+		Hooks  []func() `group:"hooks"`
+	}
+
+	newModule := func() *dig.Module {
+		return dig.NewModule("storage").
+			Version("1.2.0").
+			Provide(func(dbParams) *DB { return &DB{} }).
+			Provide(func(*DB) *Cache { return &Cache{} }, dig.Name("primary")).
+			Provide(func() func() { return func() {} }, dig.Group("hooks"))
+	}
+
+	t.Run("derived from module", func(t *testing.T) {
+		t.Parallel()
+
+		schema, err := newModule().Schema()
+		require.NoError(t, err)
+		assert.Equal(t, dig.Schema{
+			Module:   "storage",
+			Version:  "1.2.0",
+			Requires: []dig.SchemaKey{{Type: "*dig_test.Config"}},
+			Provides: []dig.SchemaKey{
+				{Type: "*dig_test.Cache", Name: "primary"},
+				{Type: "*dig_test.DB"},
+				{Type: "func()", Group: "hooks"},
+			},
+		}, schema)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+
+		schema, err := newModule().Schema()
+		require.NoError(t, err)
+
+		b, err := json.Marshal(schema)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"module": "storage",
+			"version": "1.2.0",
+			"requires": [{"type": "*dig_test.Config"}],
+			"provides": [
+				{"type": "*dig_test.Cache", "name": "primary"},
+				{"type": "*dig_test.DB"},
+				{"type": "func()", "group": "hooks"}
+			]
+		}`, string(b))
+
+		var got dig.Schema
+		require.NoError(t, json.Unmarshal(b, &got))
+		assert.Equal(t, schema, got)
+	})
+
+	t.Run("compatible", func(t *testing.T) {
+		t.Parallel()
+
+		schema, err := newModule().Schema()
+		require.NoError(t, err)
+
+		c := digtest.New(t)
+		c.RequireProvide(func() *Config { return &Config{} })
+		assert.NoError(t, c.CheckSchema(schema))
+	})
+
+	t.Run("incompatible", func(t *testing.T) {
+		t.Parallel()
+
+		schema, err := newModule().Schema()
+		require.NoError(t, err)
+
+		c := digtest.New(t)
+		c.RequireProvide(func() *DB { return &DB{} })
+
+		err = c.CheckSchema(schema)
+		require.Error(t, err)
+
+		var se dig.SchemaError
+		require.True(t, errors.As(err, &se))
+		assert.Equal(t, []dig.SchemaKey{{Type: "*dig_test.Config"}}, se.Missing)
+		assert.Equal(t, []dig.SchemaKey{{Type: "*dig_test.DB"}}, se.Conflicts)
+		assert.Equal(t, `module "storage" version "1.2.0" is incompatible with the container:
+	- requires *dig_test.Config, which is not provided
+	- provides *dig_test.DB, which is already provided`, err.Error())
+	})
+
+	t.Run("extern satisfies requirement", func(t *testing.T) {
+		t.Parallel()
+
+		schema, err := newModule().Schema()
+		require.NoError(t, err)
+
+		c := digtest.New(t)
+		require.NoError(t, dig.Extern[*Config](c.Container, ""))
+		assert.NoError(t, c.CheckSchema(schema))
+	})
+
+	t.Run("use checks versioned modules", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		err := c.Use(newModule())
+		require.Error(t, err)
+
+		var se dig.SchemaError
+		require.True(t, errors.As(err, &se))
+		assert.Equal(t, []dig.SchemaKey{{Type: "*dig_test.Config"}}, se.Missing)
+
+		// Nothing was provided.
+		err = c.Invoke(func(*DB) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing type")
+	})
+
+	t.Run("use with requirement from another module", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		config := dig.NewModule("config").Supply(&Config{})
+		require.NoError(t, c.Use(newModule(), config))
+		c.RequireInvoke(func(*DB) {})
+	})
+
+	t.Run("unversioned modules are not checked", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		require.NoError(t, c.Use(dig.NewModule("db").
+			Provide(func(*Config) *DB { return &DB{} })))
+	})
+}
@@ -22,17 +22,25 @@ package dig
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"math/rand"
 	"reflect"
 	"sort"
+	"strings"
+	"sync"
 	"time"
+
+	"go.uber.org/dig/internal/graph"
 )
 
-// A ScopeOption modifies the default behavior of Scope; currently,
-// there are no implementations.
+// A ScopeOption modifies the default behavior of Scope.
 type ScopeOption interface {
-	noScopeOption() //yet
+	applyScopeOption(*scopeOptions)
+}
+
+type scopeOptions struct {
+	Context context.Context
 }
 
 // Scope is a scoped DAG of types and their dependencies.
@@ -50,6 +58,10 @@ type Scope struct {
 	// Mapping from key to the decorator that decorates a value for that key.
 	decorators map[key]*decoratorNode
 
+	// decoratorNodes provided directly to this Scope, in the order they
+	// were provided.
+	decoratorNodes []*decoratorNode
+
 	// constructorNodes provided directly to this Scope. i.e. it does not include
 	// any nodes that were provided to the parent Scope this inherited from.
 	nodes []*constructorNode
@@ -66,6 +78,13 @@ type Scope struct {
 	// Values groups that generated via decoraters in the Scope.
 	decoratedGroups map[key]reflect.Value
 
+	// Values of value groups that generated directly in the Scope with
+	// keys, by their key. See GroupKey.
+	groupMembers map[key]reflect.Value
+
+	// Values of value groups that have labels, along with their labels.
+	labeledValues map[key][]labeledValue
+
 	// Source of randomness.
 	rand *rand.Rand
 
@@ -90,6 +109,128 @@ type Scope struct {
 
 	// All the child scopes of this Scope.
 	childScopes []*Scope
+
+	// Cleanup functions returned by constructors and io.Closers produced
+	// by them, in the order in which the constructors were called. Only
+	// the root Scope records these.
+	teardowns []teardown
+
+	// Resolution of the outermost Invoke resolving values, if any. Only
+	// the root Scope holds this.
+	active *resolution
+
+	// Whether this is a request Scope created with Request.
+	request bool
+
+	// Changes made to the wiring of this Scope and its descendants, in
+	// order. Only the root Scope records these.
+	wiring []wiringOp
+
+	// Number of changes made to the wiring of the container. Only the
+	// root Scope records this. See Container.Generation.
+	generation uint64
+
+	// Checkpoint taken by Use to undo its changes if one of them fails,
+	// or nil. Only the root Scope holds this.
+	checkpoint *wiringCheckpoint
+
+	// Values declared with Extern. Only the root Scope records these.
+	externs map[key]struct{}
+
+	// Whether interfaces without providers may be satisfied by the only
+	// value implementing them, and the keys inferred this way. Only the
+	// root Scope records these.
+	inferInterfaces bool
+	inferred        map[key]key
+
+	// Whether values without providers are stubbed, the canned values to
+	// stub them with, and the stubs created so far. Only the root Scope
+	// records these.
+	stubMissing bool
+	stubCanned  []reflect.Value
+	stubs       map[key]reflect.Value
+
+	// Whether providing values that already have a constructor replaces
+	// it, and the values replaced this way. Only the root Scope records
+	// these.
+	allowOverride bool
+	overridden    map[key]struct{}
+
+	// Reports whether two types are the same value under KeyEquivalence.
+	// Only the root Scope records this.
+	keyEqual func(a, b reflect.Type) bool
+
+	// Whether the container was built with Strict, and whether an Invoke
+	// has succeeded since. Only the root Scope records these.
+	strict  bool
+	invoked bool
+
+	// Whether the container was built with DryRun(true). Only the root
+	// Scope records this.
+	dryRun bool
+
+	// Writes diagnostics for failed Invokes if the container was built
+	// with DumpOnFailure. Only the root Scope holds this.
+	dump *failureDumper
+
+	// Constructors holding resources claimed with Claims, by resource.
+	// Only the root Scope records these.
+	claims map[string]*constructorNode
+
+	// Functions deriving values for their consumers given with
+	// DerivePerConsumer, by type. Only the root Scope records these.
+	derivers map[reflect.Type]deriveFunc
+
+	// Requests of each key if the container was built with TrackAccess.
+	// Only the root Scope records these.
+	access map[key]*KeyAccess
+
+	// Platforms of the constructors for each key that were skipped
+	// because they're restricted to other platforms with Platforms, and
+	// the platform to use instead of the current one, if any. Only the
+	// root Scope records these.
+	platformOnly     map[key][]string
+	platformOverride string
+
+	// Active profile selected with WithProfile. Only the root Scope
+	// records this.
+	profile string
+
+	// Serializes changes and resolution once concurrent is set, either by
+	// Concurrent or by the first call to Request. Only the root Scope
+	// holds these; concurrent is accessed atomically.
+	mu         *containerMutex
+	concurrent uint32
+
+	// Guards the value groups of all Scopes against live views of them
+	// that are read outside mu. Only the root Scope holds this.
+	groupsMu sync.RWMutex
+
+	// Whether value groups consumed as iter.Seq are live views. Only
+	// the root Scope records this.
+	groupView GroupViewMode
+
+	// Whether the container behaves like upstream dig. Only the root
+	// Scope records this.
+	compat CompatMode
+
+	// Hooks called around constructor calls. Only the root Scope holds
+	// these.
+	callHooks []callHooksOption
+
+	// Tracer given with Tracing, if any. Only the root Scope holds this.
+	tracer Tracer
+
+	// Number of tickets taken by Invokes while the container is
+	// concurrent. Only the root Scope records this.
+	tickets *uint64
+
+	// Maximum length of the resolution path, or 0 if unlimited. Only the
+	// root Scope records this.
+	maxDepth int
+
+	// Context given to this Scope with ScopeContext, if any.
+	ctx context.Context
 }
 
 func newScope() *Scope {
@@ -100,6 +241,8 @@ func newScope() *Scope {
 		decoratedValues: make(map[key]reflect.Value),
 		groups:          make(map[key][]reflect.Value),
 		decoratedGroups: make(map[key]reflect.Value),
+		groupMembers:    make(map[key]reflect.Value),
+		labeledValues:   make(map[key][]labeledValue),
 		invokerFn:       defaultInvoker,
 		rand:            rand.New(rand.NewSource(time.Now().UnixNano())),
 	}
@@ -112,7 +255,22 @@ func newScope() *Scope {
 // made to it in the future will be propagated to the child scope.
 // However, no modifications made to the child scope being created will be propagated
 // to the parent Scope.
+//
+// Calling Scope on a request Scope creates a nested request Scope.
 func (s *Scope) Scope(name string, opts ...ScopeOption) *Scope {
+	if s.request {
+		child := s.Request(opts...)
+		child.name = name
+		return child
+	}
+
+	defer s.lock()()
+	return s.newChildScope(name, opts)
+}
+
+// newChildScope creates a child Scope of this Scope, which must not be a
+// request Scope.
+func (s *Scope) newChildScope(name string, opts []ScopeOption) *Scope {
 	child := newScope()
 	child.name = name
 	child.parentScope = s
@@ -123,11 +281,14 @@ func (s *Scope) Scope(name string, opts ...ScopeOption) *Scope {
 	// child copies the parent's graph nodes.
 	child.gh.nodes = append(child.gh.nodes, s.gh.nodes...)
 
+	var options scopeOptions
 	for _, opt := range opts {
-		opt.noScopeOption()
+		opt.applyScopeOption(&options)
 	}
+	child.ctx = options.Context
 
 	s.childScopes = append(s.childScopes, child)
+	s.recordWiring(wiringOp{scope: s, child: child})
 	return child
 }
 
@@ -141,6 +302,32 @@ func (s *Scope) ancestors() []*Scope {
 	return scopes
 }
 
+// path returns the names of the Scopes from the root to this Scope,
+// separated by "/". Unnamed Scopes, including the root, are omitted.
+func (s *Scope) path() string {
+	var names []string
+	for _, s := range s.ancestors() {
+		if s.name != "" {
+			names = append(names, s.name)
+		}
+	}
+	for i, j := 0, len(names)-1; i < j; i, j = i+1, j-1 {
+		names[i], names[j] = names[j], names[i]
+	}
+	return strings.Join(names, "/")
+}
+
+// wrapScopeError identifies this Scope in an error that occurred while
+// resolving values in it. Errors in unnamed Scopes, and all errors of
+// containers that behave like upstream dig, are returned as-is.
+func (s *Scope) wrapScopeError(err error) error {
+	path := s.path()
+	if path == "" || s.isUpstream() {
+		return err
+	}
+	return errScopeFailed{Scope: path, Reason: err}
+}
+
 func (s *Scope) appendSubscopes(dest []*Scope) []*Scope {
 	dest = append(dest, s)
 	for _, cs := range s.childScopes {
@@ -172,6 +359,24 @@ func (s *Scope) knownTypes() []reflect.Type {
 	return types
 }
 
+func (s *Scope) knownNames(t reflect.Type) []string {
+	nameSet := make(map[string]struct{})
+	for _, s := range s.ancestors() {
+		for k := range s.providers {
+			if k.t == t && k.group == "" {
+				nameSet[k.name] = struct{}{}
+			}
+		}
+	}
+
+	names := make([]string, 0, len(nameSet))
+	for name := range nameSet {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 func (s *Scope) getValue(name string, t reflect.Type) (v reflect.Value, ok bool) {
 	v, ok = s.values[key{name: name, t: t}]
 	return
@@ -192,6 +397,9 @@ func (s *Scope) setDecoratedValue(name string, t reflect.Type, v reflect.Value)
 
 func (s *Scope) getValueGroup(name string, t reflect.Type) []reflect.Value {
 	items := s.groups[key{group: name, t: t}]
+	if s.isStrict() {
+		return append([]reflect.Value(nil), items...)
+	}
 	// shuffle the list so users don't rely on the ordering of grouped values
 	return shuffledCopy(s.rand, items)
 }
@@ -203,11 +411,17 @@ func (s *Scope) getDecoratedValueGroup(name string, t reflect.Type) (reflect.Val
 
 func (s *Scope) submitGroupedValue(name string, t reflect.Type, v reflect.Value) {
 	k := key{group: name, t: t}
+	root := s.rootScope()
+	root.groupsMu.Lock()
+	defer root.groupsMu.Unlock()
 	s.groups[k] = append(s.groups[k], v)
 }
 
 func (s *Scope) submitDecoratedGroupedValue(name string, t reflect.Type, v reflect.Value) {
 	k := key{group: name, t: t}
+	root := s.rootScope()
+	root.groupsMu.Lock()
+	defer root.groupsMu.Unlock()
 	s.decoratedGroups[k] = v
 }
 
@@ -232,11 +446,26 @@ func (s *Scope) getDecorators(k key) (decorator, bool) {
 	return d, found
 }
 
+// getProviders returns the enabled providers for the given key in this
+// Scope.
 func (s *Scope) getProviders(k key) []provider {
 	nodes := s.providers[k]
-	providers := make([]provider, len(nodes))
-	for i, n := range nodes {
-		providers[i] = n
+	providers := make([]provider, 0, len(nodes))
+	for _, n := range nodes {
+		if n.disabled || (n.fallback && s.overridesFallback(k)) || s.isOutranked(n, k) {
+			continue
+		}
+		providers = append(providers, n)
+	}
+	return providers
+}
+
+func (s *Scope) getDisabledValueProviders(name string, t reflect.Type) []provider {
+	var providers []provider
+	for _, n := range s.providers[key{name: name, t: t}] {
+		if n.disabled {
+			providers = append(providers, n)
+		}
 	}
 	return providers
 }
@@ -272,20 +501,76 @@ func (s *Scope) newGraphNode(wrapped interface{}, orders map[*Scope]int) {
 }
 
 func (s *Scope) cycleDetectedError(cycle []int) error {
-	var path []cycleErrPathEntry
-	for _, n := range cycle {
-		if n, ok := s.gh.Lookup(n).(*constructorNode); ok {
+	upstream := s.isUpstream()
+	if !upstream {
+		cycle = graph.ShortestCycle(s.gh, cycle)
+	}
+
+	var (
+		path []cycleErrPathEntry
+		via  *key
+	)
+	for i, n := range cycle {
+		switch w := s.gh.Lookup(n).(type) {
+		case *constructorNode:
 			path = append(path, cycleErrPathEntry{
 				Key: key{
-					t: n.CType(),
+					t: w.CType(),
 				},
-				Func: n.Location(),
+				Func: w.Location(),
+				Via:  via,
 			})
+			via = nil
+			if i+1 < len(cycle) && !upstream {
+				if k, ok := linkingKey(s.gh, w.paramList, cycle[i+1]); ok {
+					via = &k
+				}
+			}
+		case *paramGroupedSlice:
+			// Value group nodes aren't reported on their own, so
+			// keep the key that led to the group for the next
+			// constructor in the cycle.
 		}
 	}
 	return errCycleDetected{Path: path, scope: s}
 }
 
+// linkingKey finds the key in the given parameter through which a node
+// depends on the node with the given order.
+func linkingKey(gh *graphHolder, p param, order int) (key, bool) {
+	switch p := p.(type) {
+	case paramList:
+		for _, pp := range p.Params {
+			if k, ok := linkingKey(gh, pp, order); ok {
+				return k, true
+			}
+		}
+		return key{}, false
+	case paramObject:
+		for _, pf := range p.Fields {
+			if k, ok := linkingKey(gh, pf.Param, order); ok {
+				return k, true
+			}
+		}
+		return key{}, false
+	}
+
+	for _, o := range getParamOrder(gh, p) {
+		if o != order {
+			continue
+		}
+		switch p := p.(type) {
+		case paramSingle:
+			return key{t: p.Type, name: p.Name}, true
+		case paramGroupedSlice:
+			return key{t: p.Type.Elem(), group: p.Group}, true
+		case paramGroupMember:
+			return key{t: p.Type, group: p.Group, name: p.Key}, true
+		}
+	}
+	return key{}, false
+}
+
 // Returns the root Scope that can be reached from this Scope.
 func (s *Scope) rootScope() *Scope {
 	curr := s
@@ -295,23 +580,30 @@ func (s *Scope) rootScope() *Scope {
 	return curr
 }
 
-// String representation of the entire Scope
+// String representation of the entire Scope.
+//
+// It lists the keys provided to the Scope along with the constructors
+// providing them and where they were defined, followed by the values
+// already built. Both listings are sorted by key; members of a value
+// group are listed in the order in which they were provided.
 func (s *Scope) String() string {
+	defer s.lock()()
+
 	b := &bytes.Buffer{}
 	fmt.Fprintln(b, "nodes: {")
-	for k, vs := range s.providers {
-		for _, v := range vs {
-			fmt.Fprintln(b, "\t", k, "->", v)
+	for _, k := range sortedKeysOf(s.providers) {
+		for _, v := range s.providers[k] {
+			fmt.Fprintln(b, "\t", k, "->", v, "at", v.Location())
 		}
 	}
 	fmt.Fprintln(b, "}")
 
 	fmt.Fprintln(b, "values: {")
-	for k, v := range s.values {
-		fmt.Fprintln(b, "\t", k, "=>", v)
+	for _, k := range sortedKeysOf(s.values) {
+		fmt.Fprintln(b, "\t", k, "=>", s.values[k])
 	}
-	for k, vs := range s.groups {
-		for _, v := range vs {
+	for _, k := range sortedKeysOf(s.groups) {
+		for _, v := range s.groups[k] {
 			fmt.Fprintln(b, "\t", k, "=>", v)
 		}
 	}
@@ -319,3 +611,16 @@ func (s *Scope) String() string {
 
 	return b.String()
 }
+
+// sortedKeysOf returns the keys of m sorted by their string
+// representation.
+func sortedKeysOf[V any](m map[key]V) []key {
+	keys := make([]key, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+	return keys
+}
@@ -21,9 +21,11 @@
 package dig_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/dig"
 	"go.uber.org/dig/internal/digtest"
 )
@@ -317,6 +319,34 @@ func TestScopeFailures(t *testing.T) {
 
 		gc.RequireInvoke(func(a *A) {})
 	})
+
+	t.Run("errors identify the scope", func(t *testing.T) {
+		type A struct{}
+
+		root := digtest.New(t, dig.Compatibility(dig.CompatExtended))
+		c := root.Scope("child")
+		gc := c.Scope("grandchild")
+
+		err := gc.Invoke(func(a *A) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `in scope "child/grandchild": missing dependencies for function`)
+
+		err = c.Invoke(func(a *A) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `in scope "child": missing dependencies for function`)
+
+		err = root.Invoke(func(a *A) {})
+		require.Error(t, err)
+		assert.NotContains(t, err.Error(), "in scope")
+	})
+
+	t.Run("user errors are returned as-is", func(t *testing.T) {
+		root := digtest.New(t)
+		c := root.Scope("child")
+
+		giveErr := errors.New("great sadness")
+		assert.Equal(t, giveErr, c.Invoke(func() error { return giveErr }))
+	})
 }
 
 func TestScopeValueGroups(t *testing.T) {
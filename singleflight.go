@@ -0,0 +1,88 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import "sync/atomic"
+
+// sharedFailure is the failure of a constructor call, shared with the
+// Invokes that started while it ran.
+//
+// Invokes of containers built with Concurrent that wait on a constructor
+// share its failure instead of calling it again one after the other. Each
+// Invoke takes a ticket when it starts, and a failed call records the
+// range of tickets taken while it ran.
+type sharedFailure struct {
+	// Invokes with tickets in (after, until] share the failure.
+	after, until uint64
+	err          error
+}
+
+// takeTicket returns a ticket for a new Invoke, or 0 if the container
+// isn't concurrent. It must be called before the Invoke acquires the lock.
+func (s *Scope) takeTicket() uint64 {
+	if !s.isConcurrent() {
+		return 0
+	}
+	return atomic.AddUint64(s.rootScope().tickets, 1)
+}
+
+// lastTicket returns the last ticket taken for an Invoke.
+func (s *Scope) lastTicket() uint64 {
+	return atomic.LoadUint64(s.rootScope().tickets)
+}
+
+// ticket returns the ticket of the outermost Invoke in the path.
+func (p resolutionPath) ticket() uint64 {
+	if len(p) == 0 {
+		return 0
+	}
+	return p[0].Ticket
+}
+
+// sharedFailure returns the failure of the last call to this constructor
+// if the current Invoke was waiting on that call.
+func (n *constructorNode) sharedFailure(c containerStore) error {
+	f := n.failure
+	if f == nil {
+		return nil
+	}
+	if t := c.resolutionPath().ticket(); t > f.after && t <= f.until {
+		return f.err
+	}
+	return nil
+}
+
+// produceShared calls this constructor with Produce, recording its failure for
+// Invokes of containers built with Concurrent that are waiting on it.
+func (n *constructorNode) produceShared(c containerStore) (*stagingContainerWriter, error) {
+	if !n.s.isConcurrent() || n.transient || n.request {
+		return n.Produce(c)
+	}
+
+	after := n.s.lastTicket()
+	receiver, err := n.Produce(c)
+	if err != nil {
+		n.failure = &sharedFailure{after: after, until: n.s.lastTicket(), err: err}
+		return nil, err
+	}
+	n.failure = nil
+	return receiver, nil
+}
@@ -0,0 +1,270 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"sort"
+
+	"go.uber.org/dig/internal/digreflect"
+	"go.uber.org/dig/internal/dot"
+)
+
+// Location describes where a function given to the container was defined.
+type Location struct {
+	// Name of the function.
+	Name string
+
+	// Import path of the package in which the function was defined.
+	Package string
+
+	// Path to the file in which the function was defined.
+	File string
+
+	// Line number in the file at which the function was defined.
+	Line int
+}
+
+func newLocation(f *digreflect.Func) Location {
+	if f == nil {
+		return Location{}
+	}
+	return Location{
+		Name:    f.Name,
+		Package: f.Package,
+		File:    f.File,
+		Line:    f.Line,
+	}
+}
+
+// String returns the location in the same format used by dig's error
+// messages:
+//
+//	"path/to/package".MyFunction (path/to/file.go:42)
+func (l Location) String() string {
+	return fmt.Sprintf("%q.%v (%v:%v)", l.Package, l.Name, l.File, l.Line)
+}
+
+// Snapshot is a read-only view of the wiring of a Container at the time
+// InspectSnapshot was called.
+//
+// A Snapshot shares no mutable state with the Container it was taken from.
+// It remains valid and unchanged if the Container is modified afterwards,
+// so it may be handed off to other goroutines freely.
+type Snapshot struct {
+	// Providers lists the constructors known to the container in the order
+	// in which they were provided, scope by scope.
+	Providers []ProviderSnapshot
+
+	// Edges lists the dependencies between providers.
+	Edges []EdgeSnapshot
+
+	// Cached lists the values that have already been constructed, sorted
+	// by their string representation.
+	Cached []*Output
+
+	// Inferred lists the interfaces satisfied through InferInterfaces,
+	// sorted by the string representation of the interface.
+	Inferred []InferredBinding
+
+	// Overridden lists the values whose constructors were replaced by
+	// providing them again with AllowOverride, sorted by their string
+	// representation.
+	Overridden []*Output
+
+	// Stubbed lists the values replaced by stubs through StubMissing,
+	// sorted by their string representation.
+	Stubbed []*Output
+
+	// Accesses reports how often each value and value group was
+	// requested, sorted by the string representation of the key. This
+	// includes keys provided to the container that were never requested.
+	// It is empty unless the container was built with TrackAccess.
+	Accesses []KeyAccess
+
+	// Generation of the container when the Snapshot was taken. See
+	// Container.Generation.
+	Generation uint64
+}
+
+// ProviderSnapshot describes a single constructor inside a Snapshot.
+type ProviderSnapshot struct {
+	// ID of the constructor. This matches the ID reported by
+	// FillProvideInfo.
+	ID ID
+
+	// Scope is the name of the Scope the constructor was provided to.
+	// This is empty for constructors provided to the Container.
+	Scope string
+
+	// Location where the constructor was defined.
+	Location Location
+
+	// Module is the name of the Module the constructor was provided
+	// through, if any.
+	Module string
+
+	Inputs  []*Input
+	Outputs []*Output
+
+	// Called reports whether the constructor had already run.
+	Called bool
+
+	// Disabled reports whether the constructor was disabled with
+	// ProviderHandle.Disable.
+	Disabled bool
+
+	// Outranked lists the outputs of the constructor that are provided
+	// instead by a constructor with a higher Priority.
+	Outranked []*Output
+}
+
+// EdgeSnapshot describes a dependency of one provider on another inside a
+// Snapshot.
+type EdgeSnapshot struct {
+	// From is the ID of the consuming constructor.
+	From ID
+
+	// To is the ID of the constructor that can satisfy Input.
+	To ID
+
+	// Input is the parameter of the consuming constructor that this edge
+	// satisfies.
+	Input *Input
+}
+
+// Generation returns the number of changes made so far to the wiring of
+// the Container and its Scopes: every Provide, Replace, Decorate, Remove,
+// RemoveDecorator, ResetDecorators, and new Scope, as well as constructors
+// disabled or enabled with ProviderHandle. Values built by the container
+// don't count as changes.
+//
+// The generation only increases, so caches derived from the wiring, such
+// as exported graphs, may be invalidated cheaply by comparing it with the
+// generation they were built at. Generations of different Containers,
+// including clones, are unrelated.
+func (c *Container) Generation() uint64 {
+	return c.scope.Generation()
+}
+
+// Generation returns the generation of the Container that this Scope
+// belongs to. See Container.Generation.
+func (s *Scope) Generation() uint64 {
+	defer s.lock()()
+	return s.rootScope().generation
+}
+
+// InspectSnapshot returns a read-only Snapshot of the providers, their
+// dependencies, and the values already constructed in the Container and
+// all of its Scopes.
+func (c *Container) InspectSnapshot() *Snapshot {
+	return c.scope.InspectSnapshot()
+}
+
+// InspectSnapshot returns a read-only Snapshot of the providers, their
+// dependencies, and the values already constructed in this Scope and all
+// of its descendants.
+func (s *Scope) InspectSnapshot() *Snapshot {
+	defer s.lock()()
+	return s.inspectSnapshot()
+}
+
+func (s *Scope) inspectSnapshot() *Snapshot {
+	var snap Snapshot
+	scopes := s.appendSubscopes(nil)
+	for _, scope := range scopes {
+		for _, n := range scope.nodes {
+			snap.Providers = append(snap.Providers, ProviderSnapshot{
+				ID:       ID(n.id),
+				Scope:    n.origS.name,
+				Location: newLocation(n.location),
+				Module:   n.module,
+				Inputs:   newInputs(n.paramList.DotParam()),
+				Outputs:  newOutputs(n.resultList.DotResult()),
+				Called:   n.called,
+				Disabled: n.disabled,
+
+				Outranked: scope.outrankedOutputs(n),
+			})
+			snap.Edges = append(snap.Edges, scope.snapshotEdges(n)...)
+		}
+
+		for k := range scope.values {
+			snap.Cached = append(snap.Cached, &Output{t: k.t, name: k.name})
+		}
+		for k := range scope.groups {
+			snap.Cached = append(snap.Cached, &Output{t: k.t, group: k.group})
+		}
+	}
+
+	sort.Slice(snap.Cached, func(i, j int) bool {
+		return snap.Cached[i].String() < snap.Cached[j].String()
+	})
+
+	for iface, impl := range s.rootScope().inferred {
+		snap.Inferred = append(snap.Inferred, InferredBinding{
+			Interface:      &Output{t: iface.t, name: iface.name},
+			Implementation: &Output{t: impl.t, name: impl.name},
+		})
+	}
+	sort.Slice(snap.Inferred, func(i, j int) bool {
+		return snap.Inferred[i].Interface.String() < snap.Inferred[j].Interface.String()
+	})
+
+	for k := range s.rootScope().stubs {
+		snap.Stubbed = append(snap.Stubbed, &Output{t: k.t, name: k.name})
+	}
+	sort.Slice(snap.Stubbed, func(i, j int) bool {
+		return snap.Stubbed[i].String() < snap.Stubbed[j].String()
+	})
+
+	snap.Overridden = s.snapshotOverrides()
+	snap.Accesses = s.snapshotAccesses(scopes)
+	snap.Generation = s.rootScope().generation
+	return &snap
+}
+
+// inputProviders returns the providers that satisfy the given parameter
+// when resolved in this Scope.
+func (s *Scope) inputProviders(p *dot.Param) []provider {
+	if p.Group != "" {
+		return s.getAllGroupProviders(p.Group, p.Type.Elem())
+	}
+	return s.getAllValueProviders(p.Name, p.Type)
+}
+
+// snapshotEdges reports the edges from the given constructor to the
+// providers of its parameters, as seen from the scope that the constructor
+// was provided to.
+func (s *Scope) snapshotEdges(n *constructorNode) []EdgeSnapshot {
+	var edges []EdgeSnapshot
+	for _, p := range n.paramList.DotParam() {
+		in := newInput(p)
+		for _, pr := range s.inputProviders(p) {
+			edges = append(edges, EdgeSnapshot{
+				From:  ID(n.id),
+				To:    ID(pr.ID()),
+				Input: in,
+			})
+		}
+	}
+	return edges
+}
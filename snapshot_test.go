@@ -0,0 +1,150 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestInspectSnapshot(t *testing.T) {
+	t.Parallel()
+
+	type A struct{}
+	type B struct{}
+
+	t.Run("providers and edges", func(t *testing.T) {
+		c := digtest.New(t)
+
+		var infoA, infoB dig.ProvideInfo
+		c.RequireProvide(func() *A { return &A{} }, dig.FillProvideInfo(&infoA))
+		c.RequireProvide(func(*A) *B { return &B{} }, dig.FillProvideInfo(&infoB))
+
+		snap := c.InspectSnapshot()
+		require.Len(t, snap.Providers, 2)
+
+		pa, pb := snap.Providers[0], snap.Providers[1]
+		assert.Equal(t, infoA.ID, pa.ID)
+		assert.Equal(t, infoB.ID, pb.ID)
+		assert.Equal(t, "go.uber.org/dig_test", pa.Location.Package)
+		assert.Equal(t, "*dig_test.A", pa.Outputs[0].String())
+		assert.Equal(t, "*dig_test.A", pb.Inputs[0].String())
+		assert.False(t, pa.Called)
+
+		require.Len(t, snap.Edges, 1)
+		assert.Equal(t, infoB.ID, snap.Edges[0].From)
+		assert.Equal(t, infoA.ID, snap.Edges[0].To)
+		assert.Empty(t, snap.Cached)
+	})
+
+	t.Run("unchanged by later mutations", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{} })
+
+		snap := c.InspectSnapshot()
+
+		c.RequireProvide(func(*A) *B { return &B{} })
+		c.RequireInvoke(func(*B) {})
+
+		require.Len(t, snap.Providers, 1)
+		assert.False(t, snap.Providers[0].Called)
+		assert.Empty(t, snap.Edges)
+		assert.Empty(t, snap.Cached)
+
+		after := c.InspectSnapshot()
+		require.Len(t, after.Providers, 2)
+		assert.True(t, after.Providers[0].Called)
+		require.Len(t, after.Cached, 2)
+		assert.Equal(t, "*dig_test.A", after.Cached[0].String())
+		assert.Equal(t, "*dig_test.B", after.Cached[1].String())
+	})
+
+	t.Run("scopes", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{} })
+		child := c.Scope("child")
+		child.RequireProvide(func() *B { return &B{} })
+
+		snap := c.InspectSnapshot()
+		require.Len(t, snap.Providers, 2)
+		assert.Equal(t, "", snap.Providers[0].Scope)
+		assert.Equal(t, "child", snap.Providers[1].Scope)
+
+		childSnap := child.InspectSnapshot()
+		require.Len(t, childSnap.Providers, 1)
+		assert.Equal(t, "child", childSnap.Providers[0].Scope)
+	})
+
+	t.Run("value groups", func(t *testing.T) {
+		type In struct {
+			dig.In
+
+			Strings []string `group:"str"`
+		}
+
+		c := digtest.New(t)
+		c.RequireProvide(func() string { return "a" }, dig.Group("str"))
+		c.RequireProvide(func(In) *A { return &A{} })
+		c.RequireInvoke(func(*A) {})
+
+		snap := c.InspectSnapshot()
+		require.Len(t, snap.Edges, 1)
+		assert.Equal(t, `[]string[group = "str"]`, snap.Edges[0].Input.String())
+		assert.Equal(t, `string[group = "str"]`, snap.Cached[1].String())
+	})
+}
+
+func TestGeneration(t *testing.T) {
+	t.Parallel()
+
+	type A struct{}
+	type B struct{}
+
+	c := digtest.New(t)
+	assert.Zero(t, c.Generation())
+
+	var info dig.ProvideInfo
+	var h dig.ProviderHandle
+	c.RequireProvide(func() *A { return &A{} }, dig.FillProvideInfo(&info), dig.FillProviderHandle(&h))
+	assert.Equal(t, uint64(1), info.Generation)
+	assert.Equal(t, uint64(1), c.Generation())
+
+	c.RequireInvoke(func(*A) {})
+	assert.Equal(t, uint64(1), c.Generation(), "building values must not change the generation")
+
+	var dinfo dig.DecorateInfo
+	c.RequireDecorate(func(a *A) *A { return a }, dig.FillDecorateInfo(&dinfo))
+	assert.Equal(t, uint64(2), dinfo.Generation)
+
+	child := c.Scope("child")
+	require.NoError(t, child.Provide(func() *B { return &B{} }))
+	assert.Equal(t, uint64(4), child.Generation())
+
+	h.Disable()
+	h.Disable()
+	assert.Equal(t, uint64(5), c.Generation())
+	h.Enable()
+	assert.Equal(t, uint64(6), c.InspectSnapshot().Generation)
+}
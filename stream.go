@@ -0,0 +1,188 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"go.uber.org/dig/internal/digreflect"
+)
+
+// Stream is a stream of values produced by a function called with
+// InvokeStream.
+type Stream[T any] struct {
+	// C receives the values sent by the invoked function. It's closed
+	// after the function closes its channel, or the stream is stopped,
+	// and the Scope has been torn down.
+	C <-chan T
+
+	stop     chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+	err      error
+}
+
+// Stop ends the stream early, as if the function had closed its channel.
+// Values it sends afterwards are discarded until it does close it.
+// Functions that may send values forever should also stop once the
+// request-scoped values they use are torn down. Stop may be called more
+// than once, and after the stream has ended.
+func (st *Stream[T]) Stop() {
+	st.stopOnce.Do(func() { close(st.stop) })
+}
+
+// Wait blocks until the stream has ended and returns the error from tearing
+// down the Scope, if any.
+func (st *Stream[T]) Wait() error {
+	<-st.done
+	return st.err
+}
+
+// InvokeStream runs the given function like Invoke and streams the values
+// it sends on the channel it returns. See InvokeStreamScope.
+func InvokeStream[T any](c *Container, function interface{}, opts ...InvokeOption) (*Stream[T], error) {
+	return InvokeStreamScope[T](c.scope, function, opts...)
+}
+
+// InvokeStreamScope runs the given function like Scope.Invoke, and streams
+// the values it sends on the channel it returns. The function must return
+// a channel of T as its first result, and may return an error as its last
+// result.
+//
+//	st, err := dig.InvokeStreamScope[*Event](req, func(src *EventSource) (<-chan *Event, error) {
+//	  return src.Subscribe()
+//	})
+//	if err != nil {
+//	  return err
+//	}
+//	defer st.Stop()
+//	for ev := range st.C {
+//	  // ...
+//	}
+//
+// If the Scope was created with Request, it's kept alive until the function
+// closes its channel or the stream is stopped, and then closed as with
+// Scope.Close. This ties the lifetime of request-scoped values to the
+// stream that uses them. Use Stream.Wait to learn whether they were torn
+// down cleanly. Other Scopes are left as-is.
+//
+// Values must be received from the stream until it's closed, or it must
+// be stopped with Stream.Stop; otherwise the function producing them
+// blocks and the Scope is never closed. If InvokeStreamScope fails, the
+// Scope is not closed. With ValidateOnly, the function isn't called, and
+// InvokeStreamScope returns a nil Stream along with the validation error,
+// if any.
+func InvokeStreamScope[T any](s *Scope, function interface{}, opts ...InvokeOption) (*Stream[T], error) {
+	ftype := reflect.TypeOf(function)
+	if ftype != nil && ftype.Kind() == reflect.Func {
+		if err := validateStreamFunc(ftype, reflect.TypeOf((*T)(nil)).Elem()); err != nil {
+			return nil, err
+		}
+	}
+
+	var options invokeOptions
+	for _, o := range opts {
+		o.applyInvokeOption(&options)
+	}
+
+	returned, err := s.invoke(function, options)
+	if err != nil || len(returned) == 0 {
+		return nil, err
+	}
+	if last := returned[len(returned)-1]; isError(last.Type()) {
+		if err, _ := last.Interface().(error); err != nil {
+			return nil, err
+		}
+	}
+
+	src := returned[0]
+	if src.IsNil() {
+		return nil, newErrInvalidInput(
+			fmt.Sprintf("%v returned a nil channel", digreflect.InspectFunc(function)), nil)
+	}
+
+	out := make(chan T)
+	st := &Stream[T]{C: out, stop: make(chan struct{}), done: make(chan struct{})}
+	go func() {
+		stopped := st.forward(src, out)
+		st.err = s.Close()
+		close(out)
+		close(st.done)
+
+		// Keep the function from blocking on values nobody will receive.
+		if stopped {
+			for {
+				if _, ok := src.Recv(); !ok {
+					return
+				}
+			}
+		}
+	}()
+	return st, nil
+}
+
+// forward sends the values received from src to out until src is closed
+// or the stream is stopped. It reports whether the stream was stopped.
+func (st *Stream[T]) forward(src reflect.Value, out chan<- T) (stopped bool) {
+	cases := []reflect.SelectCase{
+		{Dir: reflect.SelectRecv, Chan: src},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(st.stop)},
+	}
+	for {
+		chosen, v, ok := reflect.Select(cases)
+		switch {
+		case chosen == 1:
+			return true
+		case !ok:
+			return false
+		}
+
+		// Convert through reflect so that nil interface values
+		// don't fail a type assertion.
+		var x T
+		reflect.ValueOf(&x).Elem().Set(v)
+		select {
+		case out <- x:
+		case <-st.stop:
+			return true
+		}
+	}
+}
+
+// validateStreamFunc checks that a function passed to InvokeStreamScope returns a
+// channel of the given type as its first result.
+func validateStreamFunc(ftype, elem reflect.Type) error {
+	if ftype.NumOut() > 0 {
+		out := ftype.Out(0)
+		if out.Kind() == reflect.Chan && out.Elem() == elem && out.ChanDir()&reflect.RecvDir != 0 {
+			switch {
+			case ftype.NumOut() == 1:
+				return nil
+			case ftype.NumOut() == 2 && isError(ftype.Out(1)):
+				return nil
+			}
+		}
+	}
+	return newErrInvalidInput(fmt.Sprintf(
+		"%v must return <-chan %v as its first result, optionally followed by an error", ftype, elem), nil)
+}
@@ -0,0 +1,283 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestInvokeStream(t *testing.T) {
+	t.Parallel()
+
+	type Source struct{ values []int }
+
+	t.Run("closes request scope when done", func(t *testing.T) {
+		c := digtest.New(t)
+
+		var closed []string
+		c.RequireProvide(func() (*Source, func()) {
+			return &Source{values: []int{1, 2, 3}}, func() { closed = append(closed, "source") }
+		}, dig.RequestScoped(), dig.ReturnsCleanup())
+
+		req := c.Request()
+		st, err := dig.InvokeStreamScope[int](req, func(src *Source) <-chan int {
+			ch := make(chan int)
+			go func() {
+				defer close(ch)
+				for _, v := range src.values {
+					ch <- v
+				}
+			}()
+			return ch
+		})
+		require.NoError(t, err)
+
+		var got []int
+		for v := range st.C {
+			// The stream can't end before we receive the last value.
+			if v < 3 {
+				assert.Empty(t, closed, "scope must be alive while streaming")
+			}
+			got = append(got, v)
+		}
+		assert.Equal(t, []int{1, 2, 3}, got)
+		assert.NoError(t, st.Wait())
+		assert.Equal(t, []string{"source"}, closed)
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		c := digtest.New(t)
+
+		type Ticker struct{ done chan struct{} }
+		c.RequireProvide(func() (*Ticker, func()) {
+			tk := &Ticker{done: make(chan struct{})}
+			return tk, func() { close(tk.done) }
+		}, dig.RequestScoped(), dig.ReturnsCleanup())
+
+		exited := make(chan struct{})
+		st, err := dig.InvokeStreamScope[int](c.Request(), func(tk *Ticker) <-chan int {
+			ch := make(chan int)
+			go func() {
+				defer close(exited)
+				defer close(ch)
+				for i := 0; ; i++ {
+					select {
+					case ch <- i:
+					case <-tk.done:
+						return
+					}
+				}
+			}()
+			return ch
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, 0, <-st.C)
+		st.Stop()
+		assert.NoError(t, st.Wait())
+		for range st.C {
+			// C is closed once the stream has ended, and may have
+			// received one more value before Stop.
+		}
+		<-exited
+		st.Stop()
+	})
+
+	t.Run("stop with a function that keeps sending", func(t *testing.T) {
+		c := digtest.New(t)
+
+		var closed []string
+		c.RequireProvide(func() *testCloser {
+			return &testCloser{name: "conn", closed: &closed}
+		}, dig.RequestScoped())
+
+		quit := make(chan struct{})
+		exited := make(chan struct{})
+		st, err := dig.InvokeStreamScope[int](c.Request(), func(*testCloser) <-chan int {
+			ch := make(chan int)
+			go func() {
+				defer close(exited)
+				defer close(ch)
+				for i := 0; ; i++ {
+					ch <- i
+					select {
+					case <-quit:
+						return
+					default:
+					}
+				}
+			}()
+			return ch
+		})
+		require.NoError(t, err)
+
+		st.Stop()
+		assert.NoError(t, st.Wait())
+		assert.Equal(t, []string{"conn"}, closed)
+
+		// Values sent after Stop are discarded rather than blocking the
+		// function.
+		close(quit)
+		<-exited
+	})
+
+	t.Run("container", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *Source { return &Source{values: []int{1, 2}} })
+
+		st, err := dig.InvokeStream[int](c.Container, func(src *Source) <-chan int {
+			ch := make(chan int, len(src.values))
+			for _, v := range src.values {
+				ch <- v
+			}
+			close(ch)
+			return ch
+		})
+		require.NoError(t, err)
+
+		var got []int
+		for v := range st.C {
+			got = append(got, v)
+		}
+		assert.Equal(t, []int{1, 2}, got)
+		assert.NoError(t, st.Wait())
+	})
+
+	t.Run("teardown error", func(t *testing.T) {
+		c := digtest.New(t)
+
+		var closed []string
+		c.RequireProvide(func() *testCloser {
+			return &testCloser{name: "conn", closed: &closed, err: errors.New("great sadness")}
+		}, dig.RequestScoped())
+
+		req := c.Request()
+		st, err := dig.InvokeStreamScope[string](req, func(*testCloser) (chan string, error) {
+			ch := make(chan string, 1)
+			ch <- "hello"
+			close(ch)
+			return ch, nil
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, "hello", <-st.C)
+		_, ok := <-st.C
+		assert.False(t, ok)
+
+		err = st.Wait()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "great sadness")
+	})
+
+	t.Run("nil interface elements", func(t *testing.T) {
+		c := digtest.New(t)
+		req := c.Request()
+		st, err := dig.InvokeStreamScope[error](req, func() <-chan error {
+			ch := make(chan error, 2)
+			ch <- nil
+			ch <- errors.New("great sadness")
+			close(ch)
+			return ch
+		})
+		require.NoError(t, err)
+
+		var got []error
+		for v := range st.C {
+			got = append(got, v)
+		}
+		require.Len(t, got, 2)
+		assert.Nil(t, got[0])
+		assert.EqualError(t, got[1], "great sadness")
+		assert.NoError(t, st.Wait())
+	})
+
+	t.Run("invoked function fails", func(t *testing.T) {
+		c := digtest.New(t)
+		_, err := dig.InvokeStreamScope[int](c.Request(), func() (<-chan int, error) {
+			return nil, errors.New("great sadness")
+		})
+		require.Error(t, err)
+		assert.Equal(t, "great sadness", err.Error())
+	})
+
+	t.Run("nil channel", func(t *testing.T) {
+		c := digtest.New(t)
+		_, err := dig.InvokeStreamScope[int](c.Request(), func() <-chan int {
+			return nil
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "returned a nil channel")
+	})
+
+	t.Run("wrong return type", func(t *testing.T) {
+		c := digtest.New(t)
+		_, err := dig.InvokeStreamScope[int](c.Request(), func() <-chan string {
+			t.Fatal("this function must not be called")
+			return nil
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(),
+			"func() <-chan string must return <-chan int as its first result, optionally followed by an error")
+	})
+
+	t.Run("send-only channel", func(t *testing.T) {
+		c := digtest.New(t)
+		_, err := dig.InvokeStreamScope[int](c.Request(), func() chan<- int {
+			t.Fatal("this function must not be called")
+			return nil
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must return <-chan int")
+	})
+
+	t.Run("missing dependencies", func(t *testing.T) {
+		c := digtest.New(t)
+		_, err := dig.InvokeStreamScope[int](c.Request(), func(*Source) <-chan int {
+			t.Fatal("this function must not be called")
+			return nil
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing type: *dig_test.Source")
+	})
+
+	t.Run("ValidateOnly", func(t *testing.T) {
+		c := digtest.New(t)
+		fn := func(*Source) <-chan int {
+			t.Fatal("this function must not be called")
+			return nil
+		}
+
+		_, err := dig.InvokeStreamScope[int](c.Request(), fn, dig.ValidateOnly())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing type: *dig_test.Source")
+
+		c.RequireProvide(func() *Source { return &Source{} })
+		st, err := dig.InvokeStreamScope[int](c.Request(), fn, dig.ValidateOnly())
+		require.NoError(t, err)
+		assert.Nil(t, st)
+	})
+}
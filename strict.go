@@ -0,0 +1,163 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// Strict is an Option that turns on a set of checks that catch common
+// mistakes in how a container is wired. With it,
+//
+//   - constructors may not return nil pointers, interfaces, maps,
+//     channels, or functions;
+//   - value groups must have at least one value when they're requested,
+//     unless they're soft;
+//   - constructors may not be provided after the first Invoke that
+//     succeeds;
+//   - values of a group are provided in the order their constructors were
+//     called in, instead of a random order; and
+//   - constructors may not provide a value that a parent or child Scope
+//     also provides.
+//
+// When a constructor returns a nil result, its cleanup function and the
+// other values it returned that would be closed are torn down right away,
+// before Invoke returns the error. Results are not checked for nil when the
+// container is also built with DryRun(true).
+func Strict() Option {
+	return strictOption{}
+}
+
+type strictOption struct{}
+
+func (strictOption) String() string {
+	return "Strict()"
+}
+
+func (strictOption) applyOption(c *Container) {
+	c.scope.strict = true
+}
+
+// isStrict reports whether the container this Scope belongs to was built
+// with Strict.
+func (s *Scope) isStrict() bool {
+	return s.rootScope().strict
+}
+
+// checkStrictProvide verifies that the given keys may be provided to this
+// Scope in strict mode.
+func (s *Scope) checkStrictProvide(ctype reflect.Type, keys map[key]struct{}) error {
+	if !s.isStrict() {
+		return nil
+	}
+	if s.rootScope().invoked {
+		return newErrInvalidInput(
+			fmt.Sprintf("cannot provide %v after Invoke in strict mode", ctype), nil)
+	}
+
+	related := append(s.ancestors()[1:], s.appendSubscopes(nil)[1:]...)
+	for k := range keys {
+		if k.group != "" {
+			continue
+		}
+		for _, rs := range related {
+			ps := rs.providers[k]
+			if len(ps) == 0 {
+				continue
+			}
+			cons := make([]string, len(ps))
+			for i, p := range ps {
+				cons[i] = fmt.Sprint(p.Location())
+			}
+			return newErrInvalidInput(
+				fmt.Sprintf("cannot provide %v from %v in strict mode", k, ctype),
+				newErrInvalidInput(fmt.Sprintf("shadows %v in another Scope", strings.Join(cons, "; ")), nil))
+		}
+	}
+	return nil
+}
+
+// checkNilResults verifies that a constructor produced no nil values in
+// strict mode.
+func (s *Scope) checkNilResults(w *stagingContainerWriter) error {
+	root := s.rootScope()
+	if !root.strict || root.dryRun {
+		return nil
+	}
+	for k, v := range w.values {
+		if isNilResult(v) {
+			return errNilResult{Key: k}
+		}
+	}
+	for k, vs := range w.groups {
+		for _, v := range vs {
+			if isNilResult(v) {
+				return errNilResult{Key: k}
+			}
+		}
+	}
+	return nil
+}
+
+// isNilResult reports whether v is a nil value that a constructor may not
+// return in strict mode. Nil slices are usable, so they're allowed.
+func isNilResult(v reflect.Value) bool {
+	return v.Kind() != reflect.Slice && isNilValue(v)
+}
+
+// errNilResult is returned in strict mode when a constructor returns a nil
+// value.
+type errNilResult struct {
+	Key key
+}
+
+var _ digError = errNilResult{}
+
+func (e errNilResult) Error() string { return fmt.Sprint(e) }
+
+func (e errNilResult) writeMessage(w io.Writer, _ string) {
+	fmt.Fprintf(w, "returned a nil %v in strict mode", e.Key)
+}
+
+func (e errNilResult) Format(w fmt.State, c rune) {
+	formatError(e, w, c)
+}
+
+// errEmptyGroup is returned in strict mode when a value group with no
+// values is requested.
+type errEmptyGroup struct {
+	Key key
+}
+
+var _ digError = errEmptyGroup{}
+
+func (e errEmptyGroup) Error() string { return fmt.Sprint(e) }
+
+func (e errEmptyGroup) writeMessage(w io.Writer, _ string) {
+	fmt.Fprintf(w, "no values provided for %v in strict mode", e.Key)
+}
+
+func (e errEmptyGroup) Format(w fmt.State, c rune) {
+	formatError(e, w, c)
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestStrict(t *testing.T) {
+	t.Parallel()
+
+	type A struct{}
+
+	t.Run("nil result", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.Strict())
+		c.RequireProvide(func() *A { return nil })
+
+		err := c.Invoke(func(*A) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "returned a nil *dig_test.A in strict mode")
+	})
+
+	t.Run("nil result with cleanup", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.Strict())
+		var cleaned bool
+		c.RequireProvide(func() (*A, func()) {
+			return nil, func() { cleaned = true }
+		}, dig.ReturnsCleanup())
+
+		err := c.Invoke(func(*A) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "returned a nil *dig_test.A in strict mode")
+		assert.True(t, cleaned, "cleanup must run when the values are rejected")
+	})
+
+	t.Run("nil slice result is allowed", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.Strict())
+		c.RequireProvide(func() []string { return nil })
+		c.RequireInvoke(func([]string) {})
+	})
+
+	t.Run("nil result in dry run", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.Strict(), dig.DryRun(true))
+		c.RequireProvide(func() *A { return &A{} })
+		c.RequireInvoke(func(*A) {})
+	})
+
+	t.Run("empty group", func(t *testing.T) {
+		t.Parallel()
+
+		type params struct {
+			dig.In
+
+			Values []int `group:"values"`
+		}
+
+		c := digtest.New(t, dig.Strict())
+		err := c.Invoke(func(params) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `no values provided for int[group="values"] in strict mode`)
+	})
+
+	t.Run("empty soft group is allowed", func(t *testing.T) {
+		t.Parallel()
+
+		type params struct {
+			dig.In
+
+			Values []int `group:"values,soft"`
+		}
+
+		c := digtest.New(t, dig.Strict())
+		c.RequireInvoke(func(p params) {
+			assert.Empty(t, p.Values)
+		})
+	})
+
+	t.Run("provide after invoke", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.Strict())
+		c.RequireProvide(func() int { return 1 })
+		c.RequireInvoke(func(int) {})
+
+		err := c.Provide(func() string { return "" })
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot provide func() string after Invoke in strict mode")
+	})
+
+	t.Run("provide after failed invoke", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.Strict())
+		require.Error(t, c.Invoke(func(string) {}))
+		require.Error(t, c.Invoke(func() error { return errors.New("great sadness") }))
+
+		c.RequireProvide(func() string { return "hello" })
+		c.RequireInvoke(func(string) {})
+	})
+
+	t.Run("group order is deterministic", func(t *testing.T) {
+		t.Parallel()
+
+		type params struct {
+			dig.In
+
+			Values []int `group:"values"`
+		}
+
+		c := digtest.New(t, dig.Strict())
+		for i := 0; i < 10; i++ {
+			i := i
+			c.RequireProvide(func() int { return i }, dig.Group("values"))
+		}
+		c.RequireInvoke(func(p params) {
+			assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, p.Values)
+		})
+	})
+
+	t.Run("shadowing a parent", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.Strict())
+		c.RequireProvide(func() *A { return &A{} })
+
+		err := c.Scope("child").Provide(func() *A { return &A{} })
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot provide *dig_test.A from func() *dig_test.A in strict mode")
+		assert.Contains(t, err.Error(), "in another Scope")
+	})
+
+	t.Run("shadowing a child", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.Strict())
+		child := c.Scope("child")
+		require.NoError(t, child.Provide(func() *A { return &A{} }))
+
+		err := c.Provide(func() *A { return &A{} })
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "in another Scope")
+	})
+
+	t.Run("groups across scopes are allowed", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.Strict())
+		c.RequireProvide(func() int { return 1 }, dig.Group("values"))
+		require.NoError(t, c.Scope("child").Provide(func() int { return 2 }, dig.Group("values")))
+	})
+}
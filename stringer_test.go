@@ -22,6 +22,7 @@ package dig_test
 
 import (
 	"math/rand"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -106,4 +107,15 @@ func TestStringer(t *testing.T) {
 	assert.Contains(t, s, `string[group="baz"] => foo`)
 	assert.Contains(t, s, `string[group="baz"] => bar`)
 	assert.Contains(t, s, `string[group="baz"] => baz`)
+
+	// Constructor locations
+	assert.Regexp(t, `dig_test.B -> deps: \[\], ctor: func\(\) dig_test.B at "go.uber.org/dig_test".TestStringer.func\d+ \(\S+/stringer_test.go:\d+\)`, s)
+
+	// Keys are sorted, and group members are listed in order.
+	assert.Equal(t, s, c.String(), "String must be deterministic")
+	assert.Less(t, strings.Index(s, "dig_test.A -> "), strings.Index(s, "dig_test.B -> "))
+	assert.Less(t, strings.Index(s, "dig_test.C[name=\"bar\"] -> "), strings.Index(s, "dig_test.D -> "))
+	assert.Less(t, strings.Index(s, "dig_test.A => "), strings.Index(s, "dig_test.B => "))
+	assert.Less(t, strings.Index(s, "-> deps: [dig_test.A]"), strings.Index(s, "-> deps: [dig_test.B]"))
+	assert.Less(t, strings.Index(s, "-> deps: [dig_test.B]"), strings.Index(s, "-> deps: [dig_test.C]"))
 }
@@ -0,0 +1,133 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// StubMissing is an Option for development environments that substitutes
+// a stub for each requested value that has no constructor, instead of
+// failing. This lets servers boot while some of their dependencies are not
+// implemented yet.
+//
+//	c := dig.New(dig.StubMissing(fakeUserStore, func(id string) (*User, error) {
+//	  return &User{ID: id, Name: "Jane Doe"}, nil
+//	}))
+//
+// The given canned values are used for the types they can be assigned to,
+// in order. Values of other types are synthesized:
+//
+//   - functions return zero values for all their results
+//   - pointers point to zero values
+//   - maps, slices, and channels are empty
+//   - interfaces without methods are nil
+//   - other types are zero values
+//
+// Interfaces with methods can't be synthesized and must be given a canned
+// implementation; otherwise they're still reported as missing. Optional
+// values and values declared with Extern are never stubbed.
+//
+// Each value is stubbed once per Container. Stubbed values are reported as
+// DegradedStub by FillDegradationReport and listed in Snapshot.Stubbed.
+func StubMissing(canned ...interface{}) Option {
+	return stubMissingOption(canned)
+}
+
+type stubMissingOption []interface{}
+
+func (o stubMissingOption) String() string {
+	types := make([]string, len(o))
+	for i, v := range o {
+		types[i] = fmt.Sprint(reflect.TypeOf(v))
+	}
+	return fmt.Sprintf("StubMissing(%v)", strings.Join(types, ", "))
+}
+
+func (o stubMissingOption) applyOption(c *Container) {
+	c.scope.stubMissing = true
+	for _, v := range o {
+		if v != nil {
+			c.scope.stubCanned = append(c.scope.stubCanned, reflect.ValueOf(v))
+		}
+	}
+}
+
+// stub returns the stub for a value with the given name and type if the
+// container was built with StubMissing, creating it if needed.
+func (s *Scope) stub(name string, t reflect.Type) (reflect.Value, bool) {
+	root := s.rootScope()
+	if !root.stubMissing {
+		return _noValue, false
+	}
+
+	k := key{t: t, name: name}
+	if v, ok := root.stubs[k]; ok {
+		return v, true
+	}
+	v, ok := newStub(t, root.stubCanned)
+	if !ok {
+		return _noValue, false
+	}
+	if root.stubs == nil {
+		root.stubs = make(map[key]reflect.Value)
+	}
+	root.stubs[k] = v
+	return v, true
+}
+
+// newStub synthesizes a value of the given type, preferring the first
+// assignable canned value.
+func newStub(t reflect.Type, canned []reflect.Value) (reflect.Value, bool) {
+	for _, c := range canned {
+		if c.Type().AssignableTo(t) {
+			v := reflect.New(t).Elem()
+			v.Set(c)
+			return v, true
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.Interface:
+		if t.NumMethod() > 0 {
+			return _noValue, false
+		}
+	case reflect.Func:
+		return reflect.MakeFunc(t, func([]reflect.Value) []reflect.Value {
+			out := make([]reflect.Value, t.NumOut())
+			for i := range out {
+				out[i] = reflect.Zero(t.Out(i))
+			}
+			return out
+		}), true
+	case reflect.Ptr:
+		return reflect.New(t.Elem()), true
+	case reflect.Map:
+		return reflect.MakeMap(t), true
+	case reflect.Slice:
+		return reflect.MakeSlice(t, 0, 0), true
+	case reflect.Chan:
+		return reflect.MakeChan(t, 0), true
+	}
+	return reflect.Zero(t), true
+}
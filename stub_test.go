@@ -0,0 +1,138 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+type userStore interface {
+	Name(id string) string
+}
+
+type fakeUserStore struct{}
+
+func (fakeUserStore) Name(id string) string { return "user " + id }
+
+func TestStubMissing(t *testing.T) {
+	t.Parallel()
+
+	type Config struct{ Port int }
+	type Fetch func(string) (int, error)
+
+	t.Run("synthesized stubs", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.StubMissing())
+		var report dig.DegradationReport
+		c.RequireInvoke(func(cfg *Config, fetch Fetch, m map[string]int, port int) {
+			require.NotNil(t, cfg)
+			assert.Equal(t, 0, cfg.Port)
+
+			n, err := fetch("x")
+			assert.NoError(t, err)
+			assert.Zero(t, n)
+
+			assert.NotNil(t, m)
+			assert.Zero(t, port)
+		}, dig.FillDegradationReport(&report))
+
+		require.Len(t, report.Degradations, 4)
+		assert.Equal(t, dig.DegradedStub, report.Degradations[0].Kind)
+		assert.Equal(t, "*dig_test.Config stubbed", report.Degradations[0].String())
+
+		snap := c.InspectSnapshot()
+		require.Len(t, snap.Stubbed, 4)
+		assert.Equal(t, "*dig_test.Config", snap.Stubbed[0].String())
+	})
+
+	t.Run("stubs are reused", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.StubMissing())
+		var first *Config
+		c.RequireInvoke(func(cfg *Config) { first = cfg })
+		c.RequireInvoke(func(cfg *Config) { assert.Same(t, first, cfg) })
+	})
+
+	t.Run("canned values", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.StubMissing(
+			fakeUserStore{},
+			Fetch(func(string) (int, error) { return 42, nil }),
+		))
+		c.RequireInvoke(func(s userStore, fetch Fetch) {
+			assert.Equal(t, "user 1", s.Name("1"))
+
+			n, err := fetch("x")
+			assert.NoError(t, err)
+			assert.Equal(t, 42, n)
+		})
+	})
+
+	t.Run("provided values are not stubbed", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.StubMissing())
+		c.RequireProvide(func() *Config { return &Config{Port: 80} })
+		c.RequireInvoke(func(cfg *Config) {
+			assert.Equal(t, 80, cfg.Port)
+		})
+		assert.Empty(t, c.InspectSnapshot().Stubbed)
+	})
+
+	t.Run("interfaces need canned values", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.StubMissing())
+		err := c.Invoke(func(userStore) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing type: dig_test.userStore")
+	})
+
+	t.Run("optional values are absent", func(t *testing.T) {
+		t.Parallel()
+
+		type params struct {
+			dig.In
+
+			Config *Config `optional:"true"`
+		}
+		c := digtest.New(t, dig.StubMissing())
+		c.RequireInvoke(func(p params) {
+			assert.Nil(t, p.Config)
+		})
+	})
+
+	t.Run("String", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "StubMissing(dig_test.fakeUserStore, int)",
+			fmt.Sprint(dig.StubMissing(fakeUserStore{}, 1)))
+	})
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+)
+
+// Supply provides an already constructed value to the Container. See
+// Scope.Supply.
+func (c *Container) Supply(value interface{}, opts ...ProvideOption) error {
+	pc, _, _, _ := runtime.Caller(1)
+	return c.scope.supply(value, pc, opts)
+}
+
+// Supply provides an already constructed value to the Scope, without the
+// need to write a constructor that returns it.
+//
+//	c.Supply(&Config{Port: 8080})
+//	c.Supply(logger, dig.As(new(Logger)))
+//
+// It accepts the same options as Provide, including Name, Group, and As.
+// The value is provided under its own type; use As to provide an
+// interface instead. Errors can't be supplied.
+//
+// The value remains owned by the caller: if it implements io.Closer, it's
+// not closed by Container.Close or Container.Shutdown.
+func (s *Scope) Supply(value interface{}, opts ...ProvideOption) error {
+	pc, _, _, _ := runtime.Caller(1)
+	return s.supply(value, pc, opts)
+}
+
+// supply provides a value to the Scope, attributing it to the code at the
+// given program counter.
+func (s *Scope) supply(value interface{}, pc uintptr, opts []ProvideOption) error {
+	ctor, err := supplyConstructor(value)
+	if err != nil {
+		return err
+	}
+	return s.Provide(ctor, append([]ProvideOption{LocationForPC(pc), SkipClose()}, opts...)...)
+}
+
+// supplyConstructor builds a constructor that returns the given value.
+func supplyConstructor(v interface{}) (interface{}, error) {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return nil, newErrInvalidInput("cannot supply an untyped nil", nil)
+	}
+	if isError(t) {
+		return nil, newErrInvalidInput(fmt.Sprintf("cannot supply an error: %v", v), nil)
+	}
+
+	value := reflect.ValueOf(v)
+	fn := reflect.MakeFunc(
+		reflect.FuncOf(nil, []reflect.Type{t}, false),
+		func([]reflect.Value) []reflect.Value { return []reflect.Value{value} },
+	)
+	return fn.Interface(), nil
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestSupply(t *testing.T) {
+	t.Parallel()
+
+	type Config struct{ Port int }
+
+	t.Run("value", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		cfg := &Config{Port: 8080}
+		require.NoError(t, c.Supply(cfg))
+		c.RequireInvoke(func(got *Config) {
+			assert.Same(t, cfg, got)
+		})
+	})
+
+	t.Run("name", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		require.NoError(t, c.Supply("hello", dig.Name("greeting")))
+
+		type params struct {
+			dig.In
+
+			Greeting string `name:"greeting"`
+		}
+		c.RequireInvoke(func(p params) {
+			assert.Equal(t, "hello", p.Greeting)
+		})
+	})
+
+	t.Run("group", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		require.NoError(t, c.Supply(1, dig.Group("values")))
+		require.NoError(t, c.Supply(2, dig.Group("values")))
+
+		type params struct {
+			dig.In
+
+			Values []int `group:"values"`
+		}
+		c.RequireInvoke(func(p params) {
+			assert.ElementsMatch(t, []int{1, 2}, p.Values)
+		})
+	})
+
+	t.Run("as", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		require.NoError(t, c.Supply(strings.NewReader("hello"), dig.As(new(io.Reader))))
+		c.RequireInvoke(func(r io.Reader) {
+			b, err := io.ReadAll(r)
+			require.NoError(t, err)
+			assert.Equal(t, "hello", string(b))
+		})
+	})
+
+	t.Run("scope", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		child := c.Scope("child")
+		require.NoError(t, child.Supply(&Config{Port: 80}))
+		require.NoError(t, child.Invoke(func(*Config) {}))
+	})
+
+	t.Run("untyped nil", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		err := c.Supply(nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot supply an untyped nil")
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		err := c.Supply(errors.New("great sadness"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot supply an error")
+	})
+
+	t.Run("closers are left open", func(t *testing.T) {
+		t.Parallel()
+
+		var closed []string
+		c := digtest.New(t)
+		require.NoError(t, c.Supply(&testCloser{name: "supplied", closed: &closed}, dig.As(new(io.Closer))))
+		require.NoError(t, c.Use(dig.NewModule("m").Supply(&testCloser{name: "module", closed: &closed})))
+		c.RequireInvoke(func(io.Closer, *testCloser) {})
+
+		require.NoError(t, c.Close())
+		_, err := c.Shutdown(context.Background())
+		require.NoError(t, err)
+		assert.Empty(t, closed)
+	})
+
+	t.Run("conflict names caller", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		require.NoError(t, c.Supply(&Config{}))
+		err := c.Supply(&Config{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "supply_test.go")
+		assert.Contains(t, err.Error(), "already provided")
+	})
+}
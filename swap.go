@@ -0,0 +1,149 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+// Live holds the generation of a Container that is currently serving
+// requests, and lets it be replaced with another one without interrupting
+// callers.
+//
+// A typical config reload builds a standby generation from the serving one,
+// re-wires it, and swaps it in:
+//
+//	live := dig.NewLive(c)
+//
+//	// On reload:
+//	next := live.Fork()
+//	next.Replace(newConfig)
+//	if err := dig.Swap(live, next); err != nil {
+//	  log.Print(err)
+//	}
+//
+// Requests made through Live.Invoke keep running against the generation
+// they started with. Since requests are usually served concurrently, the
+// Containers served by a Live are made safe for concurrent use as if they
+// were built with Concurrent.
+type Live struct {
+	cur atomic.Value // *generation
+
+	// Serializes Swaps.
+	swapMu sync.Mutex
+}
+
+// generation is a single Container served by a Live.
+type generation struct {
+	c *Container
+
+	// mu is held for reading by in-flight Invokes, and for writing while
+	// the generation is retired.
+	mu      sync.RWMutex
+	retired bool
+}
+
+// NewLive returns a Live that serves the given Container.
+func NewLive(c *Container) *Live {
+	c.scope.setConcurrent()
+
+	var l Live
+	l.cur.Store(&generation{c: c})
+	return &l
+}
+
+// Container returns the Container currently being served.
+func (l *Live) Container() *Container {
+	return l.load().c
+}
+
+func (l *Live) load() *generation {
+	return l.cur.Load().(*generation)
+}
+
+// Fork returns a clone of the Container currently being served, to be
+// modified and installed with Swap. See Container.Clone.
+func (l *Live) Fork() *Container {
+	return l.Container().Clone()
+}
+
+// Invoke runs the given function against the Container currently being
+// served. Swap waits for the function to return before draining the
+// generation it ran against.
+func (l *Live) Invoke(function interface{}, opts ...InvokeOption) error {
+	for {
+		g := l.load()
+		g.mu.RLock()
+		if g.retired {
+			// Swapped out between loading it and locking it.
+			g.mu.RUnlock()
+			continue
+		}
+		err := g.c.Invoke(function, opts...)
+		g.mu.RUnlock()
+		return err
+	}
+}
+
+// Swap atomically installs next as the Container served by live, and
+// drains the generation it replaces. Invokes made through live after Swap
+// starts use next.
+//
+// Swap waits for in-flight Live.Invoke calls on the old generation to
+// return before carrying pinned values over to next and installing it;
+// Invokes made in the meantime wait for next. It then tears down its values as with Container.Shutdown, except
+// for values carried over to next because they were provided with Pin.
+// Swap returns an error aggregating any teardown failures; next is
+// installed regardless.
+func Swap(live *Live, next *Container) error {
+	return SwapContext(context.Background(), live, next)
+}
+
+// SwapContext is like Swap, but stops tearing down the old generation once
+// ctx expires. It still waits for in-flight Invokes to return first.
+func SwapContext(ctx context.Context, live *Live, next *Container) error {
+	if next == nil {
+		return newErrInvalidInput("cannot swap in a nil Container", nil)
+	}
+
+	live.swapMu.Lock()
+	defer live.swapMu.Unlock()
+
+	old := live.load()
+	if old.c == next {
+		return nil
+	}
+	next.scope.setConcurrent()
+
+	// Drain the old generation before adopting its pinned values, so that
+	// no Invoke is still building them. Invokes waiting on the lock see
+	// that it's retired once next is installed.
+	old.mu.Lock()
+	old.retired = true
+	next.scope.adoptPinned(old.c.scope)
+	live.cur.Store(&generation{c: next})
+	old.mu.Unlock()
+
+	_, err := old.c.Shutdown(ctx)
+	return err
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestSwap(t *testing.T) {
+	t.Parallel()
+
+	type Config struct{ Name string }
+	type Server struct{ Config *Config }
+
+	newContainer := func(t *testing.T, calls *[]string) *digtest.Container {
+		c := digtest.New(t)
+		c.RequireProvide(func() *Config { return &Config{Name: "v1"} })
+		c.RequireProvide(func(cfg *Config) (*Server, func()) {
+			return &Server{Config: cfg}, func() {
+				*calls = append(*calls, "stop "+cfg.Name)
+			}
+		}, dig.ReturnsCleanup())
+		return c
+	}
+
+	t.Run("serves new generation and drains old", func(t *testing.T) {
+		var calls []string
+		c := newContainer(t, &calls)
+		live := dig.NewLive(c.Container)
+
+		require.NoError(t, live.Invoke(func(s *Server) {
+			assert.Equal(t, "v1", s.Config.Name)
+		}))
+
+		next := live.Fork()
+		require.NoError(t, next.Replace(func() *Config { return &Config{Name: "v2"} }))
+		require.NoError(t, next.Invoke(func(*Server) {}))
+		assert.Empty(t, calls)
+
+		require.NoError(t, dig.Swap(live, next))
+		assert.Equal(t, []string{"stop v1"}, calls)
+		assert.Same(t, next, live.Container())
+
+		require.NoError(t, live.Invoke(func(s *Server) {
+			assert.Equal(t, "v2", s.Config.Name)
+		}))
+	})
+
+	t.Run("waits for in-flight invokes", func(t *testing.T) {
+		var calls []string
+		c := newContainer(t, &calls)
+		live := dig.NewLive(c.Container)
+
+		started := make(chan struct{})
+		release := make(chan struct{})
+		done := make(chan error)
+		go func() {
+			done <- live.Invoke(func(*Server) {
+				close(started)
+				<-release
+			})
+		}()
+		<-started
+
+		swapped := make(chan error)
+		go func() { swapped <- dig.Swap(live, live.Fork()) }()
+
+		select {
+		case <-swapped:
+			t.Fatal("Swap must wait for in-flight invokes")
+		default:
+		}
+
+		close(release)
+		require.NoError(t, <-done)
+		require.NoError(t, <-swapped)
+		assert.Equal(t, []string{"stop v1"}, calls)
+	})
+
+	t.Run("concurrent invokes", func(t *testing.T) {
+		// The container isn't built with Concurrent.
+		c := digtest.New(t)
+		c.RequireProvide(func() *Config { return &Config{Name: "v1"} }, dig.Pin())
+		c.RequireProvide(func(cfg *Config) *Server { return &Server{Config: cfg} })
+		live := dig.NewLive(c.Container)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 10; j++ {
+					assert.NoError(t, live.Invoke(func(s *Server) {
+						assert.Equal(t, "v1", s.Config.Name)
+					}))
+				}
+			}()
+		}
+		require.NoError(t, dig.Swap(live, live.Fork()))
+		wg.Wait()
+	})
+
+	t.Run("teardown failures", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *failingCloser {
+			return &failingCloser{err: errors.New("great sadness")}
+		})
+		c.RequireInvoke(func(*failingCloser) {})
+
+		live := dig.NewLive(c.Container)
+		next := live.Fork()
+		err := dig.Swap(live, next)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "great sadness")
+		assert.Same(t, next, live.Container())
+	})
+
+	t.Run("nil container", func(t *testing.T) {
+		c := digtest.New(t)
+		live := dig.NewLive(c.Container)
+		err := dig.Swap(live, nil)
+		require.Error(t, err)
+		assert.Same(t, c.Container, live.Container())
+	})
+}
+
+type failingCloser struct{ err error }
+
+func (f *failingCloser) Close() error { return f.err }
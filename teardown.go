@@ -0,0 +1,191 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"reflect"
+
+	"go.uber.org/dig/internal/digreflect"
+)
+
+// Teardown tears down the values requested by the parameters of the given
+// function, as if it was passed to Invoke, so that they're built anew the
+// next time they're requested. This allows a subsystem to be restarted
+// without restarting the whole application.
+//
+//	// Restart the Kafka consumer stack.
+//	err := c.Teardown(func(*kafka.Consumer) {})
+//
+// Along with those values, Teardown tears down every value built from them
+// and every dependency that no other constructor called so far uses. The
+// cleanup functions and io.Closers of the torn down values are run in the
+// reverse order in which their constructors were called, and Teardown
+// returns an error aggregating their failures. Shared dependencies are
+// left intact, and so are values that have already been handed to invoked
+// functions.
+//
+// Teardown fails without tearing anything down if the values include
+// members of value groups or request-scoped values.
+func (c *Container) Teardown(root interface{}) error {
+	s := c.scope
+	tds, err := s.teardownSubtree(root)
+	if err != nil {
+		return err
+	}
+	return runTeardowns(tds)
+}
+
+// teardownSubtree forgets the values of the constructors torn down by
+// Teardown and returns their teardowns.
+func (s *Scope) teardownSubtree(root interface{}) ([]teardown, error) {
+	defer s.lock()()
+
+	ftype := reflect.TypeOf(root)
+	if ftype == nil || ftype.Kind() != reflect.Func {
+		return nil, newErrInvalidInput(
+			fmt.Sprintf("can't tear down values for non-function %v (type %v)", root, ftype), nil)
+	}
+	pl, err := newParamList(ftype, s)
+	if err != nil {
+		return nil, err
+	}
+	if err := shallowCheckDependencies(s, pl); err != nil {
+		return nil, errMissingDependencies{
+			Func:   digreflect.InspectFunc(root),
+			Reason: err,
+		}
+	}
+
+	// Direct dependencies of every constructor called so far.
+	deps := make(map[*constructorNode][]*constructorNode)
+	for _, scope := range s.appendSubscopes(nil) {
+		for _, n := range scope.nodes {
+			if n.called {
+				deps[n] = directDependencies(n.OrigScope(), n.paramList)
+			}
+		}
+	}
+
+	torn := make(map[*constructorNode]struct{})
+	for _, n := range directDependencies(s, pl) {
+		if n.called {
+			torn[n] = struct{}{}
+		}
+	}
+
+	// Values built from torn down values hold on to them, so they're torn
+	// down too.
+	for added := true; added; {
+		added = false
+		for n, ds := range deps {
+			if _, ok := torn[n]; ok {
+				continue
+			}
+			for _, d := range ds {
+				if _, ok := torn[d]; ok {
+					torn[n] = struct{}{}
+					added = true
+					break
+				}
+			}
+		}
+	}
+
+	// Dependencies used only by torn down values are torn down with them.
+	for added := true; added; {
+		added = false
+		users := make(map[*constructorNode]int)
+		for n, ds := range deps {
+			if _, ok := torn[n]; ok {
+				continue
+			}
+			for _, d := range ds {
+				users[d]++
+			}
+		}
+		for n := range torn {
+			for _, d := range deps[n] {
+				if _, ok := torn[d]; ok || !d.called || users[d] > 0 {
+					continue
+				}
+				torn[d] = struct{}{}
+				added = true
+			}
+		}
+	}
+
+	for n := range torn {
+		if n.request {
+			return nil, newErrInvalidInput(fmt.Sprintf(
+				"cannot tear down values of %v: they're request-scoped", n.location), nil)
+		}
+		for _, k := range resultKeys(n) {
+			if k.group != "" {
+				return nil, newErrInvalidInput(fmt.Sprintf(
+					"cannot tear down values of %v: %v is a value group", n.location, k), nil)
+			}
+		}
+	}
+
+	funcs := make(map[*digreflect.Func]struct{}, len(torn))
+	keys := make(map[key]struct{})
+	for n := range torn {
+		funcs[n.location] = struct{}{}
+		for _, k := range resultKeys(n) {
+			delete(n.s.values, k)
+			keys[k] = struct{}{}
+		}
+		n.called = false
+		n.failure = nil
+	}
+
+	// Decorated values are decorated anew along with their originals.
+	for _, scope := range s.appendSubscopes(nil) {
+		for _, dn := range scope.decoratorNodes {
+			for _, k := range dn.keys {
+				if _, ok := keys[k]; ok {
+					dn.state = decoratorReady
+					for _, dk := range dn.keys {
+						delete(scope.decoratedValues, dk)
+					}
+					break
+				}
+			}
+		}
+	}
+
+	return s.rootScope().takeTeardownsLocked(func(td teardown) bool {
+		_, ok := funcs[td.Func]
+		return ok
+	}), nil
+}
+
+// directDependencies returns the constructors that provide the values
+// requested by the given parameters when resolved in the given Scope.
+func directDependencies(s *Scope, p param) []*constructorNode {
+	var nodes []*constructorNode
+	walkDependencies(s, p, func(_ *Scope, _ param, n *constructorNode) bool {
+		nodes = append(nodes, n)
+		return false
+	})
+	return nodes
+}
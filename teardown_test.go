@@ -0,0 +1,145 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestTeardown(t *testing.T) {
+	t.Parallel()
+
+	type Logger struct{}
+	type Client struct{ gen int }
+	type Consumer struct{ Client *Client }
+	type Handler struct{ Consumer *Consumer }
+	type Server struct{ Logger *Logger }
+
+	t.Run("tears down a subtree", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			calls []string
+			gen   int
+		)
+		c := digtest.New(t)
+		c.RequireProvide(func() (*Logger, func()) {
+			return &Logger{}, func() { calls = append(calls, "logger") }
+		}, dig.ReturnsCleanup())
+		c.RequireProvide(func(*Logger) (*Client, func()) {
+			gen++
+			return &Client{gen: gen}, func() { calls = append(calls, "client") }
+		}, dig.ReturnsCleanup())
+		c.RequireProvide(func(_ *Logger, cl *Client) (*Consumer, func()) {
+			return &Consumer{Client: cl}, func() { calls = append(calls, "consumer") }
+		}, dig.ReturnsCleanup())
+		c.RequireProvide(func(cons *Consumer) (*Handler, func()) {
+			return &Handler{Consumer: cons}, func() { calls = append(calls, "handler") }
+		}, dig.ReturnsCleanup())
+		c.RequireProvide(func(l *Logger) *Server { return &Server{Logger: l} })
+
+		var logger *Logger
+		c.RequireInvoke(func(h *Handler, s *Server) {
+			assert.Equal(t, 1, h.Consumer.Client.gen)
+			logger = s.Logger
+		})
+
+		require.NoError(t, c.Teardown(func(*Consumer) {}))
+		assert.Equal(t, []string{"handler", "consumer", "client"}, calls)
+
+		c.RequireInvoke(func(h *Handler, l *Logger) {
+			assert.Equal(t, 2, h.Consumer.Client.gen)
+			assert.Same(t, logger, l)
+		})
+
+		calls = nil
+		c.Cleanup()
+		assert.Equal(t, []string{"handler", "consumer", "client", "logger"}, calls)
+	})
+
+	t.Run("keeps shared dependencies", func(t *testing.T) {
+		t.Parallel()
+
+		var gen int
+		c := digtest.New(t)
+		c.RequireProvide(func() *Client {
+			gen++
+			return &Client{gen: gen}
+		})
+		c.RequireProvide(func(cl *Client) *Consumer { return &Consumer{Client: cl} })
+		c.RequireProvide(func(*Client) *Logger { return &Logger{} })
+		c.RequireInvoke(func(*Consumer, *Logger) {})
+
+		require.NoError(t, c.Teardown(func(*Consumer) {}))
+		c.RequireInvoke(func(cons *Consumer) {
+			assert.Equal(t, 1, cons.Client.gen)
+		})
+	})
+
+	t.Run("decorated values are decorated again", func(t *testing.T) {
+		t.Parallel()
+
+		var gen int
+		c := digtest.New(t)
+		c.RequireProvide(func() *Client {
+			gen++
+			return &Client{gen: gen}
+		})
+		c.RequireDecorate(func(cl *Client) *Client { return &Client{gen: cl.gen * 10} })
+		c.RequireInvoke(func(cl *Client) { assert.Equal(t, 10, cl.gen) })
+
+		require.NoError(t, c.Teardown(func(*Client) {}))
+		c.RequireInvoke(func(cl *Client) { assert.Equal(t, 20, cl.gen) })
+	})
+
+	t.Run("rejects value groups", func(t *testing.T) {
+		t.Parallel()
+
+		type params struct {
+			dig.In
+
+			Clients []*Client `group:"clients"`
+		}
+
+		c := digtest.New(t)
+		c.RequireProvide(func() *Logger { return &Logger{} })
+		c.RequireProvide(func(*Logger) *Client { return &Client{} }, dig.Group("clients"))
+		c.RequireInvoke(func(params) {})
+
+		err := c.Teardown(func(*Logger) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "is a value group")
+	})
+
+	t.Run("rejects non-functions", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		err := c.Teardown(&Logger{})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "can't tear down values for non-function")
+	})
+}
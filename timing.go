@@ -0,0 +1,88 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"sort"
+	"time"
+)
+
+// ConstructorTiming reports how long a constructor took to run.
+type ConstructorTiming struct {
+	// Location where the constructor was defined.
+	Location Location
+
+	// Names of the Scopes from the root to the Scope that the constructor
+	// was provided to, separated by "/". Unnamed Scopes are omitted.
+	Scope string
+
+	// Trace ID of the Invoke that the constructor was first called for, if
+	// it was given one with TraceID or ContextWithTraceID.
+	TraceID string
+
+	// Number of times the constructor was called. This is more than one
+	// only for constructors provided with Transient or RequestScoped.
+	Calls int
+
+	// Total wall-clock time spent in the constructor, not counting the
+	// time spent building its dependencies.
+	Duration time.Duration
+}
+
+// Timings reports how long each constructor called so far by the
+// Container and its Scopes took to run, slowest first, so that the
+// constructors that dominate the startup of an application may be found.
+// Constructors that were never called are omitted.
+//
+// Use CallHooks to be notified of each call as it happens instead.
+func (c *Container) Timings() []ConstructorTiming {
+	return c.scope.Timings()
+}
+
+// Timings reports how long each constructor called so far by this Scope
+// and its descendants took to run. See Container.Timings.
+func (s *Scope) Timings() []ConstructorTiming {
+	defer s.lock()()
+
+	var (
+		timings []ConstructorTiming
+		seen    = make(map[*constructorNode]struct{})
+	)
+	for _, scope := range s.appendSubscopes(nil) {
+		for _, n := range scope.nodes {
+			if _, ok := seen[n]; ok || n.calls == 0 {
+				continue
+			}
+			seen[n] = struct{}{}
+			timings = append(timings, ConstructorTiming{
+				Location: newLocation(n.location),
+				Scope:    n.OrigScope().path(),
+				TraceID:  n.traceID,
+				Calls:    n.calls,
+				Duration: n.elapsed,
+			})
+		}
+	}
+	sort.SliceStable(timings, func(i, j int) bool {
+		return timings[i].Duration > timings[j].Duration
+	})
+	return timings
+}
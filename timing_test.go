@@ -0,0 +1,82 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestTimings(t *testing.T) {
+	t.Parallel()
+
+	type A struct{}
+	type B struct{}
+	type C struct{}
+
+	t.Run("slowest first", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{} })
+		c.RequireProvide(func(*A) *B {
+			time.Sleep(10 * time.Millisecond)
+			return &B{}
+		})
+		c.RequireProvide(func() *C { return &C{} })
+		assert.Empty(t, c.Timings())
+
+		c.RequireInvoke(func(*B) {}, dig.TraceID("trace-1"))
+		c.RequireInvoke(func(*A) {}, dig.TraceID("trace-2"))
+
+		timings := c.Timings()
+		require.Len(t, timings, 2, "constructors that weren't called must be omitted")
+		assert.Equal(t, "TestTimings.func1.2", timings[0].Location.Name)
+		assert.Equal(t, 1, timings[0].Calls)
+		assert.GreaterOrEqual(t, timings[0].Duration, 10*time.Millisecond,
+			"duration must include the constructor's own time")
+		assert.Equal(t, "TestTimings.func1.1", timings[1].Location.Name)
+		assert.Less(t, timings[1].Duration, timings[0].Duration)
+		assert.Equal(t, "trace-1", timings[1].TraceID, "trace ID of the first call must be kept")
+	})
+
+	t.Run("transient and scopes", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		child := c.Scope("child")
+		require.NoError(t, child.Provide(func() *A { return &A{} }, dig.Transient()))
+		for i := 0; i < 3; i++ {
+			require.NoError(t, child.Invoke(func(*A) {}))
+		}
+
+		timings := c.Timings()
+		require.Len(t, timings, 1)
+		assert.Equal(t, 3, timings[0].Calls)
+		assert.Equal(t, "child", timings[0].Scope)
+		assert.Equal(t, timings, child.Timings())
+	})
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// TraceID is an InvokeOption that correlates an Invoke with a trace, such
+// as a distributed trace of the request that it serves. Errors returned by
+// dig while resolving the dependencies of the function, including those
+// of nested calls to Invoke made by constructors, carry the trace ID. Use
+// ErrorTraceID to retrieve it.
+//
+//	err := c.Invoke(handle, dig.TraceID(span.SpanContext().TraceID().String()))
+//
+// Errors returned by the invoked function itself are returned as-is.
+func TraceID(id string) InvokeOption {
+	return traceIDOption(id)
+}
+
+type traceIDOption string
+
+func (o traceIDOption) String() string {
+	return fmt.Sprintf("TraceID(%q)", string(o))
+}
+
+func (o traceIDOption) applyInvokeOption(opts *invokeOptions) {
+	opts.TraceID = string(o)
+}
+
+type traceIDContextKey struct{}
+
+// ContextWithTraceID returns a copy of ctx that carries the given trace
+// ID. InvokeContext uses it as if it was given with the TraceID option.
+func ContextWithTraceID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, traceIDContextKey{}, id)
+}
+
+// TraceIDFromContext returns the trace ID carried by ctx, if any.
+func TraceIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(traceIDContextKey{}).(string)
+	return id, ok && id != ""
+}
+
+// ErrorTraceID returns the trace ID of the Invoke that produced the given
+// error, if it was made with one.
+func ErrorTraceID(err error) (string, bool) {
+	var te errTraced
+	if errors.As(err, &te) {
+		return te.TraceID, true
+	}
+	return "", false
+}
+
+// wrapTraceError attaches a trace ID to an error unless it already
+// carries it.
+func wrapTraceError(id string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if tid, ok := ErrorTraceID(err); ok && tid == id {
+		return err
+	}
+	return errTraced{TraceID: id, Reason: err}
+}
+
+// errTraced is returned by Invoke when it was made with a trace ID.
+type errTraced struct {
+	TraceID string
+	Reason  error
+}
+
+var _ digError = errTraced{}
+
+func (e errTraced) Error() string { return fmt.Sprint(e) }
+
+func (e errTraced) Unwrap() error { return e.Reason }
+
+func (e errTraced) writeMessage(w io.Writer, _ string) {
+	fmt.Fprintf(w, "trace %q", e.TraceID)
+}
+
+func (e errTraced) Format(w fmt.State, c rune) {
+	formatError(e, w, c)
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestTraceID(t *testing.T) {
+	t.Parallel()
+
+	type A struct{}
+
+	t.Run("option string", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, `TraceID("abc")`, fmt.Sprint(dig.TraceID("abc")))
+	})
+
+	t.Run("missing dependency", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		err := c.Invoke(func(*A) {}, dig.TraceID("abc"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `trace "abc": missing dependencies`)
+
+		id, ok := dig.ErrorTraceID(err)
+		require.True(t, ok)
+		assert.Equal(t, "abc", id)
+	})
+
+	t.Run("constructor error", func(t *testing.T) {
+		t.Parallel()
+
+		giveErr := errors.New("great sadness")
+		c := digtest.New(t)
+		c.RequireProvide(func() (*A, error) { return nil, giveErr })
+
+		err := c.Invoke(func(*A) {}, dig.TraceID("abc"))
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, giveErr))
+
+		id, ok := dig.ErrorTraceID(err)
+		require.True(t, ok)
+		assert.Equal(t, "abc", id)
+	})
+
+	t.Run("nested invoke inherits trace ID", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() (*A, error) {
+			return nil, c.Invoke(func(string) {})
+		})
+
+		err := c.Invoke(func(*A) {}, dig.TraceID("abc"))
+		require.Error(t, err)
+		assert.Equal(t, 1, strings.Count(err.Error(), `trace "abc"`), "trace ID must be reported once: %v", err)
+
+		id, ok := dig.ErrorTraceID(err)
+		require.True(t, ok)
+		assert.Equal(t, "abc", id)
+	})
+
+	t.Run("function errors are returned as-is", func(t *testing.T) {
+		t.Parallel()
+
+		giveErr := errors.New("great sadness")
+		c := digtest.New(t)
+		err := c.Invoke(func() error { return giveErr }, dig.TraceID("abc"))
+		assert.Equal(t, giveErr, err)
+	})
+
+	t.Run("without trace ID", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		err := c.Invoke(func(*A) {})
+		require.Error(t, err)
+
+		_, ok := dig.ErrorTraceID(err)
+		assert.False(t, ok)
+	})
+
+	t.Run("context", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := dig.ContextWithTraceID(context.Background(), "abc")
+		id, ok := dig.TraceIDFromContext(ctx)
+		require.True(t, ok)
+		assert.Equal(t, "abc", id)
+
+		c := digtest.New(t)
+		err := c.InvokeContext(ctx, func(*A) {})
+		require.Error(t, err)
+		id, ok = dig.ErrorTraceID(err)
+		require.True(t, ok)
+		assert.Equal(t, "abc", id)
+
+		// An explicit option takes precedence over the context.
+		err = c.InvokeContext(ctx, func(*A) {}, dig.TraceID("def"))
+		require.Error(t, err)
+		id, _ = dig.ErrorTraceID(err)
+		assert.Equal(t, "def", id)
+	})
+
+	t.Run("context without trace ID", func(t *testing.T) {
+		t.Parallel()
+
+		_, ok := dig.TraceIDFromContext(context.Background())
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/dig/internal/digreflect"
+)
+
+// Tracer starts spans for the calls made by a container. See Tracing.
+type Tracer interface {
+	// Start starts a span with the given name as a child of the span
+	// carried by ctx, if any. It returns a context carrying the new span
+	// and a function that ends the span with the error of the call, which
+	// is nil if the call succeeded.
+	Start(ctx context.Context, name string) (context.Context, func(error))
+}
+
+// Tracing is an Option that wraps each call to Invoke and each call to a
+// constructor in a span started with the given Tracer, so that the
+// startup of an application may be analyzed in existing tracing tools.
+//
+// Spans of constructors are named after the constructor, such as
+// "example.com/app.NewServer", and spans of Invokes are named after the
+// invoked function with an "Invoke " prefix. The span of a constructor
+// covers building its dependencies, so the spans of the constructors that
+// it depends on are its children. Invokes made with InvokeContext and
+// Scopes made with ScopeContext start their spans as children of the span
+// carried by their context.
+//
+// For example, with OpenTelemetry:
+//
+//	type otelTracer struct{ trace.Tracer }
+//
+//	func (t otelTracer) Start(ctx context.Context, name string) (context.Context, func(error)) {
+//	  ctx, span := t.Tracer.Start(ctx, name)
+//	  return ctx, func(err error) {
+//	    if err != nil {
+//	      span.RecordError(err)
+//	      span.SetStatus(codes.Error, err.Error())
+//	    }
+//	    span.End()
+//	  }
+//	}
+//
+//	c := dig.New(dig.Tracing(otelTracer{otel.Tracer("dig")}))
+func Tracing(t Tracer) Option {
+	return tracingOption{t: t}
+}
+
+type tracingOption struct{ t Tracer }
+
+func (o tracingOption) String() string {
+	return fmt.Sprintf("Tracing(%v)", o.t)
+}
+
+func (o tracingOption) applyOption(c *Container) {
+	c.scope.tracer = o.t
+}
+
+// startSpan starts a span for a call to the given function if the
+// container is traced. The span is a child of ctx if it's set, or else of
+// the innermost span being traced.
+//
+// The returned pop function must be called once the span no longer
+// encloses the calls made by the container, and end once the call is
+// done.
+func (s *Scope) startSpan(ctx context.Context, prefix string, f *digreflect.Func) (pop func(), end func(error)) {
+	root := s.rootScope()
+	if root.tracer == nil {
+		return func() {}, func(error) {}
+	}
+
+	if ctx == nil {
+		ctx = s.spanContext()
+	}
+	name := prefix
+	if f != nil {
+		name += f.Package + "." + f.Name
+	}
+	ctx, end = root.tracer.Start(ctx, name)
+
+	r, leave := s.enterResolution()
+	r.spans = append(r.spans, ctx)
+	n := len(r.spans)
+	return func() {
+		r.spans = r.spans[:n-1]
+		leave()
+	}, end
+}
+
+// spanContext returns the context carrying the innermost span being
+// traced, or else the context that values are being resolved with.
+func (s *Scope) spanContext() context.Context {
+	if r := s.resolution(); r != nil && len(r.spans) > 0 {
+		return r.spans[len(r.spans)-1]
+	}
+	if ctx := s.resolutionPath().context(); ctx != nil {
+		return ctx
+	}
+	if ctx, ok := s.scopeContext(); ok {
+		return ctx
+	}
+	return context.Background()
+}
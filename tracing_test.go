@@ -0,0 +1,140 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+type spanKey struct{}
+
+// fakeTracer records spans as "name < parent" along with the errors they
+// ended with.
+type fakeTracer struct {
+	spans []string
+	ended []string
+}
+
+func (t *fakeTracer) Start(ctx context.Context, name string) (context.Context, func(error)) {
+	parent, _ := ctx.Value(spanKey{}).(string)
+	t.spans = append(t.spans, strings.TrimSpace(shortSpanName(name)+" < "+parent))
+	return context.WithValue(ctx, spanKey{}, shortSpanName(name)), func(err error) {
+		t.ended = append(t.ended, fmt.Sprintf("%v: %v", shortSpanName(name), err))
+	}
+}
+
+// shortSpanName trims the package and test function from span names.
+func shortSpanName(name string) string {
+	return strings.Replace(name, "go.uber.org/dig_test.TestTracing.", "", 1)
+}
+
+func TestTracing(t *testing.T) {
+	t.Parallel()
+
+	type A struct{}
+	type B struct{}
+
+	t.Run("nested spans", func(t *testing.T) {
+		t.Parallel()
+
+		var tracer fakeTracer
+		c := digtest.New(t, dig.Tracing(&tracer))
+		c.RequireProvide(func() *A { return &A{} })
+		c.RequireProvide(func(*A) *B { return &B{} })
+		c.RequireInvoke(func(*B) {})
+
+		assert.Equal(t, []string{
+			"Invoke func1.3 <",
+			"func1.2 < Invoke func1.3",
+			"func1.1 < func1.2",
+		}, tracer.spans)
+		assert.Equal(t, []string{
+			"func1.1: <nil>",
+			"func1.2: <nil>",
+			"Invoke func1.3: <nil>",
+		}, tracer.ended)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		t.Parallel()
+
+		var tracer fakeTracer
+		c := digtest.New(t, dig.Tracing(&tracer))
+		c.RequireProvide(func() (*A, error) { return nil, errors.New("great sadness") })
+		require.Error(t, c.Invoke(func(*A) {}))
+		require.Error(t, c.Invoke(func() error { return errors.New("no good") }))
+
+		require.Len(t, tracer.ended, 3)
+		assert.Contains(t, tracer.ended[0], "great sadness")
+		assert.Contains(t, tracer.ended[1], "great sadness")
+		assert.Equal(t, "Invoke func2.3: no good", tracer.ended[2])
+	})
+
+	t.Run("context", func(t *testing.T) {
+		t.Parallel()
+
+		var tracer fakeTracer
+		c := digtest.New(t, dig.Tracing(&tracer))
+		c.RequireProvide(func() *A { return &A{} })
+
+		ctx := context.WithValue(context.Background(), spanKey{}, "request")
+		require.NoError(t, c.InvokeContext(ctx, func(*A) {}))
+		assert.Equal(t, []string{
+			"Invoke func3.2 < request",
+			"func3.1 < Invoke func3.2",
+		}, tracer.spans)
+	})
+
+	t.Run("nested Invoke", func(t *testing.T) {
+		t.Parallel()
+
+		var tracer fakeTracer
+		c := digtest.New(t, dig.Tracing(&tracer))
+		c.RequireProvide(func() *A { return &A{} })
+		c.RequireProvide(func() (*B, error) {
+			return &B{}, c.Invoke(func(*A) {})
+		})
+		c.RequireInvoke(func(*B) {})
+
+		assert.Equal(t, []string{
+			"Invoke func4.3 <",
+			"func4.2 < Invoke func4.3",
+			"Invoke func4.2.1 < func4.2",
+			"func4.1 < Invoke func4.2.1",
+		}, tracer.spans)
+	})
+
+	t.Run("String", func(t *testing.T) {
+		t.Parallel()
+
+		tracer := &fakeTracer{}
+		assert.Equal(t, fmt.Sprintf("Tracing(%v)", tracer), fmt.Sprint(dig.Tracing(tracer)))
+	})
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+// P2 is a parameter object that requests two values from the container,
+// each resolved independently as if it were a separate parameter. It's a
+// tag-free alternative to a small dig.In struct:
+//
+//	c.Invoke(func(p dig.P2[*Config, *zap.Logger]) {
+//	  cfg, log := p.Values()
+//	  // ...
+//	})
+//
+// Use a dig.In struct instead to request named, optional, or grouped
+// values.
+type P2[T1, T2 any] struct {
+	In
+
+	V1 T1
+	V2 T2
+}
+
+// Values returns the values held by this parameter object.
+func (p P2[T1, T2]) Values() (T1, T2) {
+	return p.V1, p.V2
+}
+
+// P3 is a parameter object that requests three values from the container.
+// See P2 for details.
+type P3[T1, T2, T3 any] struct {
+	In
+
+	V1 T1
+	V2 T2
+	V3 T3
+}
+
+// Values returns the values held by this parameter object.
+func (p P3[T1, T2, T3]) Values() (T1, T2, T3) {
+	return p.V1, p.V2, p.V3
+}
+
+// P4 is a parameter object that requests four values from the container.
+// See P2 for details.
+type P4[T1, T2, T3, T4 any] struct {
+	In
+
+	V1 T1
+	V2 T2
+	V3 T3
+	V4 T4
+}
+
+// Values returns the values held by this parameter object.
+func (p P4[T1, T2, T3, T4]) Values() (T1, T2, T3, T4) {
+	return p.V1, p.V2, p.V3, p.V4
+}
+
+// P5 is a parameter object that requests five values from the container.
+// See P2 for details.
+type P5[T1, T2, T3, T4, T5 any] struct {
+	In
+
+	V1 T1
+	V2 T2
+	V3 T3
+	V4 T4
+	V5 T5
+}
+
+// Values returns the values held by this parameter object.
+func (p P5[T1, T2, T3, T4, T5]) Values() (T1, T2, T3, T4, T5) {
+	return p.V1, p.V2, p.V3, p.V4, p.V5
+}
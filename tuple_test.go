@@ -0,0 +1,104 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestTupleParams(t *testing.T) {
+	t.Parallel()
+
+	type A struct{ name string }
+	type B struct{ name string }
+	type C struct{ name string }
+	type D struct{ name string }
+	type E struct{ name string }
+
+	newContainer := func(t *testing.T) *digtest.Container {
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{"a"} })
+		c.RequireProvide(func() *B { return &B{"b"} })
+		c.RequireProvide(func() *C { return &C{"c"} })
+		c.RequireProvide(func() *D { return &D{"d"} })
+		c.RequireProvide(func() *E { return &E{"e"} })
+		return c
+	}
+
+	t.Run("P2", func(t *testing.T) {
+		c := newContainer(t)
+		c.RequireInvoke(func(p dig.P2[*A, *B]) {
+			a, b := p.Values()
+			assert.Equal(t, "a", a.name)
+			assert.Equal(t, "b", b.name)
+		})
+	})
+
+	t.Run("P3", func(t *testing.T) {
+		c := newContainer(t)
+		c.RequireInvoke(func(p dig.P3[*A, *B, *C]) {
+			_, _, c := p.Values()
+			assert.Equal(t, "c", c.name)
+		})
+	})
+
+	t.Run("P4", func(t *testing.T) {
+		c := newContainer(t)
+		c.RequireInvoke(func(p dig.P4[*A, *B, *C, *D]) {
+			_, _, _, d := p.Values()
+			assert.Equal(t, "d", d.name)
+		})
+	})
+
+	t.Run("P5", func(t *testing.T) {
+		c := newContainer(t)
+		c.RequireInvoke(func(p dig.P5[*A, *B, *C, *D, *E]) {
+			_, _, _, _, e := p.Values()
+			assert.Equal(t, "e", e.name)
+		})
+	})
+
+	t.Run("constructor parameter", func(t *testing.T) {
+		type F struct{ name string }
+
+		c := newContainer(t)
+		c.RequireProvide(func(p dig.P2[*A, *B]) *F {
+			return &F{p.V1.name + p.V2.name}
+		})
+		c.RequireInvoke(func(f *F) {
+			assert.Equal(t, "ab", f.name)
+		})
+	})
+
+	t.Run("missing value", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{} })
+
+		err := c.Invoke(func(dig.P2[*A, *B]) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing type: *dig_test.B")
+	})
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+// Provider is implemented by Container and Scope. Constructors are
+// provided to it by Provide0 through Provide5.
+type Provider interface {
+	Provide(constructor interface{}, opts ...ProvideOption) error
+}
+
+var (
+	_ Provider = (*Container)(nil)
+	_ Provider = (*Scope)(nil)
+)
+
+// Provide0 provides a constructor that has no dependencies and returns a
+// value of type R and an error. It's equivalent to calling Provide, except
+// that the shape of the constructor is checked by the compiler instead of
+// at runtime.
+//
+//	err := dig.Provide0(c, NewConfig)
+//
+// Provide1 through Provide5 provide constructors with one through five
+// dependencies. Their type parameters are usually inferred from the
+// constructor:
+//
+//	// func NewServer(*Config, *zap.Logger) (*Server, error)
+//	err := dig.Provide2(c, NewServer)
+//
+// Use Provide directly for constructors that produce several values, or
+// that can't fail. Parameter and result objects are supported as usual.
+func Provide0[R any](p Provider, constructor func() (R, error), opts ...ProvideOption) error {
+	return p.Provide(constructor, opts...)
+}
+
+// Provide1 provides a constructor with one dependency that returns a value
+// of type R and an error. See Provide0 for details.
+func Provide1[T1, R any](p Provider, constructor func(T1) (R, error), opts ...ProvideOption) error {
+	return p.Provide(constructor, opts...)
+}
+
+// Provide2 provides a constructor with two dependencies that returns a
+// value of type R and an error. See Provide0 for details.
+func Provide2[T1, T2, R any](p Provider, constructor func(T1, T2) (R, error), opts ...ProvideOption) error {
+	return p.Provide(constructor, opts...)
+}
+
+// Provide3 provides a constructor with three dependencies that returns a
+// value of type R and an error. See Provide0 for details.
+func Provide3[T1, T2, T3, R any](p Provider, constructor func(T1, T2, T3) (R, error), opts ...ProvideOption) error {
+	return p.Provide(constructor, opts...)
+}
+
+// Provide4 provides a constructor with four dependencies that returns a
+// value of type R and an error. See Provide0 for details.
+func Provide4[T1, T2, T3, T4, R any](p Provider, constructor func(T1, T2, T3, T4) (R, error), opts ...ProvideOption) error {
+	return p.Provide(constructor, opts...)
+}
+
+// Provide5 provides a constructor with five dependencies that returns a
+// value of type R and an error. See Provide0 for details.
+func Provide5[T1, T2, T3, T4, T5, R any](p Provider, constructor func(T1, T2, T3, T4, T5) (R, error), opts ...ProvideOption) error {
+	return p.Provide(constructor, opts...)
+}
+
+// Decorator is implemented by Container and Scope. Decorators are given
+// to it by DecorateFunc and DecorateFuncErr.
+type Decorator interface {
+	Decorate(decorator interface{}, opts ...DecorateOption) error
+}
+
+var (
+	_ Decorator = (*Container)(nil)
+	_ Decorator = (*Scope)(nil)
+)
+
+// DecorateFunc decorates values of type T with the given function, which
+// receives the current value and returns its replacement. It's equivalent
+// to calling Decorate, except that the shape of the decorator is checked
+// by the compiler instead of at runtime.
+//
+//	err := dig.DecorateFunc(c, func(l *zap.Logger) *zap.Logger {
+//	  return l.Named("billing")
+//	})
+//
+// Use Decorate directly for decorators with other dependencies, or that
+// decorate several values.
+func DecorateFunc[T any](d Decorator, decorator func(T) T, opts ...DecorateOption) error {
+	return d.Decorate(decorator, opts...)
+}
+
+// DecorateFuncErr decorates values of type T with the given function,
+// which may fail. See DecorateFunc for details.
+func DecorateFuncErr[T any](d Decorator, decorator func(T) (T, error), opts ...DecorateOption) error {
+	return d.Decorate(decorator, opts...)
+}
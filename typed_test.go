@@ -0,0 +1,130 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestTypedProvide(t *testing.T) {
+	t.Parallel()
+
+	type (
+		A struct{}
+		B struct{}
+		C struct{}
+		D struct{}
+		E struct{}
+		F struct{ n int }
+	)
+
+	t.Run("all arities", func(t *testing.T) {
+		c := digtest.New(t)
+
+		require.NoError(t, dig.Provide0(c.Container, func() (*A, error) { return &A{}, nil }))
+		require.NoError(t, dig.Provide1(c.Container, func(*A) (*B, error) { return &B{}, nil }))
+		require.NoError(t, dig.Provide2(c.Container, func(*A, *B) (*C, error) { return &C{}, nil }))
+		require.NoError(t, dig.Provide3(c.Container, func(*A, *B, *C) (*D, error) { return &D{}, nil }))
+		require.NoError(t, dig.Provide4(c.Container, func(*A, *B, *C, *D) (*E, error) { return &E{}, nil }))
+		require.NoError(t, dig.Provide5(c.Container, func(*A, *B, *C, *D, *E) (*F, error) { return &F{n: 5}, nil }))
+
+		c.RequireInvoke(func(f *F) {
+			assert.Equal(t, 5, f.n)
+		})
+	})
+
+	t.Run("scope and options", func(t *testing.T) {
+		c := digtest.New(t)
+		child := c.Container.Scope("child")
+
+		require.NoError(t, dig.Provide0(child, func() (*A, error) { return &A{}, nil }, dig.Name("a")))
+		require.NoError(t, child.Invoke(func(p struct {
+			dig.In
+
+			A *A `name:"a"`
+		}) {
+			assert.NotNil(t, p.A)
+		}))
+	})
+
+	t.Run("constructor error", func(t *testing.T) {
+		c := digtest.New(t)
+		require.NoError(t, dig.Provide0(c.Container, func() (*A, error) {
+			return nil, errors.New("great sadness")
+		}))
+
+		err := c.Invoke(func(*A) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "great sadness")
+	})
+}
+
+func TestTypedDecorate(t *testing.T) {
+	t.Parallel()
+
+	type Logger struct{ name string }
+
+	t.Run("DecorateFunc", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *Logger { return &Logger{name: "root"} })
+
+		require.NoError(t, dig.DecorateFunc(c.Container, func(l *Logger) *Logger {
+			return &Logger{name: l.name + ".billing"}
+		}))
+		c.RequireInvoke(func(l *Logger) {
+			assert.Equal(t, "root.billing", l.name)
+		})
+	})
+
+	t.Run("scope", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *Logger { return &Logger{name: "root"} })
+		child := c.Container.Scope("child")
+
+		require.NoError(t, dig.DecorateFunc(child, func(l *Logger) *Logger {
+			return &Logger{name: l.name + ".child"}
+		}))
+		require.NoError(t, child.Invoke(func(l *Logger) {
+			assert.Equal(t, "root.child", l.name)
+		}))
+		c.RequireInvoke(func(l *Logger) {
+			assert.Equal(t, "root", l.name)
+		})
+	})
+
+	t.Run("DecorateFuncErr", func(t *testing.T) {
+		c := digtest.New(t)
+		c.RequireProvide(func() *Logger { return &Logger{name: "root"} })
+
+		require.NoError(t, dig.DecorateFuncErr(c.Container, func(*Logger) (*Logger, error) {
+			return nil, errors.New("great sadness")
+		}))
+		err := c.Invoke(func(*Logger) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "great sadness")
+	})
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"go.uber.org/dig/internal/digreflect"
+	"go.uber.org/dig/internal/graph"
+)
+
+// ValidateOnly is an InvokeOption that checks that the dependencies of the
+// invoked function can be resolved, without calling the function or any
+// constructor. Use it to validate wiring in CI without connecting to
+// databases or other services.
+//
+//	err := c.Invoke(run, dig.ValidateOnly())
+//
+// Unlike Invoke, which stops at the first failure, ValidateOnly walks the
+// dependencies of the function and of every constructor they need that
+// hasn't been called yet, and returns an error aggregating all missing
+// dependencies and cycles. Interfaces that InferInterfaces can't infer
+// because several values implement them are reported as missing along
+// with those values.
+//
+// Unlike a container built with DryRun, the container is left untouched,
+// so ValidateOnly may be used on containers that are in use.
+func ValidateOnly() InvokeOption {
+	return validateOnlyOption{}
+}
+
+type validateOnlyOption struct{}
+
+func (validateOnlyOption) String() string {
+	return "ValidateOnly()"
+}
+
+func (validateOnlyOption) applyInvokeOption(opts *invokeOptions) {
+	opts.ValidateOnly = true
+}
+
+// validateInvoke checks the dependencies of a function invoked with
+// ValidateOnly.
+func (s *Scope) validateInvoke(pl paramList, loc *digreflect.Func) error {
+	var errs []error
+	if err := s.graphScope().checkAcyclic(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := shallowCheckDependencies(s, pl); err != nil {
+		errs = append(errs, errMissingDependencies{Func: loc, Reason: err})
+	}
+	walkDependencies(s, pl, func(_ *Scope, _ param, n *constructorNode) bool {
+		if n.called {
+			return false
+		}
+		if err := n.checkDependencies(); err != nil {
+			errs = append(errs, err)
+		}
+		return true
+	})
+	return s.wrapScopeError(newErrMultiple(errs))
+}
+
+// Verify checks that the dependencies of every constructor and decorator
+// in the Container and all of its Scopes can be resolved, without calling
+// any of them. Unlike Invoke with ValidateOnly, this catches broken wiring even
+// for values that no Invoke requests yet.
+//
+// Optional dependencies, value groups, and values declared with Extern
+// are always satisfiable. Verify returns an error aggregating all missing
+// dependencies and cycles, with failures in a named Scope grouped under
+// that Scope. Use ErrorsByScope to inspect them.
+func (c *Container) Verify() error {
+	return c.scope.Verify()
+}
+
+// Verify checks that the dependencies of every constructor and decorator
+// in this Scope and all of its descendants can be resolved, without calling
+// any of them.
+//
+// See Container.Verify for details.
+func (s *Scope) Verify() error {
+	defer s.lock()()
+
+	var errs []error
+	for _, scope := range s.appendSubscopes(nil) {
+		if err := scope.verify(); err != nil {
+			errs = append(errs, scope.wrapScopeError(err))
+		}
+	}
+	return newErrMultiple(errs)
+}
+
+// verify checks the constructors and decorators of this Scope, not
+// including its descendants.
+func (s *Scope) verify() error {
+	var errs []error
+	if !s.request {
+		if err := s.checkAcyclic(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for _, n := range s.nodes {
+		if n.disabled {
+			continue
+		}
+		if err := n.checkDependencies(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// A decorator of several values is recorded for each of them.
+	seen := make(map[*decoratorNode]struct{})
+	for _, k := range sortedKeysOf(s.decorators) {
+		d := s.decorators[k]
+		if _, ok := seen[d]; ok {
+			continue
+		}
+		seen[d] = struct{}{}
+		if err := d.checkDependencies(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return newErrMultiple(errs)
+}
+
+// checkAcyclic verifies that the graph of this Scope has no cycles.
+func (s *Scope) checkAcyclic() error {
+	if s.isVerifiedAcyclic {
+		return nil
+	}
+	if ok, cycle := graph.IsAcyclic(s.gh); !ok {
+		return newErrInvalidInput(
+			"cycle detected in dependency graph", s.cycleDetectedError(cycle))
+	}
+	s.isVerifiedAcyclic = true
+	return nil
+}
+
+// checkDependencies verifies that the dependencies of this constructor can
+// be resolved without calling it.
+func (n *constructorNode) checkDependencies() error {
+	if err := shallowCheckDependencies(n.OrigScope(), n.paramList); err != nil {
+		return errMissingDependencies{
+			Func:   n.location,
+			Module: n.module,
+			Reason: err,
+		}
+	}
+	return nil
+}
+
+// checkDependencies verifies that the dependencies of this decorator can
+// be resolved without calling it.
+func (n *decoratorNode) checkDependencies() error {
+	if err := shallowCheckDependencies(n.s, n.params); err != nil {
+		return errMissingDependencies{
+			Func:   n.location,
+			Module: n.module,
+			Reason: err,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestValidateOnly(t *testing.T) {
+	t.Parallel()
+
+	type A struct{}
+	type B struct{}
+	type C struct{}
+	type D struct{}
+
+	t.Run("does not call constructors", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() *A {
+			t.Fatal("constructor must not be called")
+			return nil
+		})
+		c.RequireProvide(func(*A) *B {
+			t.Fatal("constructor must not be called")
+			return nil
+		})
+		require.NoError(t, c.Invoke(func(*B) {
+			t.Fatal("function must not be called")
+		}, dig.ValidateOnly()))
+
+	})
+
+	t.Run("reports all missing dependencies", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func(*C) *A { return &A{} })
+		c.RequireProvide(func(*D) *B { return &B{} })
+
+		err := c.Invoke(func(*A, *B) {}, dig.ValidateOnly())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing type: *dig_test.C")
+		assert.Contains(t, err.Error(), "missing type: *dig_test.D")
+	})
+
+	t.Run("reports missing dependencies of the function", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		err := c.Invoke(func(*A) {}, dig.ValidateOnly())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing type: *dig_test.A")
+	})
+
+	t.Run("skips called constructors", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{} })
+		c.RequireInvoke(func(*A) {})
+		require.NoError(t, c.Invoke(func(*A) {}, dig.ValidateOnly()))
+	})
+
+	t.Run("reports cycles", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.DeferAcyclicVerification())
+		c.RequireProvide(func(*B) *A { return &A{} })
+		c.RequireProvide(func(*A) *B { return &B{} })
+
+		err := c.Invoke(func(*A) {}, dig.ValidateOnly())
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cycle detected")
+	})
+
+	t.Run("allows Provide in strict mode", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.Strict())
+		c.RequireProvide(func() *A { return &A{} })
+		require.NoError(t, c.Invoke(func(*A) {}, dig.ValidateOnly()))
+		require.NoError(t, c.Provide(func() *B { return &B{} }))
+	})
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	type A struct{}
+	type B struct{}
+	type C struct{}
+	type D struct{}
+
+	t.Run("passes without calling constructors", func(t *testing.T) {
+		t.Parallel()
+
+		type params struct {
+			dig.In
+
+			C  *C   `optional:"true"`
+			Ds []*D `group:"d"`
+		}
+
+		c := digtest.New(t)
+		c.RequireProvide(func() *A {
+			t.Fatal("constructor must not be called")
+			return nil
+		})
+		c.RequireProvide(func(*A, params) *B {
+			t.Fatal("constructor must not be called")
+			return nil
+		})
+		require.NoError(t, c.Verify())
+	})
+
+	t.Run("reports constructors that nothing invokes", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func(*C) *A { return &A{} })
+		c.RequireProvide(func(*D) *B { return &B{} })
+
+		err := c.Verify()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing type: *dig_test.C")
+		assert.Contains(t, err.Error(), "missing type: *dig_test.D")
+	})
+
+	t.Run("reports decorators", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() *A { return &A{} })
+		c.RequireProvide(func() *B { return &B{} })
+		c.RequireDecorate(func(a *A, _ *C) (*A, *B) {
+			t.Fatal("decorator must not be called")
+			return a, nil
+		})
+		require.NoError(t, c.Scope("child").Decorate(func(b *B, _ *D) *B { return b }))
+
+		err := c.Verify()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing type: *dig_test.C")
+		assert.Contains(t, err.Error(), "missing type: *dig_test.D")
+		assert.Equal(t, 1, strings.Count(err.Error(), "*dig_test.C"),
+			"decorators of several values must be reported once")
+	})
+
+	t.Run("groups failures by scope", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.Compatibility(dig.CompatExtended))
+		c.RequireProvide(func() *A { return &A{} })
+		child := c.Scope("child")
+		require.NoError(t, child.Provide(func(*A, *C) *B { return &B{} }))
+
+		err := c.Verify()
+		require.Error(t, err)
+		byScope := dig.ErrorsByScope(err)
+		require.Len(t, byScope["child"], 1)
+		assert.Contains(t, byScope["child"][0].Error(), "missing type: *dig_test.C")
+	})
+
+	t.Run("reports cycles", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t, dig.DeferAcyclicVerification())
+		c.RequireProvide(func(*B) *A { return &A{} })
+		c.RequireProvide(func(*A) *B { return &B{} })
+
+		err := c.Verify()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cycle detected")
+	})
+}
@@ -21,9 +21,11 @@
 package dig
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
+	"reflect"
 	"strconv"
 	"text/template"
 
@@ -37,6 +39,9 @@ type VisualizeOption interface {
 
 type visualizeOptions struct {
 	VisualizeError error
+	Format         GraphFormat
+	Roots          []interface{}
+	Style          *GraphStyle
 }
 
 // VisualizeError includes a visualization of the given error in the output of
@@ -62,6 +67,58 @@ func (o visualizeErrorOption) applyVisualizeOption(opt *visualizeOptions) {
 	opt.VisualizeError = o.err
 }
 
+// VisualizeRoot restricts the output of Visualize to the constructors
+// needed to build the given root, and their values. The root is either a
+// function, whose parameters are resolved as with Invoke, or a pointer to
+// a value of the type to start from.
+//
+//	dig.Visualize(c, w, dig.VisualizeRoot(new(*http.Server)))
+//	dig.Visualize(c, w, dig.VisualizeRoot(run))
+//
+// VisualizeRoot may be used more than once to include the constructors
+// needed by any of the roots.
+func VisualizeRoot(root interface{}) VisualizeOption {
+	return visualizeRootOption{root}
+}
+
+type visualizeRootOption struct{ root interface{} }
+
+func (o visualizeRootOption) String() string {
+	return fmt.Sprintf("VisualizeRoot(%v)", reflect.TypeOf(o.root))
+}
+
+func (o visualizeRootOption) applyVisualizeOption(opt *visualizeOptions) {
+	opt.Roots = append(opt.Roots, o.root)
+}
+
+// reachableNodes returns the constructors of this Scope needed to build
+// the given roots. See VisualizeRoot.
+func (s *Scope) reachableNodes(roots []interface{}) (map[*constructorNode]struct{}, error) {
+	nodes := make(map[*constructorNode]struct{})
+	for _, root := range roots {
+		t := reflect.TypeOf(root)
+		switch {
+		case t == nil:
+			return nil, newErrInvalidInput("can't visualize a nil root", nil)
+		case t.Kind() == reflect.Ptr:
+			t = reflect.FuncOf([]reflect.Type{t.Elem()}, nil, false)
+		case t.Kind() != reflect.Func:
+			return nil, newErrInvalidInput(
+				fmt.Sprintf("can't visualize from %v (type %v): must be a function or a pointer", root, t), nil)
+		}
+
+		pl, err := newParamList(t, s)
+		if err != nil {
+			return nil, err
+		}
+		walkDependencies(s, pl, func(_ *Scope, _ param, n *constructorNode) bool {
+			nodes[n] = struct{}{}
+			return true
+		})
+	}
+	return nodes, nil
+}
+
 func updateGraph(dg *dot.Graph, err error) error {
 	var errs []errVisualizer
 	// Unwrap error to find the root cause.
@@ -101,7 +158,7 @@ var _graphTmpl = template.Must(
 	rankdir=RL;
 	graph [compound=true];
 	{{range $g := .Groups}}
-		{{- quote .String}} [{{.Attributes}}];
+		{{- quote .String}} [{{$.GroupAttributes .}}];
 		{{range .Results}}
 			{{- quote $g.String}} -> {{quote .String}};
 		{{end}}
@@ -111,17 +168,17 @@ var _graphTmpl = template.Must(
 			{{ with .Package }}label = {{ quote .}};
 			{{ end -}}
 
-			constructor_{{$index}} [shape=plaintext label={{quote .Name}}];
-			{{with .ErrorType}}color={{.Color}};{{end}}
+			constructor_{{$index}} [shape=plaintext label={{quote ($.CtorLabel .)}}];
+			{{$.CtorStyle}}{{with .ErrorType}}color={{.Color}};{{end}}
 			{{range .Results}}
-				{{- quote .String}} [{{.Attributes}}];
+				{{- quote .String}} [{{$.ResultAttributes .}}];
 			{{end}}
 		}
 		{{range .Params}}
-			constructor_{{$index}} -> {{quote .String}} [ltail=cluster_{{$index}}{{if .Optional}} style=dashed{{end}}];
+			constructor_{{$index}} -> {{quote .String}} [ltail=cluster_{{$index}}{{if .Optional}} style=dashed{{end}}{{$.EdgeStyle}}];
 		{{end}}
 		{{range .GroupParams}}
-			constructor_{{$index}} -> {{quote .String}} [ltail=cluster_{{$index}}];
+			constructor_{{$index}} -> {{quote .String}} [ltail=cluster_{{$index}}{{$.EdgeStyle}}];
 		{{end -}}
 	{{end}}
 	{{range .Failed.TransitiveFailures}}
@@ -133,22 +190,38 @@ var _graphTmpl = template.Must(
 }`))
 
 // Visualize parses the graph in Container c into DOT format and writes it to
-// io.Writer w.
+// io.Writer w. Use VisualizeFormat to write it in another format.
 func Visualize(c *Container, w io.Writer, opts ...VisualizeOption) error {
-	dg := c.createGraph()
-
 	var options visualizeOptions
 	for _, o := range opts {
 		o.applyVisualizeOption(&options)
 	}
 
-	if options.VisualizeError != nil {
-		if err := updateGraph(dg, options.VisualizeError); err != nil {
+	dg, err := c.scope.visualizeGraph(options)
+	if err != nil {
+		return err
+	}
+
+	switch options.Format {
+	case FormatMermaid:
+		return _mermaidTmpl.Execute(w, newFormatGraph(dg))
+	case FormatGraphML:
+		return _graphMLTmpl.Execute(w, newFormatGraph(dg))
+	default:
+		sg, err := newStyledGraph(dg, options.Style)
+		if err != nil {
 			return err
 		}
+		var b bytes.Buffer
+		if err := _graphTmpl.Execute(&b, sg); err != nil {
+			return err
+		}
+		if len(sg.labelErrors) > 0 {
+			return newErrInvalidInput("failed to execute label template", sg.labelErrors[0])
+		}
+		_, err = w.Write(b.Bytes())
+		return err
 	}
-
-	return _graphTmpl.Execute(w, dg)
 }
 
 // CanVisualizeError returns true if the error is an errVisualizer.
@@ -167,14 +240,44 @@ func CanVisualizeError(err error) bool {
 	return false
 }
 
+// visualizeGraph creates the graph of this Scope that Visualize writes.
+func (s *Scope) visualizeGraph(options visualizeOptions) (*dot.Graph, error) {
+	defer s.lock()()
+
+	dg := s.createGraph()
+	if len(options.Roots) > 0 {
+		nodes, err := s.reachableNodes(options.Roots)
+		if err != nil {
+			return nil, err
+		}
+		dg = s.createSubgraph(nodes)
+	}
+
+	if options.VisualizeError != nil {
+		if err := updateGraph(dg, options.VisualizeError); err != nil {
+			return nil, err
+		}
+	}
+	return dg, nil
+}
+
 func (c *Container) createGraph() *dot.Graph {
 	return c.scope.createGraph()
 }
 
 func (s *Scope) createGraph() *dot.Graph {
+	return s.createSubgraph(nil)
+}
+
+// createSubgraph creates a graph of the given constructors of this Scope,
+// or of all of them if nodes is nil.
+func (s *Scope) createSubgraph(nodes map[*constructorNode]struct{}) *dot.Graph {
 	dg := dot.NewGraph()
 
 	for _, n := range s.nodes {
+		if _, ok := nodes[n]; nodes != nil && !ok {
+			continue
+		}
 		dg.AddCtor(newDotCtor(n), n.paramList.DotParam(), n.resultList.DotResult())
 	}
 
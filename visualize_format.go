@@ -0,0 +1,294 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	"go.uber.org/dig/internal/dot"
+)
+
+// GraphFormat is a format that Visualize can write graphs in.
+type GraphFormat int
+
+const (
+	// FormatDOT writes graphs in the DOT language of Graphviz. This is
+	// the default.
+	FormatDOT GraphFormat = iota
+
+	// FormatMermaid writes graphs as Mermaid flowcharts, which many
+	// documentation tools render directly.
+	FormatMermaid
+
+	// FormatGraphML writes graphs as GraphML documents.
+	FormatGraphML
+)
+
+func (f GraphFormat) String() string {
+	switch f {
+	case FormatDOT:
+		return "DOT"
+	case FormatMermaid:
+		return "Mermaid"
+	case FormatGraphML:
+		return "GraphML"
+	default:
+		return fmt.Sprintf("GraphFormat(%d)", int(f))
+	}
+}
+
+// VisualizeFormat selects the format of the graph written by Visualize.
+//
+//	dig.Visualize(c, w, dig.VisualizeFormat(dig.FormatMermaid))
+func VisualizeFormat(f GraphFormat) VisualizeOption {
+	return visualizeFormatOption(f)
+}
+
+type visualizeFormatOption GraphFormat
+
+func (o visualizeFormatOption) String() string {
+	return fmt.Sprintf("VisualizeFormat(%v)", GraphFormat(o))
+}
+
+func (o visualizeFormatOption) applyVisualizeOption(opt *visualizeOptions) {
+	opt.Format = GraphFormat(o)
+}
+
+// formatGraph is the view of a graph used by the templates of formats
+// other than DOT. Unlike DOT, these formats need every node to be
+// declared with an ID, including parameters that no constructor provides.
+type formatGraph struct {
+	*dot.Graph
+
+	// Parameters and failed values that aren't the result of any
+	// constructor in the graph. Parameters are represented as Results.
+	Missing []*dot.Result
+
+	ids    map[string]string
+	colors map[string]string
+}
+
+func newFormatGraph(dg *dot.Graph) *formatGraph {
+	fg := &formatGraph{
+		Graph:  dg,
+		ids:    make(map[string]string),
+		colors: make(map[string]string),
+	}
+	for _, r := range dg.Failed.TransitiveFailures {
+		fg.colors[r.String()] = "orange"
+	}
+	for _, r := range dg.Failed.RootCauses {
+		fg.colors[r.String()] = "red"
+	}
+
+	declared := make(map[string]struct{})
+	for _, c := range dg.Ctors {
+		for _, r := range c.Results {
+			declared[r.String()] = struct{}{}
+		}
+	}
+	missing := func(r *dot.Result) {
+		if _, ok := declared[r.String()]; !ok {
+			declared[r.String()] = struct{}{}
+			fg.Missing = append(fg.Missing, r)
+		}
+	}
+	for _, r := range dg.Failed.RootCauses {
+		missing(r)
+	}
+	for _, c := range dg.Ctors {
+		for _, p := range c.Params {
+			missing(&dot.Result{Node: p.Node})
+		}
+	}
+	return fg
+}
+
+// ID returns an identifier for the node with the given string
+// representation that's safe to use in any format.
+func (fg *formatGraph) ID(s fmt.Stringer) string {
+	return fg.id(s.String())
+}
+
+// Color returns the color that marks the given result as failed, if any.
+func (fg *formatGraph) Color(r *dot.Result) string {
+	return fg.colors[r.String()]
+}
+
+func (fg *formatGraph) id(s string) string {
+	id, ok := fg.ids[s]
+	if !ok {
+		id = fmt.Sprintf("n%d", len(fg.ids))
+		fg.ids[s] = id
+	}
+	return id
+}
+
+// mermaidText escapes characters that Mermaid treats specially inside
+// quoted labels.
+var mermaidText = strings.NewReplacer(
+	`"`, "#quot;",
+	"<", "#lt;",
+	">", "#gt;",
+).Replace
+
+func nodeLabel(n *dot.Node) string {
+	switch {
+	case n.Name != "":
+		return fmt.Sprintf("%v\nName: %v", n.Type, n.Name)
+	case n.Group != "":
+		return fmt.Sprintf("%v\nGroup: %v", n.Type, n.Group)
+	default:
+		return n.Type.String()
+	}
+}
+
+var _formatFuncs = template.FuncMap{
+	"label": nodeLabel,
+	"mermaid": func(s string) string {
+		return strings.ReplaceAll(mermaidText(s), "\n", "<br/>")
+	},
+}
+
+var _mermaidTmpl = template.Must(
+	template.New("MermaidGraph").
+		Funcs(_formatFuncs).
+		Parse(`flowchart RL
+{{- range $g := .Groups}}
+	{{$.ID $g}}{"{{mermaid (printf "%v\nGroup: %v" $g.Type $g.Name)}}"}
+	{{- with $g.ErrorType}}
+	style {{$.ID $g}} stroke:{{.Color}}
+	{{- end}}
+	{{- range $g.Results}}
+	{{$.ID $g}} --> {{$.ID .}}
+	{{- end}}
+{{- end}}
+{{- range .Missing}}
+	{{$.ID .}}["{{mermaid (label .Node)}}"]
+{{- end}}
+{{- range $index, $ctor := .Ctors}}
+	subgraph cluster_{{$index}} ["{{mermaid .Package}}"]
+		constructor_{{$index}}(["{{mermaid .Name}}"])
+		{{- range .Results}}
+		{{$.ID .}}["{{mermaid (label .Node)}}"]
+		{{- end}}
+	end
+	{{- with .ErrorType}}
+	style cluster_{{$index}} stroke:{{.Color}}
+	{{- end}}
+	{{- range .Params}}
+	constructor_{{$index}} {{if .Optional}}-.->{{else}}-->{{end}} {{$.ID .}}
+	{{- end}}
+	{{- range .GroupParams}}
+	constructor_{{$index}} --> {{$.ID .}}
+	{{- end}}
+{{- end}}
+{{- range .Failed.TransitiveFailures}}
+	style {{$.ID .}} stroke:orange
+{{- end}}
+{{- range .Failed.RootCauses}}
+	style {{$.ID .}} stroke:red
+{{- end}}
+`))
+
+var _graphMLTmpl = template.Must(
+	template.New("GraphML").
+		Funcs(_formatFuncs).
+		Parse(`<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+	<key id="kind" for="node" attr.name="kind" attr.type="string"/>
+	<key id="label" for="node" attr.name="label" attr.type="string"/>
+	<key id="package" for="node" attr.name="package" attr.type="string"/>
+	<key id="type" for="node" attr.name="type" attr.type="string"/>
+	<key id="name" for="node" attr.name="name" attr.type="string"/>
+	<key id="group" for="node" attr.name="group" attr.type="string"/>
+	<key id="color" for="node" attr.name="color" attr.type="string"/>
+	<key id="optional" for="edge" attr.name="optional" attr.type="boolean">
+		<default>false</default>
+	</key>
+	<graph id="dig" edgedefault="directed">
+{{- range $g := .Groups}}
+		<node id="{{$.ID $g}}">
+			<data key="kind">group</data>
+			<data key="type">{{html $g.Type.String}}</data>
+			<data key="group">{{html $g.Name}}</data>
+			{{- with $g.ErrorType}}
+			<data key="color">{{.Color}}</data>
+			{{- end}}
+		</node>
+		{{- range $g.Results}}
+		<edge source="{{$.ID $g}}" target="{{$.ID .}}"/>
+		{{- end}}
+{{- end}}
+{{- range .Missing}}
+		<node id="{{$.ID .}}">
+			<data key="kind">value</data>
+			{{- template "value" .Node}}
+			{{- with $.Color .}}
+			<data key="color">{{.}}</data>
+			{{- end}}
+		</node>
+{{- end}}
+{{- range $index, $ctor := .Ctors}}
+		<node id="constructor_{{$index}}">
+			<data key="kind">constructor</data>
+			<data key="label">{{html .Name}}</data>
+			<data key="package">{{html .Package}}</data>
+			{{- with .ErrorType}}
+			<data key="color">{{.Color}}</data>
+			{{- end}}
+		</node>
+		{{- range .Results}}
+		<node id="{{$.ID .}}">
+			<data key="kind">value</data>
+			{{- template "value" .Node}}
+			{{- with $.Color .}}
+			<data key="color">{{.}}</data>
+			{{- end}}
+		</node>
+		<edge source="{{$.ID .}}" target="constructor_{{$index}}"/>
+		{{- end}}
+		{{- range .Params}}
+		{{- if .Optional}}
+		<edge source="constructor_{{$index}}" target="{{$.ID .}}">
+			<data key="optional">true</data>
+		</edge>
+		{{- else}}
+		<edge source="constructor_{{$index}}" target="{{$.ID .}}"/>
+		{{- end}}
+		{{- end}}
+		{{- range .GroupParams}}
+		<edge source="constructor_{{$index}}" target="{{$.ID .}}"/>
+		{{- end}}
+{{- end}}
+	</graph>
+</graphml>
+{{define "value"}}
+			<data key="type">{{html .Type.String}}</data>
+			{{- with .Name}}
+			<data key="name">{{html .}}</data>
+			{{- end}}
+			{{- with .Group}}
+			<data key="group">{{html .}}</data>
+			{{- end}}
+{{- end}}`))
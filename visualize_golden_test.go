@@ -37,7 +37,18 @@ func VerifyVisualization(t *testing.T, testname string, c *Container, opts ...Vi
 	var b bytes.Buffer
 	require.NoError(t, Visualize(c, &b, opts...))
 
-	dotFile := filepath.Join("testdata", testname+".dot")
+	var options visualizeOptions
+	for _, o := range opts {
+		o.applyVisualizeOption(&options)
+	}
+	ext := ".dot"
+	switch options.Format {
+	case FormatMermaid:
+		ext = ".mmd"
+	case FormatGraphML:
+		ext = ".graphml"
+	}
+	dotFile := filepath.Join("testdata", testname+ext)
 
 	if *generate {
 		err := os.WriteFile(dotFile, b.Bytes(), 0644)
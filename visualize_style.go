@@ -0,0 +1,245 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"go.uber.org/dig/internal/dot"
+)
+
+// GraphStyle customizes the DOT output of Visualize. See VisualizeStyle.
+//
+// Attributes are DOT attributes, such as "color", "shape", or "style",
+// that are added to the ones Visualize sets by default, overriding them.
+// Failed constructors and values are still colored as with VisualizeError.
+type GraphStyle struct {
+	// Attributes of the clusters that hold each constructor and the
+	// values it provides.
+	Constructor map[string]string
+
+	// Attributes of values without a name or group.
+	Value map[string]string
+
+	// Attributes of named values.
+	NamedValue map[string]string
+
+	// Attributes of values provided to a value group.
+	GroupedValue map[string]string
+
+	// Attributes of value group nodes.
+	Group map[string]string
+
+	// Attributes of the edges from constructors to their dependencies.
+	Edge map[string]string
+
+	// Template for the labels of values, executed with a GraphValue.
+	// Defaults to the type of the value and its name or group.
+	//
+	//	{{.Type}}{{with .Name}} ({{.}}){{end}}
+	ValueLabel string
+
+	// Template for the labels of constructors, executed with a
+	// GraphConstructor. Defaults to the name of the function.
+	//
+	//	{{.Name}} ({{.File}}:{{.Line}})
+	ConstructorLabel string
+}
+
+// GraphValue describes a value to the ValueLabel template of a GraphStyle.
+type GraphValue struct {
+	// Type of the value, as printed by Go.
+	Type string
+
+	// Name or value group of the value, if any.
+	Name, Group string
+}
+
+// GraphConstructor describes a constructor to the ConstructorLabel
+// template of a GraphStyle.
+type GraphConstructor struct {
+	// Name of the function, and the package and file it's defined in.
+	Name, Package, File string
+
+	// Line of the file that the function is defined at.
+	Line int
+}
+
+// VisualizeStyle customizes the DOT output of Visualize with the given
+// style, so that graphs need no post-processing to be presented.
+//
+//	dig.Visualize(c, w, dig.VisualizeStyle(dig.GraphStyle{
+//	  NamedValue:   map[string]string{"color": "blue"},
+//	  GroupedValue: map[string]string{"style": "filled", "fillcolor": "lightgrey"},
+//	  ConstructorLabel: "{{.Name}} ({{.File}}:{{.Line}})",
+//	}))
+//
+// It has no effect on other formats selected with VisualizeFormat.
+func VisualizeStyle(style GraphStyle) VisualizeOption {
+	return visualizeStyleOption{style}
+}
+
+type visualizeStyleOption struct{ style GraphStyle }
+
+func (visualizeStyleOption) String() string {
+	return "VisualizeStyle(...)"
+}
+
+func (o visualizeStyleOption) applyVisualizeOption(opt *visualizeOptions) {
+	opt.Style = &o.style
+}
+
+// styledGraph is the view of a graph used by the DOT template. Without a
+// style, it produces the default output.
+type styledGraph struct {
+	*dot.Graph
+
+	style       *GraphStyle
+	valueLabel  *template.Template
+	ctorLabel   *template.Template
+	labelErrors []error
+}
+
+func newStyledGraph(dg *dot.Graph, style *GraphStyle) (*styledGraph, error) {
+	sg := &styledGraph{Graph: dg, style: style}
+	if style == nil {
+		return sg, nil
+	}
+
+	var err error
+	if style.ValueLabel != "" {
+		if sg.valueLabel, err = template.New("ValueLabel").Parse(style.ValueLabel); err != nil {
+			return nil, newErrInvalidInput("invalid ValueLabel template", err)
+		}
+	}
+	if style.ConstructorLabel != "" {
+		if sg.ctorLabel, err = template.New("ConstructorLabel").Parse(style.ConstructorLabel); err != nil {
+			return nil, newErrInvalidInput("invalid ConstructorLabel template", err)
+		}
+	}
+	return sg, nil
+}
+
+// CtorLabel returns the label of the given constructor.
+func (sg *styledGraph) CtorLabel(c *dot.Ctor) string {
+	if sg.ctorLabel == nil {
+		return c.Name
+	}
+	return sg.execute(sg.ctorLabel, GraphConstructor{
+		Name:    c.Name,
+		Package: c.Package,
+		File:    c.File,
+		Line:    c.Line,
+	})
+}
+
+// CtorStyle returns statements setting the attributes of the cluster of
+// a constructor, if any.
+func (sg *styledGraph) CtorStyle() string {
+	if sg.style == nil || len(sg.style.Constructor) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	for _, k := range sortedKeys(sg.style.Constructor) {
+		fmt.Fprintf(&b, "%v=%v;\n\t\t\t", k, strconv.Quote(sg.style.Constructor[k]))
+	}
+	return b.String()
+}
+
+// ResultAttributes returns the attributes of the node for a value.
+func (sg *styledGraph) ResultAttributes(r *dot.Result) string {
+	if sg.style == nil {
+		return r.Attributes()
+	}
+
+	attrs := r.Attributes()
+	if sg.valueLabel != nil {
+		attrs = "label=" + strconv.Quote(sg.execute(sg.valueLabel, GraphValue{
+			Type:  r.Type.String(),
+			Name:  r.Name,
+			Group: r.Group,
+		}))
+	}
+
+	extra := sg.style.Value
+	switch {
+	case r.Name != "":
+		extra = sg.style.NamedValue
+	case r.Group != "":
+		extra = sg.style.GroupedValue
+	}
+	return attrs + dotAttributes(extra)
+}
+
+// GroupAttributes returns the attributes of the node for a value group.
+func (sg *styledGraph) GroupAttributes(g *dot.Group) string {
+	if sg.style == nil || len(sg.style.Group) == 0 {
+		return g.Attributes()
+	}
+
+	// Keep the color of failed groups last so that it takes precedence.
+	attrs := (&dot.Group{Type: g.Type, Name: g.Name}).Attributes() + dotAttributes(sg.style.Group)
+	if g.ErrorType != 0 {
+		attrs += " color=" + g.ErrorType.Color()
+	}
+	return attrs
+}
+
+// EdgeStyle returns the attributes added to the edges from constructors to
+// their dependencies.
+func (sg *styledGraph) EdgeStyle() string {
+	if sg.style == nil {
+		return ""
+	}
+	return dotAttributes(sg.style.Edge)
+}
+
+func (sg *styledGraph) execute(t *template.Template, data interface{}) string {
+	var b bytes.Buffer
+	if err := t.Execute(&b, data); err != nil {
+		sg.labelErrors = append(sg.labelErrors, err)
+	}
+	return b.String()
+}
+
+// dotAttributes formats the given attributes to be added to a DOT
+// attribute list, with a leading space.
+func dotAttributes(attrs map[string]string) string {
+	var b strings.Builder
+	for _, k := range sortedKeys(attrs) {
+		fmt.Fprintf(&b, " %v=%v", k, strconv.Quote(attrs[k]))
+	}
+	return b.String()
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
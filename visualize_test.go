@@ -30,6 +30,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/dig"
 	"go.uber.org/dig/internal/digtest"
 	"go.uber.org/dig/internal/dot"
@@ -582,6 +583,195 @@ func TestVisualize(t *testing.T) {
 
 		dig.VerifyVisualization(t, "missingDep", c.Container, dig.VisualizeError(err))
 	})
+
+	t.Run("rooted", func(t *testing.T) {
+		// t1 <- t2 <- t3
+		//  ^
+		//  '--- t4[name=foo]
+		newContainer := func(t *testing.T) *digtest.Container {
+			c := digtest.New(t)
+			c.RequireProvide(func() t1 { return t1{} })
+			c.RequireProvide(func(t1) t2 { return t2{} })
+			c.RequireProvide(func(t2) t3 { return t3{} })
+			c.RequireProvide(func(t1) t4 { return t4{} }, dig.Name("foo"))
+			return c
+		}
+
+		t.Run("at a type", func(t *testing.T) {
+			c := newContainer(t)
+			dig.VerifyVisualization(t, "rooted_type", c.Container, dig.VisualizeRoot(new(t2)))
+		})
+
+		t.Run("at an Invoke target", func(t *testing.T) {
+			type in struct {
+				dig.In
+
+				T4 t4 `name:"foo"`
+			}
+			c := newContainer(t)
+			dig.VerifyVisualization(t, "rooted_invoke", c.Container, dig.VisualizeRoot(func(in) {}))
+		})
+
+		t.Run("multiple roots", func(t *testing.T) {
+			c := newContainer(t)
+
+			var b bytes.Buffer
+			require.NoError(t, dig.Visualize(c.Container, &b,
+				dig.VisualizeRoot(new(t2)), dig.VisualizeRoot(func(t3) {})))
+			assert.Contains(t, b.String(), `"dig_test.t3"`)
+			assert.NotContains(t, b.String(), "Name: foo")
+		})
+
+		t.Run("invalid", func(t *testing.T) {
+			c := newContainer(t)
+
+			var b bytes.Buffer
+			err := dig.Visualize(c.Container, &b, dig.VisualizeRoot(t1{}))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "must be a function or a pointer")
+
+			err = dig.Visualize(c.Container, &b, dig.VisualizeRoot(nil))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "can't visualize a nil root")
+		})
+
+		t.Run("String", func(t *testing.T) {
+			assert.Equal(t, "VisualizeRoot(*dig_test.t2)", fmt.Sprint(dig.VisualizeRoot(new(t2))))
+		})
+	})
+}
+
+func TestVisualizeStyle(t *testing.T) {
+	type t1 struct{}
+	type t2 struct{}
+	type t3 struct{}
+	type t4 struct{}
+
+	t.Parallel()
+
+	newContainer := func(t *testing.T) *digtest.Container {
+		type in struct {
+			dig.In
+
+			A t1   `name:"foo"`
+			B t2   `optional:"true"`
+			C []t3 `group:"bar"`
+		}
+		type out struct {
+			dig.Out
+
+			A t1 `name:"foo"`
+			B t3 `group:"bar"`
+		}
+
+		c := digtest.New(t)
+		c.RequireProvide(func() out { return out{} })
+		c.RequireProvide(func() t2 { return t2{} })
+		c.RequireProvide(func(in) t4 { return t4{} })
+		return c
+	}
+
+	t.Run("styled", func(t *testing.T) {
+		c := newContainer(t)
+		dig.VerifyVisualization(t, "styled", c.Container, dig.VisualizeStyle(dig.GraphStyle{
+			Constructor:      map[string]string{"style": "filled", "fillcolor": "lightgrey"},
+			Value:            map[string]string{"shape": "box"},
+			NamedValue:       map[string]string{"color": "blue"},
+			GroupedValue:     map[string]string{"color": "darkgreen"},
+			Group:            map[string]string{"shape": "hexagon"},
+			Edge:             map[string]string{"arrowhead": "vee"},
+			ValueLabel:       `{{.Type}}{{with .Name}} "{{.}}"{{end}}`,
+			ConstructorLabel: `{{.Name}} in {{.Package}}`,
+		}))
+	})
+
+	t.Run("failures take precedence", func(t *testing.T) {
+		c := newContainer(t)
+		c.RequireProvide(func() (t3, error) { return t3{}, errors.New("great sadness") }, dig.Group("bar"))
+		err := c.Invoke(func(t4) {})
+		require.Error(t, err)
+
+		var b bytes.Buffer
+		require.NoError(t, dig.Visualize(c.Container, &b,
+			dig.VisualizeError(err),
+			dig.VisualizeStyle(dig.GraphStyle{
+				Constructor: map[string]string{"color": "blue"},
+				Group:       map[string]string{"color": "blue"},
+			})))
+		assert.Contains(t, b.String(), `Group: bar</FONT>> color="blue" color=red];`)
+		assert.Contains(t, b.String(), "color=\"blue\";\n\t\t\tcolor=orange;")
+	})
+
+	t.Run("invalid templates", func(t *testing.T) {
+		c := newContainer(t)
+
+		var b bytes.Buffer
+		err := dig.Visualize(c.Container, &b, dig.VisualizeStyle(dig.GraphStyle{ValueLabel: "{{"}))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid ValueLabel template")
+
+		err = dig.Visualize(c.Container, &b, dig.VisualizeStyle(dig.GraphStyle{ConstructorLabel: "{{.Nope}}"}))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to execute label template")
+		assert.Empty(t, b.String())
+	})
+
+	t.Run("String", func(t *testing.T) {
+		assert.Equal(t, "VisualizeStyle(...)", fmt.Sprint(dig.VisualizeStyle(dig.GraphStyle{})))
+	})
+}
+
+func TestVisualizeFormat(t *testing.T) {
+	type t1 struct{}
+	type t2 struct{}
+	type t3 struct{}
+
+	t.Parallel()
+
+	t.Run("String", func(t *testing.T) {
+		assert.Equal(t, "VisualizeFormat(Mermaid)", fmt.Sprint(dig.VisualizeFormat(dig.FormatMermaid)))
+		assert.Equal(t, "VisualizeFormat(GraphFormat(9))", fmt.Sprint(dig.VisualizeFormat(9)))
+	})
+
+	for _, f := range []dig.GraphFormat{dig.FormatMermaid, dig.FormatGraphML} {
+		f := f
+		t.Run(f.String(), func(t *testing.T) {
+			t.Run("graph", func(t *testing.T) {
+				c := digtest.New(t)
+
+				type in struct {
+					dig.In
+
+					A t1   `name:"foo"`
+					B t2   `optional:"true"`
+					C []t3 `group:"bar"`
+				}
+				type out struct {
+					dig.Out
+
+					A t1 `name:"foo"`
+				}
+
+				c.RequireProvide(func() out { return out{} })
+				c.RequireProvide(func() t3 { return t3{} }, dig.Group("bar"))
+				c.RequireProvide(func() t3 { return t3{} }, dig.Group("bar"))
+				c.RequireProvide(func(in) *bytes.Buffer { return nil })
+				dig.VerifyVisualization(t, "format", c.Container, dig.VisualizeFormat(f))
+			})
+
+			t.Run("error", func(t *testing.T) {
+				c := digtest.New(t)
+
+				c.RequireProvide(func(t1) (t2, error) { return t2{}, errors.New("great sadness") })
+				c.RequireProvide(func() t1 { return t1{} })
+				c.RequireProvide(func(t2, *bytes.Buffer) t3 { return t3{} })
+				err := c.Invoke(func(t3) {})
+
+				dig.VerifyVisualization(t, "format_error", c.Container,
+					dig.VisualizeFormat(f), dig.VisualizeError(err))
+			})
+		})
+	}
 }
 
 func TestVisualizeErrorString(t *testing.T) {
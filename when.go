@@ -0,0 +1,88 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// When is a ProvideOption that provides a constructor only if the given
+// predicate reports true. This allows feature flags to drive the wiring
+// without wrapping Provide calls in conditionals.
+//
+//	c.Provide(NewRedisCache, dig.When(flags.RedisEnabled))
+//	c.Provide(NewMemoryCache, dig.When(func() bool { return !flags.RedisEnabled() }))
+//
+// The predicate is called once, by Provide. If it reports false, the
+// constructor is checked to be valid but otherwise ignored, as if it had
+// not been provided, and FillProvideInfo and FillProviderHandle are left
+// untouched. If When is used more than once, all predicates must report
+// true.
+func When(predicate func() bool) ProvideOption {
+	return whenOption{predicate: predicate}
+}
+
+type whenOption struct {
+	predicate func() bool
+}
+
+func (o whenOption) String() string {
+	return fmt.Sprintf("When(%p)", o.predicate)
+}
+
+func (o whenOption) applyProvideOption(opts *provideOptions) {
+	opts.When = append(opts.When, o.predicate)
+}
+
+// conditionsHold reports whether all predicates passed to When report
+// true.
+func (o *provideOptions) conditionsHold() bool {
+	for _, p := range o.When {
+		if !p() {
+			return false
+		}
+	}
+	return true
+}
+
+// skipCondition validates a constructor whose When predicates reported
+// false, or that doesn't apply to the active Profile, without providing
+// it.
+func (s *Scope) skipCondition(ctor interface{}, opts provideOptions) error {
+	ctype := reflect.TypeOf(ctor)
+	rl, err := newResultList(ctype, resultOptions{
+		Name:    opts.Name,
+		Group:   opts.Group,
+		Key:     opts.GroupKey,
+		As:      opts.As,
+		Futures: true,
+		Cleanup: opts.Cleanup,
+	})
+	if err != nil {
+		return err
+	}
+	if len(rl.DotResult()) == 0 {
+		return newErrInvalidInput(
+			fmt.Sprintf("%v must provide at least one non-error type", ctype), nil)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2026 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dig_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+	"go.uber.org/dig/internal/digtest"
+)
+
+func TestWhen(t *testing.T) {
+	t.Parallel()
+
+	enabled := func() bool { return true }
+	disabled := func() bool { return false }
+
+	t.Run("picks constructor by predicate", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		c.RequireProvide(func() string { return "redis" }, dig.When(disabled))
+		c.RequireProvide(func() string { return "memory" }, dig.When(enabled))
+
+		c.RequireInvoke(func(s string) {
+			assert.Equal(t, "memory", s)
+		})
+	})
+
+	t.Run("all predicates must hold", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		var info dig.ProvideInfo
+		c.RequireProvide(func() string { return "redis" },
+			dig.When(enabled), dig.When(disabled), dig.FillProvideInfo(&info))
+		assert.Empty(t, info.Outputs)
+
+		err := c.Invoke(func(string) {})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "missing type: string")
+	})
+
+	t.Run("predicate called once", func(t *testing.T) {
+		t.Parallel()
+
+		var calls int
+		c := digtest.New(t)
+		c.RequireProvide(func() string { return "s" }, dig.When(func() bool {
+			calls++
+			return true
+		}))
+		c.RequireInvoke(func(string) {})
+		c.RequireInvoke(func(string) {})
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("invalid constructor", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		err := c.Provide(func() error { return nil }, dig.When(disabled))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must provide at least one non-error type")
+	})
+
+	t.Run("nil predicate", func(t *testing.T) {
+		t.Parallel()
+
+		c := digtest.New(t)
+		err := c.Provide(func() string { return "s" }, dig.When(nil))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid dig.When(nil)")
+	})
+}